@@ -0,0 +1,2 @@
+# terraform import yandex_alb_target_group_target.<resource Name> <target group Id>/<target IP address>
+terraform import yandex_alb_target_group_target.app ds7fh**********d6ob5/10.0.1.10
@@ -0,0 +1,13 @@
+//
+// Add a target to a shared ALB Target Group.
+//
+resource "yandex_alb_target_group_target" "app" {
+  target_group_id = yandex_alb_target_group.shared.id
+  subnet_id       = yandex_vpc_subnet.my-subnet.id
+  ip_address      = yandex_compute_instance.my-instance.network_interface.0.ip_address
+}
+
+resource "yandex_alb_target_group" "shared" {
+  name               = "shared-target-group"
+  targets_management = "external"
+}
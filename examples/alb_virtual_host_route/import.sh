@@ -0,0 +1,2 @@
+# terraform import yandex_alb_virtual_host_route.<resource Name> <http router Id>/<virtual host name>/<route name>
+terraform import yandex_alb_virtual_host_route.api ds7fh**********d6ob5/my-virtual-host/api
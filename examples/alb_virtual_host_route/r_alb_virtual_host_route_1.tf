@@ -0,0 +1,36 @@
+//
+// Add routes to a shared ALB Virtual Host.
+//
+resource "yandex_alb_virtual_host_route" "default" {
+  virtual_host_id = yandex_alb_virtual_host.shared.id
+  name            = "default"
+
+  http_route {
+    http_route_action {
+      backend_group_id = yandex_alb_backend_group.default.id
+    }
+  }
+}
+
+resource "yandex_alb_virtual_host_route" "api" {
+  virtual_host_id = yandex_alb_virtual_host.shared.id
+  name            = "api"
+  before          = yandex_alb_virtual_host_route.default.name
+
+  http_route {
+    http_match {
+      path {
+        prefix = "/api/"
+      }
+    }
+    http_route_action {
+      backend_group_id = yandex_alb_backend_group.api.id
+    }
+  }
+}
+
+resource "yandex_alb_virtual_host" "shared" {
+  name              = "shared-virtual-host"
+  http_router_id    = yandex_alb_http_router.my-router.id
+  routes_management = "external"
+}
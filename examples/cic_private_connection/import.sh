@@ -0,0 +1,2 @@
+# terraform import yandex_cic_private_connection.<resource Name> <resource Id>
+terraform import yandex_cic_private_connection.dc cf3**********0v7e7h
@@ -0,0 +1,21 @@
+//
+// Create a new Cloud Interconnect Private Connection.
+//
+resource "yandex_cic_private_connection" "dc" {
+  name                = "dc-private-connection"
+  trunk_connection_id = "cf3**********lgb3s2"
+  vlan_id             = 1042
+
+  ipv4_peering = {
+    peering_subnet   = "192.168.42.0/30"
+    peer_ip          = "192.168.42.1"
+    cloud_ip         = "192.168.42.2"
+    peer_bgp_asn     = 65001
+    peer_bgp_md5_key = var.bgp_md5_key
+  }
+
+  ipv4_static_routes = [
+    "10.100.0.0/16",
+    "10.101.0.0/16",
+  ]
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_cloudrouter_prefix_announcement.<resource Name> <routing instance Id>:<VPC network Id>:<availability zone Id>:<prefix>
+terraform import yandex_cloudrouter_prefix_announcement.subnet-a cf3**********1mrt3p:enp**********6j7g8:ru-central1-a:10.10.0.0/24
@@ -0,0 +1,15 @@
+//
+// Announce a subnet of the VPC network to the on-premise network.
+//
+resource "yandex_cloudrouter_prefix_announcement" "subnet-a" {
+  routing_instance_id = yandex_cloudrouter_routing_instance.dc.id
+  vpc_network_id      = yandex_vpc_network.my-network.id
+  az_id               = yandex_vpc_subnet.subnet-a.zone
+  prefix              = yandex_vpc_subnet.subnet-a.v4_cidr_blocks[0]
+}
+
+resource "yandex_vpc_subnet" "subnet-a" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.my-network.id
+  v4_cidr_blocks = ["10.10.0.0/24"]
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_cloudrouter_routing_instance.<resource Name> <resource Id>
+terraform import yandex_cloudrouter_routing_instance.dc cf3**********1mrt3p
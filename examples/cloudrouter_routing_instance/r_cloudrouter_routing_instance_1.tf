@@ -0,0 +1,12 @@
+//
+// Connect a VPC network with a Cloud Interconnect Private Connection.
+//
+resource "yandex_cloudrouter_routing_instance" "dc" {
+  name                       = "dc-routing-instance"
+  vpc_network_ids            = [yandex_vpc_network.my-network.id]
+  cic_private_connection_ids = [yandex_cic_private_connection.dc.id]
+}
+
+resource "yandex_vpc_network" "my-network" {
+  name = "my-network"
+}
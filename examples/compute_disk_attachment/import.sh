@@ -0,0 +1,2 @@
+# terraform import yandex_compute_disk_attachment.<resource Name> <instance Id>/<disk Id>
+terraform import yandex_compute_disk_attachment.data fhmrm**********90r5f/fhm1l**********0bd9
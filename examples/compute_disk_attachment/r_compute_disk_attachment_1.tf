@@ -0,0 +1,16 @@
+//
+// Attach a Compute Disk to an existing Compute Instance.
+// The instance must have external_secondary_disks = true, so it doesn't detach the disk.
+//
+resource "yandex_compute_disk" "data" {
+  name = "data-disk"
+  type = "network-ssd"
+  zone = "ru-central1-a"
+  size = 20
+}
+
+resource "yandex_compute_disk_attachment" "data" {
+  instance_id = yandex_compute_instance.default.id
+  disk_id     = yandex_compute_disk.data.id
+  device_name = "data"
+}
@@ -0,0 +1,10 @@
+//
+// Get information about existing Host Group and its hosts.
+//
+data "yandex_compute_host_group" "my_host_group" {
+  host_group_id = "some_host_group_id"
+}
+
+output "host_ids" {
+  value = data.yandex_compute_host_group.my_host_group.hosts[*].id
+}
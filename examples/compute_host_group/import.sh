@@ -0,0 +1,2 @@
+# terraform import yandex_compute_host_group.<resource Name> <resource Id>
+terraform import yandex_compute_host_group.my_host_group fv4hd**********2f8l6
@@ -0,0 +1,31 @@
+//
+// Create a new Compute Host Group and place an instance to its hosts.
+//
+resource "yandex_compute_host_group" "my_host_group" {
+  name               = "host-group-name"
+  zone               = "ru-central1-a"
+  type_id            = "intel-6338-c108-m704-n3200x6"
+  maintenance_policy = "restart"
+
+  scale_policy {
+    fixed_scale {
+      size = 2
+    }
+  }
+
+  labels = {
+    environment = "test"
+  }
+}
+
+resource "yandex_compute_instance" "default" {
+  # ...
+
+  placement_policy {
+    host_affinity_rules {
+      key    = "yc.hostGroupId"
+      op     = "IN"
+      values = [yandex_compute_host_group.my_host_group.id]
+    }
+  }
+}
@@ -0,0 +1,13 @@
+//
+// Create a new Compute Image from a local file.
+//
+resource "yandex_compute_image" "packer-image" {
+  name             = "my-packer-image"
+  source_file      = "output/ubuntu.qcow2"
+  source_file_hash = filesha256("output/ubuntu.qcow2")
+
+  source_file_staging {
+    bucket = "my-images-bucket"
+    key    = "staging/ubuntu.qcow2"
+  }
+}
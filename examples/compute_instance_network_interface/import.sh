@@ -0,0 +1,2 @@
+# terraform import yandex_compute_instance_network_interface.<resource Name> <instance Id>/<network interface index>
+terraform import yandex_compute_instance_network_interface.second fhmrm**********90r5f/1
@@ -0,0 +1,18 @@
+//
+// Attach a second network interface with a public address to an existing Compute Instance.
+// The instance must have external_network_interfaces = true, so it doesn't detach the interface.
+//
+resource "yandex_compute_instance_network_interface" "second" {
+  instance_id        = yandex_compute_instance.default.id
+  index              = 1
+  subnet_id          = yandex_vpc_subnet.backend.id
+  security_group_ids = [yandex_vpc_security_group.backend.id]
+  nat                = true
+
+  dns_record {
+    fqdn = "backend.example.internal."
+    ttl  = 300
+  }
+
+  allow_stopping_for_update = true
+}
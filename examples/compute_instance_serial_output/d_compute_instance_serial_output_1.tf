@@ -0,0 +1,10 @@
+//
+// Get the serial port output of an existing Compute Instance.
+//
+data "yandex_compute_instance_serial_output" "my_instance_output" {
+  instance_id = "some_instance_id"
+}
+
+output "boot_log" {
+  value = data.yandex_compute_instance_serial_output.my_instance_output.contents
+}
@@ -0,0 +1,10 @@
+//
+// Get information about existing Reserved Instance Pool.
+//
+data "yandex_compute_reserved_instance_pool" "my_pool" {
+  reserved_instance_pool_id = "some_reserved_instance_pool_id"
+}
+
+output "pool_size" {
+  value = data.yandex_compute_reserved_instance_pool.my_pool.size
+}
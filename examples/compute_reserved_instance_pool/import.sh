@@ -0,0 +1,2 @@
+# terraform import yandex_compute_reserved_instance_pool.<resource Name> <resource Id>
+terraform import yandex_compute_reserved_instance_pool.my_pool fv4ro**********5kq2m
@@ -0,0 +1,32 @@
+//
+// Create a new Compute Reserved Instance Pool and place an instance to it.
+//
+resource "yandex_compute_reserved_instance_pool" "my_pool" {
+  name        = "reserved-pool-name"
+  zone        = "ru-central1-a"
+  platform_id = "standard-v3"
+
+  resources_spec {
+    cores  = 2
+    memory = 4
+  }
+
+  size = 3
+
+  labels = {
+    environment = "test"
+  }
+}
+
+resource "yandex_compute_instance" "default" {
+  # ...
+  zone        = "ru-central1-a"
+  platform_id = "standard-v3"
+
+  resources {
+    cores  = 2
+    memory = 4
+  }
+
+  reserved_instance_pool_id = yandex_compute_reserved_instance_pool.my_pool.id
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_compute_snapshot_copy.<resource Name> <resource Id>
+terraform import yandex_compute_snapshot_copy.staging fd8hc**********o4qe2
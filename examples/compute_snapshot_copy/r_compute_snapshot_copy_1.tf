@@ -0,0 +1,23 @@
+//
+// Copy the latest scheduled snapshot of a production disk to the staging folder
+// and restore a disk from the copy.
+//
+data "yandex_compute_snapshots" "production" {
+  folder_id            = "production-folder-id"
+  snapshot_schedule_id = yandex_compute_snapshot_schedule.production.id
+  source_disk_id       = yandex_compute_disk.production_db.id
+}
+
+resource "yandex_compute_snapshot_copy" "staging" {
+  name               = "staging-db"
+  source_snapshot_id = data.yandex_compute_snapshots.production.latest_snapshot_id
+  folder_id          = "staging-folder-id"
+  zone               = "ru-central1-a"
+}
+
+resource "yandex_compute_disk" "staging_db" {
+  name        = "staging-db"
+  folder_id   = "staging-folder-id"
+  zone        = "ru-central1-a"
+  snapshot_id = yandex_compute_snapshot_copy.staging.id
+}
@@ -0,0 +1,13 @@
+//
+// Restore a disk from the latest snapshot created by a schedule.
+//
+data "yandex_compute_snapshots" "db" {
+  snapshot_schedule_id = yandex_compute_snapshot_schedule.default.id
+  source_disk_id       = yandex_compute_disk.db.id
+}
+
+resource "yandex_compute_disk" "db_restored" {
+  name        = "db-restored"
+  zone        = "ru-central1-a"
+  snapshot_id = data.yandex_compute_snapshots.db.latest_snapshot_id
+}
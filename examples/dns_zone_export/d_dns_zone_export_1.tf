@@ -0,0 +1,11 @@
+//
+// Back up the records of a zone to a zone file.
+//
+data "yandex_dns_zone_export" "backup" {
+  dns_zone_id = yandex_dns_zone.example.id
+}
+
+resource "local_file" "backup" {
+  filename = "${path.module}/${data.yandex_dns_zone_export.backup.zone}zone"
+  content  = data.yandex_dns_zone_export.backup.content
+}
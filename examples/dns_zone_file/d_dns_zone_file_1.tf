@@ -0,0 +1,21 @@
+//
+// Migrate the records of a zone from a BIND zone file.
+//
+data "yandex_dns_zone_file" "legacy" {
+  content = file("${path.module}/example.com.zone")
+  origin  = "example.com."
+}
+
+resource "yandex_dns_zone_records" "migrated" {
+  zone_id = yandex_dns_zone.example.id
+
+  dynamic "record" {
+    for_each = data.yandex_dns_zone_file.legacy.records
+    content {
+      name = record.value.name
+      type = record.value.type
+      ttl  = record.value.ttl
+      data = record.value.data
+    }
+  }
+}
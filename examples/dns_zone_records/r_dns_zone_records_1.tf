@@ -0,0 +1,34 @@
+//
+// Manage all records of a public zone with a single resource.
+//
+resource "yandex_dns_zone" "public" {
+  name   = "example-public-zone"
+  zone   = "example.com."
+  public = true
+}
+
+resource "yandex_dns_zone_records" "public" {
+  zone_id   = yandex_dns_zone.public.id
+  exclusive = true
+
+  record {
+    name = "@"
+    type = "A"
+    ttl  = 300
+    data = ["203.0.113.10"]
+  }
+
+  record {
+    name = "www"
+    type = "CNAME"
+    ttl  = 300
+    data = ["example.com."]
+  }
+
+  record {
+    name = "@"
+    type = "MX"
+    ttl  = 3600
+    data = ["10 mx1.example.com.", "20 mx2.example.com."]
+  }
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_lb_network_load_balancer_listener.<resource Name> <network load balancer Id>/<listener name>
+terraform import yandex_lb_network_load_balancer_listener.http enp1g**********ghq5m/http
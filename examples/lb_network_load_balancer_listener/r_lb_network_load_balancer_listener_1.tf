@@ -0,0 +1,18 @@
+//
+// Add a listener to a shared Network Load Balancer.
+//
+resource "yandex_lb_network_load_balancer_listener" "http" {
+  network_load_balancer_id = yandex_lb_network_load_balancer.shared.id
+  name                     = "http"
+  port                     = 80
+  target_port              = 8080
+
+  external_address_spec {
+    ip_version = "ipv4"
+  }
+}
+
+resource "yandex_lb_network_load_balancer" "shared" {
+  name                   = "shared-nlb"
+  attachments_management = "external"
+}
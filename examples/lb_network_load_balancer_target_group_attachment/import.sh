@@ -0,0 +1,2 @@
+# terraform import yandex_lb_network_load_balancer_target_group_attachment.<resource Name> <network load balancer Id>/<target group Id>
+terraform import yandex_lb_network_load_balancer_target_group_attachment.web enp1g**********ghq5m/enpt2**********q3ke
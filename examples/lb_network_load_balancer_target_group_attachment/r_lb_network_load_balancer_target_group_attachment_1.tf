@@ -0,0 +1,20 @@
+//
+// Attach a target group to a shared Network Load Balancer.
+//
+resource "yandex_lb_network_load_balancer_target_group_attachment" "web" {
+  network_load_balancer_id = yandex_lb_network_load_balancer.shared.id
+  target_group_id          = yandex_lb_target_group.web.id
+
+  healthcheck {
+    name = "http"
+    http_options {
+      port = 8080
+      path = "/ping"
+    }
+  }
+}
+
+resource "yandex_lb_network_load_balancer" "shared" {
+  name                   = "shared-nlb"
+  attachments_management = "external"
+}
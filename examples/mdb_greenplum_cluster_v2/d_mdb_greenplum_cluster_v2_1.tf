@@ -0,0 +1,7 @@
+data "yandex_mdb_greenplum_cluster_v2" "foo" {
+  name = "test"
+}
+
+output "segment_hosts" {
+  value = data.yandex_mdb_greenplum_cluster_v2.foo.segment_hosts
+}
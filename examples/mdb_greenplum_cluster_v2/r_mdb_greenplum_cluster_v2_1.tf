@@ -0,0 +1,86 @@
+//
+// Create a new MDB Greenplum Cluster (v2).
+//
+resource "yandex_mdb_greenplum_cluster_v2" "my_cluster" {
+  name             = "test"
+  environment      = "PRESTABLE"
+  network_id       = yandex_vpc_network.foo.id
+  zone_id          = "ru-central1-a"
+  subnet_id        = yandex_vpc_subnet.foo.id
+  assign_public_ip = false
+  version          = "6.25"
+
+  master_host_count  = 2
+  segment_host_count = 5
+  segment_in_host    = 1
+
+  master_subcluster = {
+    resources = {
+      resource_preset_id = "s2.micro"
+      disk_size          = 24
+      disk_type_id       = "network-ssd"
+    }
+  }
+  segment_subcluster = {
+    resources = {
+      resource_preset_id = "s2.micro"
+      disk_size          = 24
+      disk_type_id       = "network-ssd"
+    }
+  }
+
+  user_name     = "admin_user"
+  user_password = "your_super_secret_password"
+
+  greenplum_config = {
+    max_connections         = 395
+    gp_workfile_compression = false
+    log_statement           = "DDL"
+  }
+
+  pooler_config = {
+    mode = "TRANSACTION"
+    size = 10
+  }
+
+  pxf_config = {
+    connection_timeout = 600
+    max_threads        = 100
+  }
+
+  background_activities = {
+    analyze_and_vacuum = {
+      start_time      = "22:00"
+      analyze_timeout = 3600
+      vacuum_timeout  = 3600
+    }
+  }
+
+  access = {
+    web_sql = true
+  }
+}
+
+resource "yandex_mdb_greenplum_resource_group" "analytics" {
+  cluster_id          = yandex_mdb_greenplum_cluster_v2.my_cluster.id
+  name                = "analytics"
+  concurrency         = 10
+  cpu_rate_limit      = 20
+  memory_limit        = 20
+  memory_shared_quota = 50
+}
+
+resource "yandex_mdb_greenplum_user" "analyst" {
+  cluster_id     = yandex_mdb_greenplum_cluster_v2.my_cluster.id
+  name           = "analyst"
+  password       = "password"
+  resource_group = yandex_mdb_greenplum_resource_group.analytics.name
+}
+
+resource "yandex_vpc_network" "foo" {}
+
+resource "yandex_vpc_subnet" "foo" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.foo.id
+  v4_cidr_blocks = ["10.5.0.0/24"]
+}
@@ -0,0 +1,39 @@
+//
+// Move an existing MDB Greenplum Cluster to the v2 resource without recreation.
+//
+moved {
+  from = yandex_mdb_greenplum_cluster.my_cluster
+  to   = yandex_mdb_greenplum_cluster_v2.my_cluster
+}
+
+resource "yandex_mdb_greenplum_cluster_v2" "my_cluster" {
+  name             = "test"
+  environment      = "PRESTABLE"
+  network_id       = yandex_vpc_network.foo.id
+  zone_id          = "ru-central1-a"
+  subnet_id        = yandex_vpc_subnet.foo.id
+  assign_public_ip = false
+  version          = "6.25"
+
+  master_host_count  = 2
+  segment_host_count = 5
+  segment_in_host    = 1
+
+  master_subcluster = {
+    resources = {
+      resource_preset_id = "s2.micro"
+      disk_size          = 24
+      disk_type_id       = "network-ssd"
+    }
+  }
+  segment_subcluster = {
+    resources = {
+      resource_preset_id = "s2.micro"
+      disk_size          = 24
+      disk_type_id       = "network-ssd"
+    }
+  }
+
+  user_name     = "admin_user"
+  user_password = "your_super_secret_password"
+}
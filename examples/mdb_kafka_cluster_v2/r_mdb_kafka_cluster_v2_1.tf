@@ -0,0 +1,69 @@
+//
+// Create a new MDB Kafka Cluster (v2).
+//
+resource "yandex_mdb_kafka_cluster_v2" "my_cluster" {
+  name        = "test"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.foo.id
+  subnet_ids  = [yandex_vpc_subnet.foo.id]
+
+  version          = "3.6"
+  brokers_count    = 1
+  zones            = ["ru-central1-a"]
+  assign_public_ip = false
+  schema_registry  = false
+
+  kafka = {
+    resources = {
+      resource_preset_id = "s2.micro"
+      disk_type_id       = "network-ssd"
+      disk_size          = 32
+    }
+    kafka_config = {
+      compression_type        = "COMPRESSION_TYPE_ZSTD"
+      log_retention_ms        = 86400000
+      num_partitions          = 10
+      sasl_enabled_mechanisms = "SASL_MECHANISM_SCRAM_SHA_256,SASL_MECHANISM_SCRAM_SHA_512"
+    }
+  }
+
+  rest_api = {
+    enabled = true
+  }
+
+  kafka_ui = {
+    enabled = true
+  }
+
+  maintenance_window = {
+    type = "WEEKLY"
+    day  = "MON"
+    hour = 3
+  }
+}
+
+resource "yandex_mdb_kafka_topic" "events" {
+  cluster_id         = yandex_mdb_kafka_cluster_v2.my_cluster.id
+  name               = "events"
+  partitions         = 4
+  replication_factor = 1
+}
+
+resource "yandex_mdb_kafka_user" "producer" {
+  cluster_id = yandex_mdb_kafka_cluster_v2.my_cluster.id
+  name       = "producer-application"
+  password   = "password"
+
+  permission {
+    topic_name = yandex_mdb_kafka_topic.events.name
+    role       = "ACCESS_ROLE_PRODUCER"
+  }
+}
+
+resource "yandex_vpc_network" "foo" {}
+
+resource "yandex_vpc_subnet" "foo" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.foo.id
+  v4_cidr_blocks = ["10.5.0.0/24"]
+}
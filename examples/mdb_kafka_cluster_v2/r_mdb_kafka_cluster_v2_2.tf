@@ -0,0 +1,27 @@
+//
+// Move an existing MDB Kafka Cluster to the v2 resource without recreation.
+// Inline `topic` and `user` blocks of the old resource must be replaced with
+// `yandex_mdb_kafka_topic` and `yandex_mdb_kafka_user` resources beforehand.
+//
+moved {
+  from = yandex_mdb_kafka_cluster.my_cluster
+  to   = yandex_mdb_kafka_cluster_v2.my_cluster
+}
+
+resource "yandex_mdb_kafka_cluster_v2" "my_cluster" {
+  name        = "test"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.foo.id
+  subnet_ids  = [yandex_vpc_subnet.foo.id]
+
+  version = "3.6"
+  zones   = ["ru-central1-a"]
+
+  kafka = {
+    resources = {
+      resource_preset_id = "s2.micro"
+      disk_type_id       = "network-ssd"
+      disk_size          = 32
+    }
+  }
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_mdb_mongodb_cluster_v2.<resource Name> <resource Id>
+terraform import yandex_mdb_mongodb_cluster_v2.my_cluster ...
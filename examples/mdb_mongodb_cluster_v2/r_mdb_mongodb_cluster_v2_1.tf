@@ -0,0 +1,60 @@
+//
+// Create a new MDB MongoDB Cluster (v2).
+//
+resource "yandex_mdb_mongodb_cluster_v2" "my_cluster" {
+  name        = "test"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.foo.id
+
+  version = "6.0"
+
+  mongod = {
+    resources = {
+      resource_preset_id = "s2.small"
+      disk_type_id       = "network-ssd"
+      disk_size          = 16
+    }
+    config = {
+      cache_size_gb     = 1.5
+      slow_op_threshold = 200
+      mode              = "SLOW_OP"
+    }
+  }
+
+  hosts = {
+    "a" = {
+      zone_id   = "ru-central1-a"
+      subnet_id = yandex_vpc_subnet.foo.id
+    }
+  }
+
+  maintenance_window = {
+    type = "WEEKLY"
+    day  = "MON"
+    hour = 3
+  }
+}
+
+resource "yandex_mdb_mongodb_database" "db" {
+  cluster_id = yandex_mdb_mongodb_cluster_v2.my_cluster.id
+  name       = "testdb"
+}
+
+resource "yandex_mdb_mongodb_user" "user" {
+  cluster_id = yandex_mdb_mongodb_cluster_v2.my_cluster.id
+  name       = "john"
+  password   = "password"
+
+  permission {
+    database_name = yandex_mdb_mongodb_database.db.name
+    roles         = ["readWrite"]
+  }
+}
+
+resource "yandex_vpc_network" "foo" {}
+
+resource "yandex_vpc_subnet" "foo" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.foo.id
+  v4_cidr_blocks = ["10.5.0.0/24"]
+}
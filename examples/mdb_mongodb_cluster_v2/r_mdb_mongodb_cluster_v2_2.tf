@@ -0,0 +1,59 @@
+//
+// Create a new sharded MDB MongoDB Cluster (v2).
+// Hosts of types other than MONGOD make the cluster sharded,
+// the first shard is declared with `shard_name` of mongod hosts.
+//
+resource "yandex_mdb_mongodb_cluster_v2" "my_cluster" {
+  name        = "test"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.foo.id
+
+  version = "6.0"
+
+  mongod = {
+    resources = {
+      resource_preset_id = "s2.small"
+      disk_type_id       = "network-ssd"
+      disk_size          = 16
+    }
+  }
+
+  mongoinfra = {
+    resources = {
+      resource_preset_id = "s2.small"
+      disk_type_id       = "network-ssd"
+      disk_size          = 10
+    }
+  }
+
+  hosts = {
+    "rs01-a" = {
+      zone_id    = "ru-central1-a"
+      subnet_id  = yandex_vpc_subnet.foo.id
+      shard_name = "rs01"
+    }
+    "infra-a" = {
+      type      = "MONGOINFRA"
+      zone_id   = "ru-central1-a"
+      subnet_id = yandex_vpc_subnet.foo.id
+    }
+    "infra-b" = {
+      type      = "MONGOINFRA"
+      zone_id   = "ru-central1-a"
+      subnet_id = yandex_vpc_subnet.foo.id
+    }
+    "infra-c" = {
+      type      = "MONGOINFRA"
+      zone_id   = "ru-central1-a"
+      subnet_id = yandex_vpc_subnet.foo.id
+    }
+  }
+}
+
+resource "yandex_vpc_network" "foo" {}
+
+resource "yandex_vpc_subnet" "foo" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.foo.id
+  v4_cidr_blocks = ["10.5.0.0/24"]
+}
@@ -0,0 +1,2 @@
+# terraform import yandex_mdb_mongodb_shard.<resource Name> <cluster Id>:<shard name>
+terraform import yandex_mdb_mongodb_shard.my_shard ...
@@ -0,0 +1,18 @@
+//
+// Add a shard to a sharded MDB MongoDB Cluster (v2).
+//
+resource "yandex_mdb_mongodb_shard" "my_shard" {
+  cluster_id = yandex_mdb_mongodb_cluster_v2.my_cluster.id
+  name       = "rs02"
+
+  hosts = {
+    "a" = {
+      zone_id   = "ru-central1-a"
+      subnet_id = yandex_vpc_subnet.foo.id
+    }
+    "b" = {
+      zone_id   = "ru-central1-b"
+      subnet_id = yandex_vpc_subnet.bar.id
+    }
+  }
+}
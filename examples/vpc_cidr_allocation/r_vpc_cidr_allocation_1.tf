@@ -0,0 +1,26 @@
+//
+// Allocate a /24 for a team environment and create a subnet from it.
+//
+resource "yandex_vpc_cidr_allocation" "team-a" {
+  pool_id       = yandex_vpc_cidr_pool.envs.id
+  supernet      = yandex_vpc_cidr_pool.envs.supernet
+  used_cidrs    = yandex_vpc_cidr_pool.envs.used_cidrs
+  name          = "team-a"
+  prefix_length = 24
+}
+
+resource "yandex_vpc_subnet" "team-a" {
+  network_id     = yandex_vpc_network.lab-net.id
+  zone           = "ru-central1-a"
+  v4_cidr_blocks = [yandex_vpc_cidr_allocation.team-a.cidr]
+}
+
+resource "yandex_vpc_cidr_pool" "envs" {
+  supernet        = "10.100.0.0/16"
+  reserved_ranges = ["10.100.0.0/20"]
+  network_ids     = [yandex_vpc_network.lab-net.id]
+}
+
+resource "yandex_vpc_network" "lab-net" {
+  name = "lab-network"
+}
@@ -0,0 +1,12 @@
+//
+// Create a new VPC CIDR pool.
+//
+resource "yandex_vpc_cidr_pool" "envs" {
+  supernet        = "10.100.0.0/16"
+  reserved_ranges = ["10.100.0.0/20"]
+  network_ids     = [yandex_vpc_network.lab-net.id]
+}
+
+resource "yandex_vpc_network" "lab-net" {
+  name = "lab-network"
+}
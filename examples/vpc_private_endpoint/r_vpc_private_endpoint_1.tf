@@ -11,7 +11,9 @@ resource "yandex_vpc_private_endpoint" "my_pe" {
 
   network_id = yandex_vpc_network.lab-net.id
 
-  object_storage {}
+  service {
+    type = "object_storage"
+  }
 
   dns_options {
     private_dns_records_enabled = true
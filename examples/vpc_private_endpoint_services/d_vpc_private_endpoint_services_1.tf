@@ -0,0 +1,21 @@
+//
+// Create private endpoints for all the services available in the region.
+//
+data "yandex_vpc_private_endpoint_services" "available" {
+  region_id = "ru-central1"
+}
+
+resource "yandex_vpc_private_endpoint" "pe" {
+  for_each = { for s in data.yandex_vpc_private_endpoint_services.available.services : s.type => s }
+
+  name       = "${replace(each.key, "_", "-")}-private-endpoint"
+  network_id = "my-network-id"
+
+  service {
+    type = each.key
+  }
+
+  dns_options {
+    private_dns_records_enabled = true
+  }
+}
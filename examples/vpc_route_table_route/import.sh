@@ -0,0 +1,2 @@
+# terraform import yandex_vpc_route_table_route.<resource Name> <route table Id>/<destination prefix>
+terraform import yandex_vpc_route_table_route.to-lab-b enp1g**********ghq5m/10.2.0.0/16
@@ -0,0 +1,31 @@
+//
+// Add static routes to a VPC Route Table.
+// The route table must have external_static_routes = true, so it doesn't remove the routes.
+//
+resource "yandex_vpc_route_table_route" "to-lab-b" {
+  route_table_id     = yandex_vpc_route_table.lab-rt-a.id
+  destination_prefix = "10.2.0.0/16"
+  next_hop_address   = "172.16.10.10"
+}
+
+resource "yandex_vpc_route_table_route" "egress" {
+  route_table_id     = yandex_vpc_route_table.lab-rt-a.id
+  destination_prefix = "0.0.0.0/0"
+  gateway_id         = yandex_vpc_gateway.egress-gateway.id
+}
+
+// Auxiliary resources
+resource "yandex_vpc_network" "lab-net" {
+  name = "lab-network"
+}
+
+resource "yandex_vpc_route_table" "lab-rt-a" {
+  network_id = yandex_vpc_network.lab-net.id
+
+  external_static_routes = true
+}
+
+resource "yandex_vpc_gateway" "egress-gateway" {
+  name = "egress-gateway"
+  shared_egress_gateway {}
+}
@@ -28,7 +28,7 @@ resource "yandex_vpc_security_group" "sg1" {
   egress {
     protocol       = "UDP"
     description    = "rule3 description"
-    v4_cidr_blocks = ["10.0.1.0/24"]
+    v4_cidr_blocks = ["10.0.3.0/24"]
     from_port      = 8090
     to_port        = 8099
   }
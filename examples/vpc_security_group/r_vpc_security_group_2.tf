@@ -0,0 +1,22 @@
+//
+// Create a new VPC Security Group with rules managed by separate resources.
+//
+resource "yandex_vpc_security_group" "sg2" {
+  name       = "My security group"
+  network_id = yandex_vpc_network.lab-net.id
+
+  rules_management = "external"
+}
+
+resource "yandex_vpc_security_group_rule" "https" {
+  security_group_binding = yandex_vpc_security_group.sg2.id
+  direction              = "ingress"
+  protocol               = "TCP"
+  port                   = 443
+  v4_cidr_blocks         = ["10.0.1.0/24"]
+}
+
+// Auxiliary resources
+resource "yandex_vpc_network" "lab-net" {
+  name = "lab-network"
+}
@@ -0,0 +1,20 @@
+//
+// Check that the application can reach the database on the PostgreSQL port.
+//
+data "yandex_vpc_security_group_evaluation" "app-to-db" {
+  source = {
+    security_group_id = yandex_vpc_security_group.app.id
+  }
+  destination = {
+    instance_id = yandex_compute_instance.db.id
+  }
+  protocol = "TCP"
+  port     = 5432
+}
+
+check "app-to-db" {
+  assert {
+    condition     = data.yandex_vpc_security_group_evaluation.app-to-db.allowed
+    error_message = "The application can't reach the database on port 5432."
+  }
+}
@@ -9,6 +9,8 @@ resource "yandex_vpc_security_group" "group1" {
   labels = {
     my-label = "my-label-value"
   }
+
+  rules_management = "external"
 }
 
 resource "yandex_vpc_security_group_rule" "rule1" {
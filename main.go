@@ -17,7 +17,7 @@ func NewMuxProviderServer(ctx context.Context) (func() tfprotov6.ProviderServer,
 
 	upgradedSdkProvider, _ := tf5to6server.UpgradeServer(
 		context.Background(),
-		yandex.NewSDKProvider().GRPCProvider,
+		yandex.NewSDKProviderServer,
 	)
 
 	providers := []func() tfprotov6.ProviderServer{
@@ -107,6 +107,15 @@ func FindTag(f reflect.StructField, tag, attrTagName string) (string, bool) {
 func (f *ProtobufMapDataAdapter) Fill(ctx context.Context, target any, attributes map[string]attr.Value, diags *diag.Diagnostics) {
 	unhandledAttrs := maps.Clone(attributes)
 	f.fill(ctx, target, unhandledAttrs, diags)
+	if diags.HasError() {
+		return
+	}
+
+	// Nested structs share the attributes map with their parent and consume only their own fields,
+	// so the unmapped attributes are known only after the whole target is filled
+	for key := range unhandledAttrs {
+		diags.AddError("Error protobuf filler", fmt.Sprintf("Attribute %s is not mapped", key))
+	}
 }
 
 func (f *ProtobufMapDataAdapter) fill(ctx context.Context, target any, attributes map[string]attr.Value, diags *diag.Diagnostics) {
@@ -170,10 +179,6 @@ func (f *ProtobufMapDataAdapter) fill(ctx context.Context, target any, attribute
 		targetReflectVal.Field(i).Set(setVal)
 
 	}
-
-	for key := range attributes {
-		diags.AddError("Error protobuf filler", fmt.Sprintf("Attribute %s is not mapped", key))
-	}
 }
 
 func (f *ProtobufMapDataAdapter) mapAttributeToType(ctx context.Context, t reflect.Type, attribute attr.Value, diags *diag.Diagnostics) reflect.Value {
@@ -256,6 +256,66 @@ func TestYandexProvider_MDBCommonProtobufFillOneField(t *testing.T) {
 	}
 }
 
+// nestedFirstMessage has the nested message before the plain fields, as in the MDB config specs
+type nestedFirstMessage struct {
+	Nested     *TestMessage_NestedMessage `protobuf:"bytes,1,opt,name=nested,proto3"`
+	OuterField string                     `protobuf:"bytes,2,opt,name=outer_field,proto3"`
+}
+
+func TestYandexProvider_MDBCommonProtobufFillNestedFirst(t *testing.T) {
+
+	t.Parallel()
+	f := NewProtobufMapDataAdapter()
+	ctx := context.Background()
+
+	cases := []struct {
+		testname      string
+		reqVal        map[string]attr.Value
+		expectedVal   nestedFirstMessage
+		expectedError bool
+	}{
+		{
+			// The nested message must not report the attributes of the following outer fields as unmapped
+			testname: "CheckFillOuterFieldAfterNested",
+			reqVal: map[string]attr.Value{
+				"string_nested_field": types.StringValue("nested_value"),
+				"outer_field":         types.StringValue("outer_value"),
+			},
+			expectedVal: nestedFirstMessage{
+				Nested: &TestMessage_NestedMessage{
+					StringNestedField: "nested_value",
+				},
+				OuterField: "outer_value",
+			},
+		},
+		{
+			testname: "CheckFillUnknownAttribute",
+			reqVal: map[string]attr.Value{
+				"string_nested_field": types.StringValue("nested_value"),
+				"unknown_field":       types.StringValue("value"),
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		var diags diag.Diagnostics
+		obj := nestedFirstMessage{}
+
+		f.Fill(ctx, &obj, c.reqVal, &diags)
+		if c.expectedError != diags.HasError() {
+			if diags.HasError() {
+				t.Errorf("Unexpected fill error in %s: %v\n", c.testname, diags.Errors())
+			}
+			t.Errorf("Unexpected fill error status in %s: expected %v, actual %v", c.testname, c.expectedError, diags.HasError())
+			continue
+		}
+		if !c.expectedError && !reflect.DeepEqual(&obj, &c.expectedVal) {
+			t.Errorf("Unexpected result in %s: expected %+v, actual %+v", c.testname, c.expectedVal, obj)
+		}
+	}
+}
+
 func TestYandexProvider_AdapterProtobufExtract(t *testing.T) {
 	t.Parallel()
 	f := NewProtobufMapDataAdapter()
@@ -34,10 +34,17 @@ type ProtoHostWithShard interface {
 	GetShardName() string
 }
 
+// HostReaderService is an interface that defines the read-only part of API operations involving hosts.
+// It is enough for clusters whose hosts are derived from the cluster topology (e.g. zones and brokers count)
+// and can't be managed one by one.
+type HostReaderService[ProtoHost any] interface {
+	ListHosts(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid string) []ProtoHost
+}
+
 // HostApiService is an interface that defines methods for API operations involving hosts.
 // It is parameterized with types `ProtoHost`, `ProtoHostSpec`, and `UpdateSpec`
 type HostApiService[ProtoHost any, ProtoHostSpec any, UpdateSpec any] interface {
-	ListHosts(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid string) []ProtoHost
+	HostReaderService[ProtoHost]
 	CreateHosts(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid string, specs []ProtoHostSpec)
 	UpdateHosts(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid string, specs []*UpdateSpec)
 	DeleteHosts(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid string, fqdns []string)
@@ -536,3 +543,28 @@ func ReadHosts[T Host, H ProtoHost, HS any, U any](
 
 	return entityIdToApiHosts
 }
+
+// The ReadComputedHosts method is used to update the state of hosts that are fully computed by the API.
+//
+// Every host returned by the API is converted to the host model and put into the state under its FQDN.
+// Unlike ReadHosts, there are no user defined labels to keep, so the result depends only on the API response.
+func ReadComputedHosts[T Host, H ProtoHost](
+	ctx context.Context,
+	sdk *ycsdk.SDK, // The SDK instance to interact with the relevant API.
+	diags *diag.Diagnostics,
+	convert func(H) T, // Converts an API host to the host model.
+	hostsApiService HostReaderService[H], // The API service used to list hosts of the cluster.
+	cid string,
+) map[string]T {
+	apiHosts := hostsApiService.ListHosts(ctx, sdk, diags, cid)
+	if diags.HasError() {
+		return nil
+	}
+
+	fqdnToHost := make(map[string]T, len(apiHosts))
+	for _, apiHost := range apiHosts {
+		fqdnToHost[apiHost.GetName()] = convert(apiHost)
+	}
+
+	return fqdnToHost
+}
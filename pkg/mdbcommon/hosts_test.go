@@ -1,11 +1,13 @@
 package mdbcommon
 
 import (
+	"context"
 	"testing"
 
 	"github.com/hashicorp/terraform-plugin-framework/diag"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/stretchr/testify/assert"
+	ycsdk "github.com/yandex-cloud/go-sdk"
 )
 
 type MockHost struct {
@@ -363,3 +365,61 @@ func TestDeleteHostsDependsOnShards(t *testing.T) {
 		})
 	}
 }
+
+type MockHostReaderService struct {
+	hosts []MockHost
+	err   string
+}
+
+func (m *MockHostReaderService) ListHosts(_ context.Context, _ *ycsdk.SDK, diags *diag.Diagnostics, _ string) []MockHost {
+	if m.err != "" {
+		diags.AddError("Failed to list hosts", m.err)
+		return nil
+	}
+	return m.hosts
+}
+
+func TestReadComputedHosts(t *testing.T) {
+	hostService := &MockCmpHostService{}
+	tests := []struct {
+		name          string
+		apiService    *MockHostReaderService
+		expected      map[string]MockHost
+		expectedError bool
+	}{
+		{
+			name: "Hosts keyed by FQDN",
+			apiService: &MockHostReaderService{
+				hosts: []MockHost{
+					{FQDN: "host1.example.com", ParamANotChanged: "zone-a"},
+					{FQDN: "host2.example.com", ParamANotChanged: "zone-b"},
+				},
+			},
+			expected: map[string]MockHost{
+				"host1.example.com": {FQDN: "host1.example.com", ParamANotChanged: "zone-a"},
+				"host2.example.com": {FQDN: "host2.example.com", ParamANotChanged: "zone-b"},
+			},
+		},
+		{
+			name:       "No hosts",
+			apiService: &MockHostReaderService{},
+			expected:   map[string]MockHost{},
+		},
+		{
+			name:          "API error",
+			apiService:    &MockHostReaderService{err: "unavailable"},
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range tests {
+		t.Run(tc.name, func(t *testing.T) {
+			var diags diag.Diagnostics
+			res := ReadComputedHosts(context.Background(), nil, &diags, hostService.ConvertFromProto, tc.apiService, "cid")
+			assert.Equal(t, tc.expectedError, diags.HasError(), "Unexpected diagnostics: %v", diags)
+			if !tc.expectedError {
+				assert.Equal(t, tc.expected, res, "Unexpected hosts")
+			}
+		})
+	}
+}
@@ -0,0 +1,274 @@
+package mdbcommon
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// SettingKind is a type of the value of a setting in a settings map.
+type SettingKind int
+
+const (
+	SettingKindString SettingKind = iota
+	SettingKindInt
+	SettingKindFloat
+	SettingKindBool
+	SettingKindEnum
+)
+
+func (k SettingKind) String() string {
+	switch k {
+	case SettingKindInt:
+		return "integer"
+	case SettingKindFloat:
+		return "number"
+	case SettingKindBool:
+		return "bool"
+	case SettingKindEnum:
+		return "enum"
+	default:
+		return "string"
+	}
+}
+
+// SettingsAttributeKindProvider is implemented by providers which know all the settings and types of their values.
+// Settings maps of such providers are validated at plan time with SettingsMapValidator.
+type SettingsAttributeKindProvider interface {
+	SettingsAttributeInfoProvider
+	// GetSettingsKinds returns kinds of all the settings, kinds of list settings are kinds of their elements.
+	GetSettingsKinds() map[string]SettingKind
+}
+
+var _ SettingsAttributeKindProvider = &ProtoSettingsAttributeInfoProvider{}
+
+// ProtoSettingsAttributeInfoProvider is generated from the descriptors of protobuf config messages,
+// so the settings of a map are exactly the fields which the protobuf adapter can fill.
+//
+// Keys are the names of the message fields. Fields of nested messages are keys of the same level,
+// the same way the protobuf adapter maps them. Oneof, map and bytes fields are not settings.
+type ProtoSettingsAttributeInfoProvider struct {
+	enumNames     map[string]map[int32]string
+	enumValues    map[string]map[string]int32
+	setAttributes map[string]struct{}
+	kinds         map[string]SettingKind
+}
+
+// NewProtoSettingsAttributeInfoProvider generates the provider from the messages and their nested messages.
+//
+// Several messages (e.g. configs of different versions) are merged.
+// If a field is defined by several messages, the definition of the first one is used.
+func NewProtoSettingsAttributeInfoProvider(msgs ...proto.Message) *ProtoSettingsAttributeInfoProvider {
+	return newProtoSettingsAttributeInfoProvider(true, msgs...)
+}
+
+// NewShallowProtoSettingsAttributeInfoProvider generates the provider from the fields of the messages only,
+// nested messages are configured with other settings maps.
+func NewShallowProtoSettingsAttributeInfoProvider(msgs ...proto.Message) *ProtoSettingsAttributeInfoProvider {
+	return newProtoSettingsAttributeInfoProvider(false, msgs...)
+}
+
+func newProtoSettingsAttributeInfoProvider(nested bool, msgs ...proto.Message) *ProtoSettingsAttributeInfoProvider {
+	p := &ProtoSettingsAttributeInfoProvider{
+		enumNames:     map[string]map[int32]string{},
+		enumValues:    map[string]map[string]int32{},
+		setAttributes: map[string]struct{}{},
+		kinds:         map[string]SettingKind{},
+	}
+	for _, m := range msgs {
+		p.addMessage(m.ProtoReflect().Descriptor(), nested)
+	}
+	return p
+}
+
+var wrapperKinds = map[protoreflect.FullName]SettingKind{
+	"google.protobuf.BoolValue":   SettingKindBool,
+	"google.protobuf.DoubleValue": SettingKindFloat,
+	"google.protobuf.FloatValue":  SettingKindFloat,
+	"google.protobuf.Int32Value":  SettingKindInt,
+	"google.protobuf.Int64Value":  SettingKindInt,
+	"google.protobuf.StringValue": SettingKindString,
+}
+
+func (p *ProtoSettingsAttributeInfoProvider) addMessage(md protoreflect.MessageDescriptor, nested bool) {
+	fields := md.Fields()
+	for i := 0; i < fields.Len(); i++ {
+		fd := fields.Get(i)
+		if oneof := fd.ContainingOneof(); oneof != nil && !oneof.IsSynthetic() {
+			continue
+		}
+		if fd.IsMap() {
+			continue
+		}
+
+		name := string(fd.Name())
+		if _, ok := p.kinds[name]; ok {
+			continue
+		}
+
+		var kind SettingKind
+		switch fd.Kind() {
+		case protoreflect.MessageKind, protoreflect.GroupKind:
+			wk, ok := wrapperKinds[fd.Message().FullName()]
+			if !ok {
+				if nested && !fd.IsList() {
+					p.addMessage(fd.Message(), nested)
+				}
+				continue
+			}
+			kind = wk
+		case protoreflect.EnumKind:
+			kind = SettingKindEnum
+			enumName := name
+			if fd.IsList() {
+				enumName = name + ".element"
+			}
+			p.enumNames[enumName], p.enumValues[enumName] = enumMaps(fd.Enum())
+		case protoreflect.BoolKind:
+			kind = SettingKindBool
+		case protoreflect.StringKind:
+			kind = SettingKindString
+		case protoreflect.DoubleKind, protoreflect.FloatKind:
+			kind = SettingKindFloat
+		case protoreflect.BytesKind:
+			continue
+		default:
+			kind = SettingKindInt
+		}
+
+		p.kinds[name] = kind
+		if fd.IsList() {
+			p.setAttributes[name] = struct{}{}
+		}
+	}
+}
+
+func enumMaps(ed protoreflect.EnumDescriptor) (map[int32]string, map[string]int32) {
+	names := map[int32]string{}
+	values := map[string]int32{}
+	for i := 0; i < ed.Values().Len(); i++ {
+		v := ed.Values().Get(i)
+		names[int32(v.Number())] = string(v.Name())
+		values[string(v.Name())] = int32(v.Number())
+	}
+	return names, values
+}
+
+func (p *ProtoSettingsAttributeInfoProvider) GetSettingsEnumNames() map[string]map[int32]string {
+	return p.enumNames
+}
+
+func (p *ProtoSettingsAttributeInfoProvider) GetSettingsEnumValues() map[string]map[string]int32 {
+	return p.enumValues
+}
+
+func (p *ProtoSettingsAttributeInfoProvider) GetSetAttributes() map[string]struct{} {
+	return p.setAttributes
+}
+
+func (p *ProtoSettingsAttributeInfoProvider) GetSettingsKinds() map[string]SettingKind {
+	return p.kinds
+}
+
+// ValidateSetting checks that the setting is known and its value can be converted to the setting type.
+func ValidateSetting(p SettingsAttributeKindProvider, name, value string) error {
+	kind, ok := p.GetSettingsKinds()[name]
+	if !ok && len(p.GetSettingsKinds()) == 0 {
+		return fmt.Errorf("unknown setting %q, no settings are supported", name)
+	}
+	if !ok {
+		return fmt.Errorf("unknown setting %q, allowed settings are: %s", name, strings.Join(sortedKeys(p.GetSettingsKinds()), ", "))
+	}
+
+	if _, ok := p.GetSetAttributes()[name]; ok {
+		for _, el := range strings.Split(value, ",") {
+			if err := validateSettingValue(p, name+".element", kind, el); err != nil {
+				return fmt.Errorf("setting %q: %w", name, err)
+			}
+		}
+		return nil
+	}
+
+	if err := validateSettingValue(p, name, kind, value); err != nil {
+		return fmt.Errorf("setting %q: %w", name, err)
+	}
+	return nil
+}
+
+func validateSettingValue(p SettingsAttributeKindProvider, name string, kind SettingKind, value string) error {
+	var err error
+	switch kind {
+	case SettingKindInt:
+		_, err = strconv.ParseInt(value, 10, 64)
+	case SettingKindFloat:
+		_, err = strconv.ParseFloat(value, 64)
+	case SettingKindBool:
+		_, err = strconv.ParseBool(value)
+	case SettingKindEnum:
+		values := p.GetSettingsEnumValues()[name]
+		if _, ok := values[value]; !ok {
+			return fmt.Errorf("invalid value %q, allowed values are: %s", value, strings.Join(sortedKeys(values), ", "))
+		}
+	}
+
+	if err != nil {
+		return fmt.Errorf("value %q is not a valid %s", value, kind)
+	}
+	return nil
+}
+
+func sortedKeys[V any](m map[string]V) []string {
+	keys := make([]string, 0, len(m))
+	for k := range m {
+		keys = append(keys, k)
+	}
+	slices.Sort(keys)
+	return keys
+}
+
+var _ validator.Map = settingsMapValidator{}
+
+type settingsMapValidator struct {
+	p SettingsAttributeKindProvider
+}
+
+// SettingsMapValidator rejects unknown settings, values of wrong types and unknown enum values at plan time.
+func SettingsMapValidator(p SettingsAttributeKindProvider) validator.Map {
+	return settingsMapValidator{p: p}
+}
+
+func (v settingsMapValidator) Description(_ context.Context) string {
+	return "settings must be known and have values of the setting types"
+}
+
+func (v settingsMapValidator) MarkdownDescription(ctx context.Context) string {
+	return v.Description(ctx)
+}
+
+func (v settingsMapValidator) ValidateMap(ctx context.Context, req validator.MapRequest, resp *validator.MapResponse) {
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	for _, name := range sortedKeys(req.ConfigValue.Elements()) {
+		val, ok := req.ConfigValue.Elements()[name].(types.String)
+		if !ok || val.IsNull() || val.IsUnknown() {
+			continue
+		}
+
+		if err := ValidateSetting(v.p, name, val.ValueString()); err != nil {
+			resp.Diagnostics.AddAttributeError(
+				req.Path.AtMapKey(name),
+				"Invalid setting",
+				err.Error(),
+			)
+		}
+	}
+}
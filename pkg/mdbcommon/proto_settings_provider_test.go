@@ -0,0 +1,225 @@
+package mdbcommon
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	config "github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1/config"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/spqr/v1"
+)
+
+func TestYandexProvider_MDBProtoSettingsProviderKinds(t *testing.T) {
+	t.Parallel()
+
+	p := NewProtoSettingsAttributeInfoProvider(&config.PostgresqlConfig14{})
+
+	cases := map[string]SettingKind{
+		"max_connections":               SettingKindInt,
+		"search_path":                   SettingKindString,
+		"row_security":                  SettingKindBool,
+		"random_page_cost":              SettingKindFloat,
+		"default_transaction_isolation": SettingKindEnum,
+		"shared_preload_libraries":      SettingKindEnum,
+	}
+
+	for name, expected := range cases {
+		if kind, ok := p.GetSettingsKinds()[name]; !ok || kind != expected {
+			t.Errorf("Unexpected kind of %s: expected %s, actual %s (found %t)", name, expected, kind, ok)
+		}
+	}
+
+	if _, ok := p.GetSetAttributes()["shared_preload_libraries"]; !ok {
+		t.Errorf("shared_preload_libraries should be a list setting")
+	}
+	if _, ok := p.GetSettingsEnumValues()["shared_preload_libraries.element"]; !ok {
+		t.Errorf("Enum values of shared_preload_libraries elements should be known")
+	}
+	if !reflect.DeepEqual(p.GetSettingsEnumNames()["default_transaction_isolation"], config.PostgresqlConfig14_TransactionIsolation_name) {
+		t.Errorf("Unexpected enum names of default_transaction_isolation")
+	}
+}
+
+func TestYandexProvider_MDBProtoSettingsProviderNested(t *testing.T) {
+	t.Parallel()
+
+	nested := NewProtoSettingsAttributeInfoProvider(&spqr.SpqrSpec_Router{})
+	for _, name := range []string{"show_notice_messages", "time_quantiles", "resource_preset_id", "disk_size"} {
+		if _, ok := nested.GetSettingsKinds()[name]; !ok {
+			t.Errorf("Setting %s of a nested message should be known", name)
+		}
+	}
+
+	shallow := NewShallowProtoSettingsAttributeInfoProvider(&spqr.SpqrSpec_Router{})
+	if len(shallow.GetSettingsKinds()) != 0 {
+		t.Errorf("Settings of nested messages shouldn't be known, got %v", sortedKeys(shallow.GetSettingsKinds()))
+	}
+}
+
+func TestYandexProvider_MDBProtoSettingsProviderValidate(t *testing.T) {
+	t.Parallel()
+
+	p := NewProtoSettingsAttributeInfoProvider(&config.PostgresqlConfig14{})
+
+	cases := []struct {
+		testname      string
+		name          string
+		value         string
+		expectedError string
+	}{
+		{
+			testname: "CheckValidValues",
+			name:     "max_connections",
+			value:    "100",
+		},
+		{
+			testname: "CheckValidFloat",
+			name:     "random_page_cost",
+			value:    "1.5",
+		},
+		{
+			testname: "CheckValidEnumList",
+			name:     "shared_preload_libraries",
+			value:    "SHARED_PRELOAD_LIBRARIES_AUTO_EXPLAIN,SHARED_PRELOAD_LIBRARIES_PG_HINT_PLAN",
+		},
+		{
+			testname:      "CheckUnknownSetting",
+			name:          "max_connection",
+			value:         "100",
+			expectedError: `unknown setting "max_connection", allowed settings are: `,
+		},
+		{
+			testname:      "CheckWrongType",
+			name:          "row_security",
+			value:         "yes",
+			expectedError: `setting "row_security": value "yes" is not a valid bool`,
+		},
+		{
+			testname:      "CheckUnknownEnumValue",
+			name:          "default_transaction_isolation",
+			value:         "SERIALIZABLE",
+			expectedError: `setting "default_transaction_isolation": invalid value "SERIALIZABLE", allowed values are: `,
+		},
+		{
+			testname:      "CheckUnknownEnumListValue",
+			name:          "shared_preload_libraries",
+			value:         "SHARED_PRELOAD_LIBRARIES_AUTO_EXPLAIN,AUTO_EXPLAIN",
+			expectedError: `setting "shared_preload_libraries": invalid value "AUTO_EXPLAIN"`,
+		},
+	}
+
+	for _, c := range cases {
+		err := ValidateSetting(p, c.name, c.value)
+		if c.expectedError == "" {
+			if err != nil {
+				t.Errorf("Unexpected validation error in test case %s: %v", c.testname, err)
+			}
+			continue
+		}
+
+		if err == nil || !strings.HasPrefix(err.Error(), c.expectedError) {
+			t.Errorf("Unexpected validation error in test case %s: expected prefix %q, actual %v", c.testname, c.expectedError, err)
+		}
+	}
+
+	err := ValidateSetting(p, "max_connection", "100")
+	if err == nil || !strings.Contains(err.Error(), "max_connections") {
+		t.Errorf("Allowed settings should be listed in the error: %v", err)
+	}
+
+	err = ValidateSetting(NewProtoSettingsAttributeInfoProvider(&spqr.CoordinatorSettings{}), "any", "1")
+	if err == nil || err.Error() != `unknown setting "any", no settings are supported` {
+		t.Errorf("Unexpected validation error for a map without settings: %v", err)
+	}
+}
+
+func TestYandexProvider_MDBSettingsMapValidator(t *testing.T) {
+	t.Parallel()
+
+	p := NewProtoSettingsAttributeInfoProvider(&config.PostgresqlConfig14{})
+	v := SettingsMapValidator(p)
+
+	cases := []struct {
+		testname       string
+		value          types.Map
+		expectedErrors int
+	}{
+		{
+			testname: "CheckValidMap",
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"max_connections": types.StringValue("100"),
+				"search_path":     types.StringValue("public"),
+			}),
+		},
+		{
+			testname: "CheckInvalidSettings",
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"max_connections": types.StringValue("many"),
+				"unknown":         types.StringValue("1"),
+				"search_path":     types.StringValue("public"),
+			}),
+			expectedErrors: 2,
+		},
+		{
+			testname: "CheckUnknownValue",
+			value: types.MapValueMust(types.StringType, map[string]attr.Value{
+				"max_connections": types.StringUnknown(),
+			}),
+		},
+		{
+			testname: "CheckNullMap",
+			value:    types.MapNull(types.StringType),
+		},
+	}
+
+	for _, c := range cases {
+		req := validator.MapRequest{
+			Path:        path.Root("config"),
+			ConfigValue: c.value,
+		}
+		resp := &validator.MapResponse{}
+		v.ValidateMap(context.Background(), req, resp)
+
+		if resp.Diagnostics.ErrorsCount() != c.expectedErrors {
+			t.Errorf("Unexpected validation errors count in test case %s: expected %d, actual %v", c.testname, c.expectedErrors, resp.Diagnostics)
+		}
+	}
+}
+
+func TestYandexProvider_MDBSettingsMapPrimitiveElementsKinds(t *testing.T) {
+	t.Parallel()
+
+	p := NewProtoSettingsAttributeInfoProvider(&config.PostgresqlConfig14{}, &spqr.RouterSettings{})
+	m := NewSettingsMapValueMust(map[string]attr.Value{}, p)
+	m.MapValue = types.MapValueMust(types.StringType, map[string]attr.Value{
+		"search_path":      types.StringValue("123"),
+		"random_page_cost": types.StringValue("2"),
+		"max_connections":  types.StringValue("100"),
+		"time_quantiles":   types.StringValue("0.5,1"),
+	})
+
+	expected := map[string]attr.Value{
+		"search_path":      types.StringValue("123"),
+		"random_page_cost": types.Float64Value(2),
+		"max_connections":  types.Int64Value(100),
+		"time_quantiles": types.TupleValueMust(
+			[]attr.Type{types.Float64Type, types.Float64Type},
+			[]attr.Value{types.Float64Value(0.5), types.Float64Value(1)},
+		),
+	}
+
+	var diags diag.Diagnostics
+	actual := m.PrimitiveElements(context.Background(), &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected conversion errors: %v", diags)
+	}
+	if !reflect.DeepEqual(expected, actual) {
+		t.Errorf("Unexpected primitive elements: expected %v, actual %v", expected, actual)
+	}
+}
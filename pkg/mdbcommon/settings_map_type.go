@@ -146,6 +146,20 @@ func (v SettingsMapValue) convertFromStringValue(ctx context.Context, a string,
 		return types.StringNull(), diag.NewErrorDiagnostic("Enum conversion error", fmt.Sprintf("Attribute %s has a unknown value %v", a, val))
 	}
 
+	// Types of the settings are known, so strings of digits aren't converted to numbers and so on
+	if kp, ok := v.p.(SettingsAttributeKindProvider); ok {
+		if kind, ok := kp.GetSettingsKinds()[strings.TrimSuffix(a, ".element")]; ok {
+			switch kind {
+			case SettingKindString:
+				return val, nil
+			case SettingKindFloat:
+				if attrVal, err := strconv.ParseFloat(s, 64); err == nil {
+					return types.Float64Value(attrVal), nil
+				}
+			}
+		}
+	}
+
 	if attrVal, err := strconv.ParseInt(s, 10, 64); err == nil {
 		return types.Int64Value(attrVal), nil
 	}
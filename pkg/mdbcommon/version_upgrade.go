@@ -0,0 +1,207 @@
+package mdbcommon
+
+import (
+	"context"
+	"fmt"
+	"slices"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// VersionUpgradeRules maps each version of an engine to the versions
+// which it can be upgraded to with a single operation.
+type VersionUpgradeRules map[string][]string
+
+// SequentialUpgradeRules allows upgrades only to the next version of the chain.
+// Chains are ordered from the oldest version to the newest one, e.g. editions of the engine.
+func SequentialUpgradeRules(chains ...[]string) VersionUpgradeRules {
+	rules := VersionUpgradeRules{}
+	for _, chain := range chains {
+		for i, v := range chain {
+			if i+1 < len(chain) {
+				rules[v] = append(rules[v], chain[i+1])
+			} else if _, ok := rules[v]; !ok {
+				rules[v] = nil
+			}
+		}
+	}
+	return rules
+}
+
+func (r VersionUpgradeRules) known(version string) bool {
+	if _, ok := r[version]; ok {
+		return true
+	}
+	for _, targets := range r {
+		if slices.Contains(targets, version) {
+			return true
+		}
+	}
+	return false
+}
+
+// reachable returns the versions which the version can be upgraded to,
+// each of them is mapped to the previous version of the shortest upgrade path.
+func (r VersionUpgradeRules) reachable(from string) ([]string, map[string]string) {
+	var order []string
+	prev := map[string]string{from: ""}
+	queue := []string{from}
+	for len(queue) > 0 {
+		v := queue[0]
+		queue = queue[1:]
+		for _, next := range r[v] {
+			if _, ok := prev[next]; ok {
+				continue
+			}
+			prev[next] = v
+			order = append(order, next)
+			queue = append(queue, next)
+		}
+	}
+	return order, prev
+}
+
+// VersionUpgradePlanner validates upgrades of the engine version at plan time
+// and applies upgrades through several versions with sequential operations.
+type VersionUpgradePlanner struct {
+	// Engine is the name of the engine used in messages, e.g. "PostgreSQL".
+	Engine string
+	Rules  VersionUpgradeRules
+
+	// ConfigSpec is the message with the configs of all versions, e.g. postgresql.ConfigSpec.
+	// It's used to find the settings which are not supported by the target version.
+	ConfigSpec proto.Message
+	// ConfigField returns the name of the ConfigSpec field with the config of the version.
+	ConfigField func(version string) string
+}
+
+// UpgradePath returns the versions which the cluster is upgraded to one by one, the last one is the target version.
+//
+// Upgrades from or to versions which are unknown to the rules are passed to the API as is.
+func (p *VersionUpgradePlanner) UpgradePath(from, to string) ([]string, error) {
+	if from == to {
+		return nil, nil
+	}
+	if !p.Rules.known(from) || !p.Rules.known(to) {
+		return []string{to}, nil
+	}
+
+	order, prev := p.Rules.reachable(from)
+	if _, ok := prev[to]; !ok {
+		if len(order) == 0 {
+			return nil, fmt.Errorf("%s version %s can't be upgraded", p.Engine, from)
+		}
+		return nil, fmt.Errorf(
+			"%s version %s can't be upgraded to %s, allowed upgrades are: %s",
+			p.Engine, from, to, strings.Join(order, ", "),
+		)
+	}
+
+	var upgradePath []string
+	for v := to; v != from; v = prev[v] {
+		upgradePath = append(upgradePath, v)
+	}
+	slices.Reverse(upgradePath)
+	return upgradePath, nil
+}
+
+// UnsupportedSettings returns the settings which are set but are not supported by the version.
+func (p *VersionUpgradePlanner) UnsupportedSettings(version string, settings SettingsMapValue) []string {
+	if p.ConfigSpec == nil || p.ConfigField == nil || settings.IsNull() || settings.IsUnknown() {
+		return nil
+	}
+
+	fd := p.ConfigSpec.ProtoReflect().Descriptor().Fields().ByName(protoreflect.Name(p.ConfigField(version)))
+	if fd == nil || fd.Message() == nil {
+		return nil
+	}
+	supported := newProtoSettingsAttributeInfoProvider(true)
+	supported.addMessage(fd.Message(), true)
+
+	var unsupported []string
+	for _, name := range sortedKeys(settings.Elements()) {
+		if settings.Elements()[name].IsNull() {
+			continue
+		}
+		if _, ok := supported.GetSettingsKinds()[name]; !ok {
+			unsupported = append(unsupported, name)
+		}
+	}
+	return unsupported
+}
+
+// ValidateUpgrade adds an error if the version can't be upgraded to the planned one
+// and a warning if the planned settings are not supported by the planned version.
+func (p *VersionUpgradePlanner) ValidateUpgrade(
+	state, plan types.String, versionPath path.Path,
+	settings SettingsMapValue, settingsPath path.Path,
+	diags *diag.Diagnostics,
+) {
+	if state.IsNull() || state.IsUnknown() || plan.IsNull() || plan.IsUnknown() || plan.Equal(state) {
+		return
+	}
+
+	upgradePath, err := p.UpgradePath(state.ValueString(), plan.ValueString())
+	if err != nil {
+		diags.AddAttributeError(versionPath, "Unsupported version upgrade", err.Error())
+		return
+	}
+
+	if len(upgradePath) > 1 {
+		diags.AddAttributeWarning(
+			versionPath,
+			"Version is upgraded in several steps",
+			fmt.Sprintf(
+				"%s version %s is upgraded to %s with sequential upgrades: %s.",
+				p.Engine, state.ValueString(), plan.ValueString(), strings.Join(upgradePath, " -> "),
+			),
+		)
+	}
+
+	if unsupported := p.UnsupportedSettings(plan.ValueString(), settings); len(unsupported) > 0 {
+		diags.AddAttributeWarning(
+			settingsPath,
+			"Settings are not supported by the target version",
+			fmt.Sprintf(
+				"Settings %s are removed in %s %s, the update of them will be rejected after the upgrade. Remove them from the configuration.",
+				strings.Join(unsupported, ", "), p.Engine, plan.ValueString(),
+			),
+		)
+	}
+}
+
+// Upgrade upgrades the version through all versions of the upgrade path, each step waits for the previous one.
+func (p *VersionUpgradePlanner) Upgrade(
+	ctx context.Context, from, to string,
+	upgrade func(version string, diags *diag.Diagnostics),
+	diags *diag.Diagnostics,
+) {
+	upgradePath, err := p.UpgradePath(from, to)
+	if err != nil {
+		diags.AddError("Unsupported version upgrade", err.Error())
+		return
+	}
+
+	current := from
+	for i, version := range upgradePath {
+		tflog.Info(ctx, fmt.Sprintf("Upgrading %s version", p.Engine), map[string]interface{}{
+			"from": current,
+			"to":   version,
+			"step": fmt.Sprintf("%d/%d", i+1, len(upgradePath)),
+		})
+
+		var d diag.Diagnostics
+		upgrade(version, &d)
+		diags.Append(d...)
+		if d.HasError() {
+			return
+		}
+		current = version
+	}
+}
@@ -0,0 +1,202 @@
+package mdbcommon
+
+import (
+	"context"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/postgresql/v1"
+)
+
+var testUpgradePlanner = &VersionUpgradePlanner{
+	Engine: "PostgreSQL",
+	Rules: SequentialUpgradeRules(
+		[]string{"13", "14", "15", "16"},
+		[]string{"13-1c", "14-1c"},
+	),
+	ConfigSpec: &postgresql.ConfigSpec{},
+	ConfigField: func(version string) string {
+		return "postgresql_config_" + strings.ReplaceAll(version, "-", "_")
+	},
+}
+
+func TestYandexProvider_MDBVersionUpgradePath(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		testname      string
+		from          string
+		to            string
+		expectedPath  []string
+		expectedError string
+	}{
+		{
+			testname: "CheckSameVersion",
+			from:     "14",
+			to:       "14",
+		},
+		{
+			testname:     "CheckNextVersion",
+			from:         "14",
+			to:           "15",
+			expectedPath: []string{"15"},
+		},
+		{
+			testname:     "CheckSeveralHops",
+			from:         "13",
+			to:           "16",
+			expectedPath: []string{"14", "15", "16"},
+		},
+		{
+			testname:      "CheckDowngrade",
+			from:          "15",
+			to:            "14",
+			expectedError: "PostgreSQL version 15 can't be upgraded to 14, allowed upgrades are: 16",
+		},
+		{
+			testname:      "CheckEditionChange",
+			from:          "13",
+			to:            "14-1c",
+			expectedError: "PostgreSQL version 13 can't be upgraded to 14-1c, allowed upgrades are: 14, 15, 16",
+		},
+		{
+			testname:      "CheckLatestVersion",
+			from:          "14-1c",
+			to:            "13-1c",
+			expectedError: "PostgreSQL version 14-1c can't be upgraded",
+		},
+		{
+			testname:     "CheckUnknownVersion",
+			from:         "16",
+			to:           "18",
+			expectedPath: []string{"18"},
+		},
+	}
+
+	for _, c := range cases {
+		upgradePath, err := testUpgradePlanner.UpgradePath(c.from, c.to)
+		if c.expectedError != "" {
+			if err == nil || err.Error() != c.expectedError {
+				t.Errorf("Unexpected error in test case %s: expected %q, actual %v", c.testname, c.expectedError, err)
+			}
+			continue
+		}
+
+		if err != nil {
+			t.Errorf("Unexpected error in test case %s: %v", c.testname, err)
+			continue
+		}
+		if !reflect.DeepEqual(upgradePath, c.expectedPath) {
+			t.Errorf("Unexpected upgrade path in test case %s: expected %v, actual %v", c.testname, c.expectedPath, upgradePath)
+		}
+	}
+}
+
+func TestYandexProvider_MDBVersionUpgradeUnsupportedSettings(t *testing.T) {
+	t.Parallel()
+
+	settings := NewSettingsMapValueMust(map[string]attr.Value{
+		"max_connections":                   types.Int64Value(100),
+		"operator_precedence_warning":       types.BoolValue(true),
+		"vacuum_cleanup_index_scale_factor": types.Float64Value(0.1),
+	}, &MockAttrInfoProvider{})
+
+	if unsupported := testUpgradePlanner.UnsupportedSettings("13", settings); len(unsupported) != 0 {
+		t.Errorf("Unexpected unsupported settings of version 13: %v", unsupported)
+	}
+
+	expected := []string{"operator_precedence_warning", "vacuum_cleanup_index_scale_factor"}
+	if unsupported := testUpgradePlanner.UnsupportedSettings("14", settings); !reflect.DeepEqual(unsupported, expected) {
+		t.Errorf("Unexpected unsupported settings of version 14: expected %v, actual %v", expected, unsupported)
+	}
+
+	if unsupported := testUpgradePlanner.UnsupportedSettings("14", NewSettingsMapNull()); len(unsupported) != 0 {
+		t.Errorf("Unexpected unsupported settings of null settings: %v", unsupported)
+	}
+}
+
+func TestYandexProvider_MDBVersionUpgradeValidate(t *testing.T) {
+	t.Parallel()
+
+	settings := NewSettingsMapValueMust(map[string]attr.Value{
+		"operator_precedence_warning": types.BoolValue(true),
+	}, &MockAttrInfoProvider{})
+
+	cases := []struct {
+		testname         string
+		state            types.String
+		plan             types.String
+		expectedErrors   int
+		expectedWarnings int
+	}{
+		{
+			testname: "CheckWithoutChanges",
+			state:    types.StringValue("13"),
+			plan:     types.StringValue("13"),
+		},
+		{
+			testname: "CheckUnknownPlan",
+			state:    types.StringValue("13"),
+			plan:     types.StringUnknown(),
+		},
+		{
+			testname:         "CheckRemovedSettings",
+			state:            types.StringValue("13"),
+			plan:             types.StringValue("14"),
+			expectedWarnings: 1,
+		},
+		{
+			testname:         "CheckSeveralHops",
+			state:            types.StringValue("13"),
+			plan:             types.StringValue("15"),
+			expectedWarnings: 2,
+		},
+		{
+			testname:       "CheckNotAllowedUpgrade",
+			state:          types.StringValue("15"),
+			plan:           types.StringValue("13"),
+			expectedErrors: 1,
+		},
+	}
+
+	for _, c := range cases {
+		var diags diag.Diagnostics
+		testUpgradePlanner.ValidateUpgrade(
+			c.state, c.plan, path.Root("version"),
+			settings, path.Root("config"),
+			&diags,
+		)
+
+		if diags.ErrorsCount() != c.expectedErrors || diags.WarningsCount() != c.expectedWarnings {
+			t.Errorf(
+				"Unexpected diagnostics in test case %s: expected %d errors and %d warnings, actual %v",
+				c.testname, c.expectedErrors, c.expectedWarnings, diags,
+			)
+		}
+	}
+}
+
+func TestYandexProvider_MDBVersionUpgradeSequential(t *testing.T) {
+	t.Parallel()
+
+	var upgraded []string
+	var diags diag.Diagnostics
+	testUpgradePlanner.Upgrade(context.Background(), "13", "16", func(version string, diags *diag.Diagnostics) {
+		upgraded = append(upgraded, version)
+		if version == "15" {
+			diags.AddError("Failed to update resource", "upgrade failed")
+		}
+	}, &diags)
+
+	if !diags.HasError() {
+		t.Errorf("Error of the upgrade step should be returned")
+	}
+	if expected := []string{"14", "15"}; !reflect.DeepEqual(upgraded, expected) {
+		t.Errorf("Unexpected upgrade steps: expected %v, actual %v", expected, upgraded)
+	}
+}
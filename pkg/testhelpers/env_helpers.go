@@ -66,7 +66,7 @@ var storageEndpoint = "no.storage.endpoint"
 func NewFrameworkProviderServer(ctx context.Context) (func() tfprotov6.ProviderServer, error) {
 	upgradedSdkProvider, _ := tf5to6server.UpgradeServer(
 		context.Background(),
-		yandex.NewSDKProvider().GRPCProvider,
+		yandex.NewSDKProviderServer,
 	)
 	providers := []func() tfprotov6.ProviderServer{
 		providerserver.NewProtocol6(AccProvider),
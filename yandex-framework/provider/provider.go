@@ -20,6 +20,9 @@ import (
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/airflow_cluster"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/billing_cloud_binding"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/cic_private_connection"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/cloudrouter_prefix_announcement"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/cloudrouter_routing_instance"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/compute_disk_iam_binding"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/compute_disk_placement_group_iam_binding"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/compute_filesystem_iam_binding"
@@ -37,8 +40,11 @@ import (
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/kubernetes_marketplace_helm_release"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_clickhouse_database"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_clickhouse_user"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_greenplum_cluster_v2"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_greenplum_resource_group"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_greenplum_user"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_kafka_cluster_v2"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_mongodb_cluster_v2"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_mongodb_database"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_mongodb_user"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/mdb_mysql_cluster_v2"
@@ -56,6 +62,8 @@ import (
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/storage_bucket_policy"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/trino_catalog"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/trino_cluster"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/vpc_security_group"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/vpc_security_group_evaluation"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/vpc_security_group_rule"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/yq_monitoring_connection"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/yq_object_storage_binding"
@@ -63,7 +71,6 @@ import (
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/yq_ydb_connection"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/yq_yds_binding"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/yq_yds_connection"
-	// "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/vpc_security_group"
 )
 
 type saKeyValidator struct{}
@@ -309,11 +316,19 @@ func (p *Provider) Resources(_ context.Context) []func() resource.Resource {
 		compute_snapshot_schedule_iam_binding.NewIamBinding,
 		airflow_cluster.NewResource,
 		metastore_cluster.NewResource,
+		vpc_security_group.NewResource,
 		vpc_security_group_rule.NewResource,
+		cic_private_connection.NewResource,
+		cloudrouter_routing_instance.NewResource,
+		cloudrouter_prefix_announcement.NewResource,
 		mdb_postgresql_cluster_v2.NewPostgreSQLClusterResourceV2,
 		mdb_redis_cluster_v2.NewResource,
 		mdb_redis_user.NewResource,
 		mdb_mysql_cluster_v2.NewMySQLClusterResourceV2,
+		mdb_kafka_cluster_v2.NewKafkaClusterResourceV2,
+		mdb_mongodb_cluster_v2.NewMongoDBClusterResourceV2,
+		mdb_mongodb_cluster_v2.NewMongoDBShardResource,
+		mdb_greenplum_cluster_v2.NewGreenplumClusterResourceV2,
 		kubernetes_marketplace_helm_release.NewResource,
 		spark_cluster.NewResource,
 		gitlab_instance.NewResource,
@@ -347,6 +362,7 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		datasphere_community.NewDataSource,
 		mdb_clickhouse_database.NewDataSource,
 		mdb_clickhouse_user.NewDataSource,
+		mdb_greenplum_cluster_v2.NewGreenplumClusterDataSourceV2,
 		mdb_greenplum_resource_group.NewDataSource,
 		mdb_greenplum_user.NewDataSource,
 		mdb_mongodb_database.NewDataSource,
@@ -355,6 +371,7 @@ func (p *Provider) DataSources(_ context.Context) []func() datasource.DataSource
 		mdb_redis_user.NewDataSource,
 		mdb_opensearch_cluster.NewDataSource,
 		vpc_security_group_rule.NewDataSource,
+		vpc_security_group_evaluation.NewDataSource,
 		spark_cluster.NewDatasource,
 		gitlab_instance.NewDataSource,
 		trino_cluster.NewDatasource,
@@ -0,0 +1,163 @@
+package api
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/cic/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/retry"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// There is no Cloud Interconnect client in the go-sdk, the connection is resolved by the service name.
+const privateConnectionService = protoreflect.FullName("yandex.cloud.cic.v1.PrivateConnectionService")
+
+func client(ctx context.Context, config *provider_config.Config) (cic.PrivateConnectionServiceClient, error) {
+	conn, err := config.SDKv2.GetConnection(ctx, privateConnectionService)
+	if err != nil {
+		return nil, err
+	}
+	return cic.NewPrivateConnectionServiceClient(conn), nil
+}
+
+func ReadPrivateConnection(ctx context.Context, config *provider_config.Config, diag *diag.Diagnostics, id string) *cic.PrivateConnection {
+	tflog.Debug(ctx, "Reading CIC PrivateConnection", map[string]interface{}{"id": id})
+	c, err := client(ctx, config)
+	if err != nil {
+		diag.AddError(
+			"Failed to Read resource",
+			"Error while connecting to Cloud Interconnect API: "+err.Error(),
+		)
+		return nil
+	}
+
+	pc, err := c.Get(ctx, &cic.GetPrivateConnectionRequest{
+		PrivateConnectionId: id,
+	})
+	if err != nil {
+		if validate.IsStatusWithCode(err, codes.NotFound) {
+			return nil
+		}
+
+		diag.AddError(
+			"Failed to Read resource",
+			"Error while requesting API to get Private Connection: "+err.Error(),
+		)
+		return nil
+	}
+	return pc
+}
+
+func CreatePrivateConnection(ctx context.Context, config *provider_config.Config, diag *diag.Diagnostics, req *cic.CreatePrivateConnectionRequest) string {
+	tflog.Debug(ctx, "Creating CIC PrivateConnection", map[string]interface{}{"name": req.Name})
+	c, err := client(ctx, config)
+	if err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while connecting to Cloud Interconnect API: "+err.Error(),
+		)
+		return ""
+	}
+
+	op, err := config.SDK.WrapOperation(c.Create(ctx, req))
+	if err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while requesting API to create Private Connection: "+err.Error(),
+		)
+		return ""
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while getting Private Connection create operation metadata: "+err.Error(),
+		)
+		return ""
+	}
+
+	md, ok := protoMetadata.(*cic.CreatePrivateConnectionMetadata)
+	if !ok {
+		diag.AddError(
+			"Failed to Create resource",
+			"Could not get Private Connection ID from create operation metadata",
+		)
+		return ""
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while waiting for operation to create Private Connection: "+err.Error(),
+		)
+	}
+
+	return md.GetPrivateConnectionId()
+}
+
+func UpdatePrivateConnection(ctx context.Context, config *provider_config.Config, diag *diag.Diagnostics, req *cic.UpdatePrivateConnectionRequest) {
+	tflog.Debug(ctx, "Updating CIC PrivateConnection", map[string]interface{}{"id": req.PrivateConnectionId})
+	runOperation(ctx, config, diag, "Failed to Update resource", "update Private Connection", func(c cic.PrivateConnectionServiceClient) (*operation.Operation, error) {
+		return c.Update(ctx, req)
+	})
+}
+
+// UpdateStaticRoutes adds and removes the static routes of the private connection, the other routes are left as is.
+func UpdateStaticRoutes(ctx context.Context, config *provider_config.Config, diag *diag.Diagnostics, id string, upsert, remove []*cic.PrivateConnection_StaticRoute) {
+	tflog.Debug(ctx, "Updating CIC PrivateConnection static routes", map[string]interface{}{"id": id, "upserted": len(upsert), "removed": len(remove)})
+	if len(remove) > 0 {
+		runOperation(ctx, config, diag, "Failed to Update Static Routes", "remove static routes of Private Connection", func(c cic.PrivateConnectionServiceClient) (*operation.Operation, error) {
+			return c.RemoveStaticRoute(ctx, &cic.RemoveStaticRouteRequest{
+				PrivateConnectionId: id,
+				Ipv4StaticRoutes:    remove,
+			})
+		})
+		if diag.HasError() {
+			return
+		}
+	}
+
+	if len(upsert) > 0 {
+		runOperation(ctx, config, diag, "Failed to Update Static Routes", "upsert static routes of Private Connection", func(c cic.PrivateConnectionServiceClient) (*operation.Operation, error) {
+			return c.UpsertStaticRoute(ctx, &cic.UpsertStaticRouteRequest{
+				PrivateConnectionId: id,
+				Ipv4StaticRoutes:    upsert,
+			})
+		})
+	}
+}
+
+func DeletePrivateConnection(ctx context.Context, config *provider_config.Config, diag *diag.Diagnostics, id string) {
+	tflog.Debug(ctx, "Deleting CIC PrivateConnection", map[string]interface{}{"id": id})
+	runOperation(ctx, config, diag, "Failed to Delete resource", "delete Private Connection", func(c cic.PrivateConnectionServiceClient) (*operation.Operation, error) {
+		return c.Delete(ctx, &cic.DeletePrivateConnectionRequest{
+			PrivateConnectionId: id,
+		})
+	})
+}
+
+func runOperation(ctx context.Context, config *provider_config.Config, diag *diag.Diagnostics, summary, action string, call func(cic.PrivateConnectionServiceClient) (*operation.Operation, error)) {
+	c, err := client(ctx, config)
+	if err != nil {
+		diag.AddError(summary, "Error while connecting to Cloud Interconnect API: "+err.Error())
+		return
+	}
+
+	op, err := retry.ConflictingOperation(ctx, config.SDK, func() (*operation.Operation, error) {
+		return call(c)
+	})
+	if err != nil {
+		diag.AddError(summary, "Error while requesting API to "+action+": "+err.Error())
+		return
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diag.AddError(summary, "Error while waiting for operation to "+action+": "+err.Error())
+	}
+}
@@ -0,0 +1,169 @@
+package cic_private_connection
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/cic/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/timestamp"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+type privateConnectionModel struct {
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+	ID                types.String   `tfsdk:"id"`
+	CreatedAt         types.String   `tfsdk:"created_at"`
+	Name              types.String   `tfsdk:"name"`
+	Description       types.String   `tfsdk:"description"`
+	Labels            types.Map      `tfsdk:"labels"`
+	FolderID          types.String   `tfsdk:"folder_id"`
+	RegionID          types.String   `tfsdk:"region_id"`
+	TrunkConnectionID types.String   `tfsdk:"trunk_connection_id"`
+	VlanID            types.Int64    `tfsdk:"vlan_id"`
+	Ipv4Peering       *peeringModel  `tfsdk:"ipv4_peering"`
+	Ipv4StaticRoutes  types.Set      `tfsdk:"ipv4_static_routes"`
+	Status            types.String   `tfsdk:"status"`
+}
+
+type peeringModel struct {
+	PeeringSubnet types.String `tfsdk:"peering_subnet"`
+	PeerIP        types.String `tfsdk:"peer_ip"`
+	CloudIP       types.String `tfsdk:"cloud_ip"`
+	PeerBgpAsn    types.Int64  `tfsdk:"peer_bgp_asn"`
+	CloudBgpAsn   types.Int64  `tfsdk:"cloud_bgp_asn"`
+	PeerBgpMd5Key types.String `tfsdk:"peer_bgp_md5_key"`
+}
+
+func privateConnectionToState(ctx context.Context, pc *cic.PrivateConnection, state *privateConnectionModel) diag.Diagnostics {
+	state.FolderID = types.StringValue(pc.GetFolderId())
+	state.RegionID = types.StringValue(pc.GetRegionId())
+	state.TrunkConnectionID = types.StringValue(pc.GetTrunkConnectionId())
+	state.Status = types.StringValue(pc.GetStatus().String())
+	state.CreatedAt = types.StringValue(timestamp.Get(pc.GetCreatedAt()))
+	if !state.Name.IsNull() || pc.GetName() != "" {
+		state.Name = types.StringValue(pc.GetName())
+	}
+	if state.Description.IsUnknown() || pc.GetDescription() != "" {
+		state.Description = types.StringValue(pc.GetDescription())
+	}
+	if pc.GetVlanId() != nil {
+		state.VlanID = types.Int64Value(pc.GetVlanId().GetValue())
+	} else {
+		state.VlanID = types.Int64Null()
+	}
+
+	var diags diag.Diagnostics
+	if state.Labels.IsUnknown() || pc.Labels != nil {
+		state.Labels, diags = types.MapValueFrom(ctx, types.StringType, pc.Labels)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	state.Ipv4Peering = flattenPeering(pc.GetIpv4Peering(), state.Ipv4Peering)
+
+	routes := staticRoutePrefixes(pc.GetIpv4StaticRoutes())
+	if !state.Ipv4StaticRoutes.IsNull() || len(routes) > 0 {
+		state.Ipv4StaticRoutes, diags = types.SetValueFrom(ctx, types.StringType, routes)
+	}
+	return diags
+}
+
+// flattenPeering keeps the BGP MD5 key of the state, the API doesn't return it back.
+func flattenPeering(p *cic.Peering, state *peeringModel) *peeringModel {
+	if p == nil {
+		return nil
+	}
+
+	peering := &peeringModel{
+		PeeringSubnet: types.StringValue(p.GetPeeringSubnet()),
+		PeerIP:        types.StringValue(p.GetPeerIp()),
+		CloudIP:       types.StringValue(p.GetCloudIp()),
+		PeerBgpAsn:    types.Int64Value(p.GetPeerBgpAsn()),
+		CloudBgpAsn:   types.Int64Value(p.GetCloudBgpAsn()),
+		PeerBgpMd5Key: types.StringNull(),
+	}
+	if p.GetPeerBgpMd5Key() != "" {
+		peering.PeerBgpMd5Key = types.StringValue(p.GetPeerBgpMd5Key())
+	} else if state != nil && !state.PeerBgpMd5Key.IsUnknown() {
+		peering.PeerBgpMd5Key = state.PeerBgpMd5Key
+	}
+	return peering
+}
+
+func expandPeering(p *peeringModel) *cic.Peering {
+	if p == nil {
+		return nil
+	}
+
+	return &cic.Peering{
+		PeeringSubnet: p.PeeringSubnet.ValueString(),
+		PeerIp:        p.PeerIP.ValueString(),
+		CloudIp:       p.CloudIP.ValueString(),
+		PeerBgpAsn:    p.PeerBgpAsn.ValueInt64(),
+		CloudBgpAsn:   p.CloudBgpAsn.ValueInt64(),
+		PeerBgpMd5Key: p.PeerBgpMd5Key.ValueString(),
+	}
+}
+
+func expandVlanID(v types.Int64) *wrapperspb.Int64Value {
+	if v.IsNull() || v.IsUnknown() {
+		return nil
+	}
+	return wrapperspb.Int64(v.ValueInt64())
+}
+
+func expandStaticRoutes(ctx context.Context, routes types.Set) ([]string, diag.Diagnostics) {
+	var prefixes []string
+	if routes.IsNull() || routes.IsUnknown() {
+		return prefixes, nil
+	}
+	diags := routes.ElementsAs(ctx, &prefixes, false)
+	return prefixes, diags
+}
+
+func staticRoutes(prefixes []string) []*cic.PrivateConnection_StaticRoute {
+	var routes []*cic.PrivateConnection_StaticRoute
+	for _, prefix := range prefixes {
+		routes = append(routes, &cic.PrivateConnection_StaticRoute{Prefix: prefix})
+	}
+	return routes
+}
+
+func staticRoutePrefixes(routes []*cic.PrivateConnection_StaticRoute) []string {
+	prefixes := make([]string, 0, len(routes))
+	for _, route := range routes {
+		prefixes = append(prefixes, route.GetPrefix())
+	}
+	return prefixes
+}
+
+// staticRoutesDelta returns the static routes to upsert and to remove so that the routes of the private
+// connection match the planned prefixes.
+func staticRoutesDelta(current []*cic.PrivateConnection_StaticRoute, planned []string) (upsert, remove []*cic.PrivateConnection_StaticRoute) {
+	existing := make(map[string]bool, len(current))
+	for _, route := range current {
+		existing[route.GetPrefix()] = true
+	}
+
+	wanted := make(map[string]bool, len(planned))
+	for _, prefix := range planned {
+		wanted[prefix] = true
+		if !existing[prefix] {
+			upsert = append(upsert, &cic.PrivateConnection_StaticRoute{Prefix: prefix})
+		}
+	}
+
+	for _, route := range current {
+		if !wanted[route.GetPrefix()] {
+			remove = append(remove, route)
+		}
+	}
+
+	sort.Slice(upsert, func(i, j int) bool { return upsert[i].GetPrefix() < upsert[j].GetPrefix() })
+	sort.Slice(remove, func(i, j int) bool { return remove[i].GetPrefix() < remove[j].GetPrefix() })
+	return upsert, remove
+}
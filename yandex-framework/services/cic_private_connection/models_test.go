@@ -0,0 +1,63 @@
+package cic_private_connection
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/cic/v1"
+)
+
+func TestStaticRoutesDelta(t *testing.T) {
+	current := staticRoutes([]string{"10.0.0.0/16", "10.1.0.0/16", "10.2.0.0/16"})
+
+	upsert, remove := staticRoutesDelta(current, []string{"10.3.0.0/16", "10.1.0.0/16", "10.0.0.0/16"})
+	if got := staticRoutePrefixes(upsert); !reflect.DeepEqual(got, []string{"10.3.0.0/16"}) {
+		t.Errorf("unexpected upserted routes %v", got)
+	}
+	if got := staticRoutePrefixes(remove); !reflect.DeepEqual(got, []string{"10.2.0.0/16"}) {
+		t.Errorf("unexpected removed routes %v", got)
+	}
+
+	upsert, remove = staticRoutesDelta(current, []string{"10.2.0.0/16", "10.1.0.0/16", "10.0.0.0/16"})
+	if len(upsert) != 0 || len(remove) != 0 {
+		t.Errorf("expected no changes, got upserted %v and removed %v", staticRoutePrefixes(upsert), staticRoutePrefixes(remove))
+	}
+
+	upsert, remove = staticRoutesDelta(current, nil)
+	if len(upsert) != 0 {
+		t.Errorf("expected nothing to upsert, got %v", staticRoutePrefixes(upsert))
+	}
+	if got := staticRoutePrefixes(remove); !reflect.DeepEqual(got, []string{"10.0.0.0/16", "10.1.0.0/16", "10.2.0.0/16"}) {
+		t.Errorf("unexpected removed routes %v", got)
+	}
+}
+
+func TestFlattenPeeringKeepsMd5Key(t *testing.T) {
+	p := &cic.Peering{
+		PeeringSubnet: "192.168.0.0/30",
+		PeerIp:        "192.168.0.1",
+		CloudIp:       "192.168.0.2",
+		PeerBgpAsn:    65001,
+		CloudBgpAsn:   200350,
+	}
+
+	flattened := flattenPeering(p, &peeringModel{PeerBgpMd5Key: types.StringValue("secret")})
+	if flattened.PeerBgpMd5Key.ValueString() != "secret" {
+		t.Errorf("expected the MD5 key to be taken from the state, got %s", flattened.PeerBgpMd5Key)
+	}
+	if !reflect.DeepEqual(expandPeering(flattened), &cic.Peering{
+		PeeringSubnet: "192.168.0.0/30",
+		PeerIp:        "192.168.0.1",
+		CloudIp:       "192.168.0.2",
+		PeerBgpAsn:    65001,
+		CloudBgpAsn:   200350,
+		PeerBgpMd5Key: "secret",
+	}) {
+		t.Errorf("unexpected expanded peering %v", expandPeering(flattened))
+	}
+
+	if flattened = flattenPeering(p, nil); !flattened.PeerBgpMd5Key.IsNull() {
+		t.Errorf("expected no MD5 key without the state, got %s", flattened.PeerBgpMd5Key)
+	}
+}
@@ -0,0 +1,374 @@
+package cic_private_connection
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/cic/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common/defaultschema"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	cic_api "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/cic_private_connection/api"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+const YandexCICPrivateConnectionDefaultTimeout = 10 * time.Minute
+
+var (
+	_ resource.Resource                = &privateConnectionResource{}
+	_ resource.ResourceWithConfigure   = &privateConnectionResource{}
+	_ resource.ResourceWithImportState = &privateConnectionResource{}
+)
+
+type privateConnectionResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewResource() resource.Resource {
+	return &privateConnectionResource{}
+}
+
+func (r *privateConnectionResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cic_private_connection"
+}
+
+func (r *privateConnectionResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Initializing CIC PrivateConnection schema")
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Cloud Interconnect `Private Connection` within the Yandex Cloud. A private connection is the BGP peering with the on-premise network over a trunk connection. For more information, see [Documentation](https://yandex.cloud/docs/interconnect/concepts/priv-con).\n\n~> The trunk connection is set up by the support or a partner and can't be managed by Terraform.\n\n",
+		Attributes: map[string]schema.Attribute{
+			"id":          defaultschema.Id(),
+			"folder_id":   defaultschema.FolderId(),
+			"name":        defaultschema.Name(),
+			"description": defaultschema.Description(),
+			"labels":      defaultschema.Labels(),
+			"created_at":  defaultschema.CreatedAt(),
+			"region_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the region the private connection belongs to.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"trunk_connection_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the trunk connection the private connection goes through.",
+				Required:            true,
+			},
+			"vlan_id": schema.Int64Attribute{
+				MarkdownDescription: "VLAN ID the private connection uses in the trunk connection. Not used in connections over the partners.",
+				Optional:            true,
+				Computed:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(1, 4095),
+				},
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"ipv4_peering": schema.SingleNestedAttribute{
+				MarkdownDescription: "IPv4 BGP peering of the private connection.",
+				Required:            true,
+				Attributes: map[string]schema.Attribute{
+					"peering_subnet": schema.StringAttribute{
+						MarkdownDescription: "CIDR of the peering subnet, e.g. `192.168.0.0/30`.",
+						Required:            true,
+					},
+					"peer_ip": schema.StringAttribute{
+						MarkdownDescription: "IP address of the on-premise side of the peering.",
+						Required:            true,
+					},
+					"cloud_ip": schema.StringAttribute{
+						MarkdownDescription: "IP address of the cloud side of the peering.",
+						Required:            true,
+					},
+					"peer_bgp_asn": schema.Int64Attribute{
+						MarkdownDescription: "BGP ASN of the on-premise side.",
+						Required:            true,
+					},
+					"cloud_bgp_asn": schema.Int64Attribute{
+						MarkdownDescription: "BGP ASN of the cloud side.",
+						Optional:            true,
+						Computed:            true,
+						PlanModifiers: []planmodifier.Int64{
+							int64planmodifier.UseStateForUnknown(),
+						},
+					},
+					"peer_bgp_md5_key": schema.StringAttribute{
+						MarkdownDescription: "MD5 key of the BGP session.",
+						Optional:            true,
+						Sensitive:           true,
+					},
+				},
+			},
+			"ipv4_static_routes": schema.SetAttribute{
+				MarkdownDescription: "IPv4 prefixes of the static routes to the on-premise network. The routes which aren't listed are removed.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The Private Connection status.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *privateConnectionResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *privateConnectionResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan privateConnectionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, YandexCICPrivateConnectionDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	folderID, d := validate.FolderID(plan.FolderID, &r.providerConfig.ProviderState)
+	resp.Diagnostics.Append(d)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	labels := make(map[string]string, len(plan.Labels.Elements()))
+	resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &labels, false)...)
+	routes, diags := expandStaticRoutes(ctx, plan.Ipv4StaticRoutes)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(cic_api.CreatePrivateConnection(ctx, r.providerConfig, &resp.Diagnostics, &cic.CreatePrivateConnectionRequest{
+		FolderId:          folderID,
+		Name:              plan.Name.ValueString(),
+		Description:       plan.Description.ValueString(),
+		Labels:            labels,
+		RegionId:          plan.RegionID.ValueString(),
+		TrunkConnectionId: plan.TrunkConnectionID.ValueString(),
+		VlanId:            expandVlanID(plan.VlanID),
+		Ipv4Peering:       expandPeering(plan.Ipv4Peering),
+		Ipv4StaticRoutes:  staticRoutes(routes),
+	}))
+	if plan.ID.ValueString() == "" {
+		return
+	}
+	// Save the ID even if waiting has failed, so the private connection isn't lost
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refreshState(ctx, &plan, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *privateConnectionResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state privateConnectionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	pc := cic_api.ReadPrivateConnection(ctx, r.providerConfig, &resp.Diagnostics, state.ID.ValueString())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if pc == nil {
+		tflog.Warn(ctx, "CIC PrivateConnection not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(privateConnectionToState(ctx, pc, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *privateConnectionResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state privateConnectionModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, YandexCICPrivateConnectionDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	id := state.ID.ValueString()
+	updateReq := &cic.UpdatePrivateConnectionRequest{
+		PrivateConnectionId: id,
+		UpdateMask:          &field_mask.FieldMask{},
+	}
+	if !plan.Name.Equal(state.Name) {
+		updateReq.Name = plan.Name.ValueString()
+		updateReq.UpdateMask.Paths = append(updateReq.UpdateMask.Paths, "name")
+	}
+	if !plan.Description.Equal(state.Description) {
+		updateReq.Description = plan.Description.ValueString()
+		updateReq.UpdateMask.Paths = append(updateReq.UpdateMask.Paths, "description")
+	}
+	if !plan.Labels.Equal(state.Labels) {
+		labels := make(map[string]string, len(plan.Labels.Elements()))
+		resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &labels, false)...)
+		updateReq.Labels = labels
+		updateReq.UpdateMask.Paths = append(updateReq.UpdateMask.Paths, "labels")
+	}
+	if !plan.RegionID.Equal(state.RegionID) {
+		updateReq.RegionId = plan.RegionID.ValueString()
+		updateReq.UpdateMask.Paths = append(updateReq.UpdateMask.Paths, "region_id")
+	}
+	if !plan.TrunkConnectionID.Equal(state.TrunkConnectionID) {
+		updateReq.TrunkConnectionId = plan.TrunkConnectionID.ValueString()
+		updateReq.UpdateMask.Paths = append(updateReq.UpdateMask.Paths, "trunk_connection_id")
+	}
+	if !plan.VlanID.Equal(state.VlanID) {
+		updateReq.VlanId = expandVlanID(plan.VlanID)
+		updateReq.UpdateMask.Paths = append(updateReq.UpdateMask.Paths, "vlan_id")
+	}
+	if !peeringEqual(plan.Ipv4Peering, state.Ipv4Peering) {
+		updateReq.Ipv4Peering = expandPeering(plan.Ipv4Peering)
+		updateReq.UpdateMask.Paths = append(updateReq.UpdateMask.Paths, "ipv4_peering")
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(updateReq.UpdateMask.Paths) > 0 {
+		cic_api.UpdatePrivateConnection(ctx, r.providerConfig, &resp.Diagnostics, updateReq)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.Ipv4StaticRoutes.Equal(state.Ipv4StaticRoutes) {
+		r.updateStaticRoutes(ctx, id, &plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	r.refreshState(ctx, &plan, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// updateStaticRoutes makes the static routes of the private connection match the plan. The routes are compared
+// with the current ones, so the routes added outside of Terraform are removed as well.
+func (r *privateConnectionResource) updateStaticRoutes(ctx context.Context, id string, plan *privateConnectionModel, diags *diag.Diagnostics) {
+	planned, d := expandStaticRoutes(ctx, plan.Ipv4StaticRoutes)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	pc := cic_api.ReadPrivateConnection(ctx, r.providerConfig, diags, id)
+	if diags.HasError() {
+		return
+	}
+	if pc == nil {
+		diags.AddError(
+			"Failed to get PrivateConnection",
+			fmt.Sprintf("PrivateConnection with id %s not found", id))
+		return
+	}
+
+	upsert, remove := staticRoutesDelta(pc.GetIpv4StaticRoutes(), planned)
+	cic_api.UpdateStaticRoutes(ctx, r.providerConfig, diags, id, upsert, remove)
+}
+
+func (r *privateConnectionResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state privateConnectionModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, YandexCICPrivateConnectionDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	cic_api.DeletePrivateConnection(ctx, r.providerConfig, &resp.Diagnostics, state.ID.ValueString())
+}
+
+func (r *privateConnectionResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *privateConnectionResource) refreshState(ctx context.Context, state *privateConnectionModel, diags *diag.Diagnostics) {
+	id := state.ID.ValueString()
+	pc := cic_api.ReadPrivateConnection(ctx, r.providerConfig, diags, id)
+	if diags.HasError() {
+		return
+	}
+	if pc == nil {
+		diags.AddError(
+			"Failed to get PrivateConnection",
+			fmt.Sprintf("PrivateConnection with id %s not found", id))
+		return
+	}
+
+	diags.Append(privateConnectionToState(ctx, pc, state)...)
+}
+
+func peeringEqual(a, b *peeringModel) bool {
+	if a == nil || b == nil {
+		return a == b
+	}
+	return a.PeeringSubnet.Equal(b.PeeringSubnet) &&
+		a.PeerIP.Equal(b.PeerIP) &&
+		a.CloudIP.Equal(b.CloudIP) &&
+		a.PeerBgpAsn.Equal(b.PeerBgpAsn) &&
+		a.CloudBgpAsn.Equal(b.CloudBgpAsn) &&
+		a.PeerBgpMd5Key.Equal(b.PeerBgpMd5Key)
+}
@@ -0,0 +1,124 @@
+package cic_private_connection_test
+
+import (
+	"context"
+	"fmt"
+	"os"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+	cic_api "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/cic_private_connection/api"
+)
+
+// Trunk connections are set up by the support, so the test needs an existing one.
+const trunkConnectionEnvVar = "YC_CIC_TRUNK_CONNECTION_ID"
+
+func TestAccCICPrivateConnection_basic(t *testing.T) {
+	trunkID := os.Getenv(trunkConnectionEnvVar)
+	if trunkID == "" {
+		t.Skipf("%s must be set for the Cloud Interconnect acceptance tests", trunkConnectionEnvVar)
+	}
+
+	name := acctest.RandomWithPrefix("tf-cic-private-connection")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckCICPrivateConnectionDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCICPrivateConnection(name, trunkID, `"10.100.0.0/16"`),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCICPrivateConnectionExists("yandex_cic_private_connection.test"),
+					resource.TestCheckResourceAttr("yandex_cic_private_connection.test", "name", name),
+					resource.TestCheckResourceAttr("yandex_cic_private_connection.test", "ipv4_peering.peer_bgp_asn", "65001"),
+					resource.TestCheckResourceAttr("yandex_cic_private_connection.test", "ipv4_static_routes.#", "1"),
+					resource.TestCheckResourceAttr("yandex_cic_private_connection.test", "status", "ACTIVE"),
+					test.AccCheckCreatedAtAttr("yandex_cic_private_connection.test"),
+				),
+			},
+			{
+				Config: testAccCICPrivateConnection(name, trunkID, `"10.100.0.0/16", "10.101.0.0/16"`),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_cic_private_connection.test", "ipv4_static_routes.#", "2"),
+				),
+			},
+			{
+				ResourceName:            "yandex_cic_private_connection.test",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"ipv4_peering.peer_bgp_md5_key"},
+			},
+		},
+	})
+}
+
+func testAccCICPrivateConnection(name, trunkID, routes string) string {
+	return fmt.Sprintf(`
+resource "yandex_cic_private_connection" "test" {
+  name                = "%s"
+  trunk_connection_id = "%s"
+  vlan_id             = 1042
+
+  ipv4_peering = {
+    peering_subnet = "192.168.42.0/30"
+    peer_ip        = "192.168.42.1"
+    cloud_ip       = "192.168.42.2"
+    peer_bgp_asn   = 65001
+  }
+
+  ipv4_static_routes = [%s]
+}
+`, name, trunkID, routes)
+}
+
+func testAccCheckCICPrivateConnectionExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		var diags diag.Diagnostics
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+		pc := cic_api.ReadPrivateConnection(context.Background(), &config, &diags, rs.Primary.ID)
+		if diags.HasError() {
+			return fmt.Errorf("failed to get private connection: %v", diags)
+		}
+		if pc == nil {
+			return fmt.Errorf("private connection not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCICPrivateConnectionDestroy(s *terraform.State) error {
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_cic_private_connection" {
+			continue
+		}
+
+		var diags diag.Diagnostics
+		pc := cic_api.ReadPrivateConnection(context.Background(), &config, &diags, rs.Primary.ID)
+		if diags.HasError() {
+			return fmt.Errorf("failed to get private connection: %v", diags)
+		}
+		if pc != nil {
+			return fmt.Errorf("private connection still exists")
+		}
+	}
+
+	return nil
+}
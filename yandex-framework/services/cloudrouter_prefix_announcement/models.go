@@ -0,0 +1,32 @@
+package cloudrouter_prefix_announcement
+
+import (
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+type prefixAnnouncementModel struct {
+	Timeouts          timeouts.Value `tfsdk:"timeouts"`
+	ID                types.String   `tfsdk:"id"`
+	RoutingInstanceID types.String   `tfsdk:"routing_instance_id"`
+	VpcNetworkID      types.String   `tfsdk:"vpc_network_id"`
+	AzID              types.String   `tfsdk:"az_id"`
+	Prefix            types.String   `tfsdk:"prefix"`
+}
+
+// constructID joins the parts with colons like resourceid.Construct does. The prefix is the last part,
+// so the IPv6 prefixes containing colons are parsed back as is.
+func constructID(routingInstanceID, vpcNetworkID, azID, prefix string) string {
+	return strings.Join([]string{routingInstanceID, vpcNetworkID, azID, prefix}, ":")
+}
+
+func deconstructID(id string) (routingInstanceID, vpcNetworkID, azID, prefix string, err error) {
+	parts := strings.SplitN(id, ":", 4)
+	if len(parts) != 4 || parts[0] == "" || parts[1] == "" || parts[2] == "" || parts[3] == "" {
+		return "", "", "", "", fmt.Errorf("Invalid resource id format: %q, expected <routing_instance_id>:<vpc_network_id>:<az_id>:<prefix>", id)
+	}
+	return parts[0], parts[1], parts[2], parts[3], nil
+}
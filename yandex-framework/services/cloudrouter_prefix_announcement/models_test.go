@@ -0,0 +1,24 @@
+package cloudrouter_prefix_announcement
+
+import (
+	"testing"
+)
+
+func TestDeconstructID(t *testing.T) {
+	for _, prefix := range []string{"10.0.0.0/24", "2001:db8::/32"} {
+		id := constructID("ri1", "net1", "ru-central1-a", prefix)
+		ri, network, zone, p, err := deconstructID(id)
+		if err != nil {
+			t.Fatalf("unexpected error for %q: %s", id, err)
+		}
+		if ri != "ri1" || network != "net1" || zone != "ru-central1-a" || p != prefix {
+			t.Errorf("unexpected parts of %q: %s, %s, %s, %s", id, ri, network, zone, p)
+		}
+	}
+
+	for _, id := range []string{"", "ri1", "ri1:net1:ru-central1-a", "ri1::ru-central1-a:10.0.0.0/24", "ri1:net1:ru-central1-a:"} {
+		if _, _, _, _, err := deconstructID(id); err == nil {
+			t.Errorf("expected an error for %q", id)
+		}
+	}
+}
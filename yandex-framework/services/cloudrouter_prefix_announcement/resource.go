@@ -0,0 +1,212 @@
+package cloudrouter_prefix_announcement
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/cloudrouter/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	ri_api "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/cloudrouter_routing_instance/api"
+)
+
+const YandexCloudRouterPrefixAnnouncementDefaultTimeout = 5 * time.Minute
+
+var (
+	_ resource.Resource                = &prefixAnnouncementResource{}
+	_ resource.ResourceWithConfigure   = &prefixAnnouncementResource{}
+	_ resource.ResourceWithImportState = &prefixAnnouncementResource{}
+)
+
+type prefixAnnouncementResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewResource() resource.Resource {
+	return &prefixAnnouncementResource{}
+}
+
+func (r *prefixAnnouncementResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudrouter_prefix_announcement"
+}
+
+func requiredForceNewString(description string) schema.StringAttribute {
+	return schema.StringAttribute{
+		MarkdownDescription: description,
+		Required:            true,
+		PlanModifiers: []planmodifier.String{
+			stringplanmodifier.RequiresReplace(),
+		},
+	}
+}
+
+func (r *prefixAnnouncementResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Initializing CloudRouter PrefixAnnouncement schema")
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Announces a prefix of a VPC network availability zone through a Cloud Router `Routing Instance` to the on-premise network. For more information, see [Documentation](https://yandex.cloud/docs/cloud-router/concepts/routing-instance).\n\n~> The VPC network must be attached to the routing instance by its `vpc_network_ids`.\n\n~> Any change of the announcement recreates it.\n\n",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["id"],
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"routing_instance_id": requiredForceNewString("ID of the routing instance announcing the prefix."),
+			"vpc_network_id":      requiredForceNewString("ID of the VPC network the prefix belongs to."),
+			"az_id":               requiredForceNewString("ID of the availability zone the prefix belongs to, e.g. `ru-central1-a`."),
+			"prefix":              requiredForceNewString("The announced prefix, e.g. `10.0.0.0/24`."),
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *prefixAnnouncementResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	routingInstanceID, vpcNetworkID, azID, prefix, err := deconstructID(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), req.ID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("routing_instance_id"), routingInstanceID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("vpc_network_id"), vpcNetworkID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("az_id"), azID)...)
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("prefix"), prefix)...)
+}
+
+func (r *prefixAnnouncementResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan prefixAnnouncementModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, YandexCloudRouterPrefixAnnouncementDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	routingInstanceID := plan.RoutingInstanceID.ValueString()
+
+	// The routing instance and the other announcements are modified under the same lock
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(routingInstanceID)
+	defer mutexKV.Unlock(routingInstanceID)
+
+	ri_api.UpsertPrefixes(ctx, r.providerConfig, &resp.Diagnostics, &cloudrouter.UpsertPrefixesRequest{
+		RoutingInstanceId: routingInstanceID,
+		VpcNetworkId:      plan.VpcNetworkID.ValueString(),
+		VpcAzInfoPrefixes: prefixes(&plan),
+	})
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(constructID(routingInstanceID, plan.VpcNetworkID.ValueString(), plan.AzID.ValueString(), plan.Prefix.ValueString()))
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *prefixAnnouncementResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state prefixAnnouncementModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ri := ri_api.ReadRoutingInstance(ctx, r.providerConfig, &resp.Diagnostics, state.RoutingInstanceID.ValueString())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if ri == nil || !ri_api.FindPrefix(ri, state.VpcNetworkID.ValueString(), state.AzID.ValueString(), state.Prefix.ValueString()) {
+		tflog.Warn(ctx, "CloudRouter PrefixAnnouncement not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// Update only saves the timeouts, all other attributes recreate the announcement.
+func (r *prefixAnnouncementResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan prefixAnnouncementModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *prefixAnnouncementResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state prefixAnnouncementModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, YandexCloudRouterPrefixAnnouncementDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	routingInstanceID := state.RoutingInstanceID.ValueString()
+
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(routingInstanceID)
+	defer mutexKV.Unlock(routingInstanceID)
+
+	ri_api.RemovePrefixes(ctx, r.providerConfig, &resp.Diagnostics, &cloudrouter.RemovePrefixesRequest{
+		RoutingInstanceId: routingInstanceID,
+		VpcNetworkId:      state.VpcNetworkID.ValueString(),
+		VpcAzInfoPrefixes: prefixes(&state),
+	})
+}
+
+func (r *prefixAnnouncementResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func prefixes(m *prefixAnnouncementModel) []*cloudrouter.VpcAzInfoPrefixes {
+	return []*cloudrouter.VpcAzInfoPrefixes{
+		{
+			AzId:     m.AzID.ValueString(),
+			Prefixes: []string{m.Prefix.ValueString()},
+		},
+	}
+}
@@ -0,0 +1,106 @@
+package cloudrouter_prefix_announcement_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+	ri_api "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/cloudrouter_routing_instance/api"
+)
+
+func TestAccCloudRouterPrefixAnnouncement_basic(t *testing.T) {
+	networkName := acctest.RandomWithPrefix("tf-cloudrouter-network")
+	name := acctest.RandomWithPrefix("tf-cloudrouter-ri")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudRouterPrefixAnnouncement(networkName, name, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudRouterPrefixAnnounced("yandex_cloudrouter_prefix_announcement.first", true),
+					testAccCheckCloudRouterPrefixAnnounced("yandex_cloudrouter_prefix_announcement.second", true),
+				),
+			},
+			{
+				ResourceName:      "yandex_cloudrouter_prefix_announcement.first",
+				ImportState:       true,
+				ImportStateVerify: true,
+				ImportStateVerifyIgnore: []string{
+					"timeouts",
+				},
+			},
+			{
+				Config: testAccCloudRouterPrefixAnnouncement(networkName, name, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudRouterPrefixAnnounced("yandex_cloudrouter_prefix_announcement.first", true),
+					resource.TestCheckNoResourceAttr("yandex_cloudrouter_prefix_announcement.second", "id"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCloudRouterPrefixAnnouncement(networkName, name string, withSecond bool) string {
+	config := fmt.Sprintf(`
+resource "yandex_vpc_network" "test" {
+  name = "%s"
+}
+
+resource "yandex_cloudrouter_routing_instance" "test" {
+  name            = "%s"
+  vpc_network_ids = [yandex_vpc_network.test.id]
+}
+
+resource "yandex_cloudrouter_prefix_announcement" "first" {
+  routing_instance_id = yandex_cloudrouter_routing_instance.test.id
+  vpc_network_id      = yandex_vpc_network.test.id
+  az_id               = "ru-central1-a"
+  prefix              = "10.10.0.0/24"
+}
+`, networkName, name)
+
+	if withSecond {
+		config += `
+resource "yandex_cloudrouter_prefix_announcement" "second" {
+  routing_instance_id = yandex_cloudrouter_routing_instance.test.id
+  vpc_network_id      = yandex_vpc_network.test.id
+  az_id               = "ru-central1-a"
+  prefix              = "10.10.1.0/24"
+}
+`
+	}
+	return config
+}
+
+func testAccCheckCloudRouterPrefixAnnounced(name string, announced bool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		var diags diag.Diagnostics
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+		ri := ri_api.ReadRoutingInstance(context.Background(), &config, &diags, rs.Primary.Attributes["routing_instance_id"])
+		if diags.HasError() {
+			return fmt.Errorf("failed to get routing instance: %v", diags)
+		}
+		if ri == nil {
+			return fmt.Errorf("routing instance not found")
+		}
+
+		found := ri_api.FindPrefix(ri, rs.Primary.Attributes["vpc_network_id"], rs.Primary.Attributes["az_id"], rs.Primary.Attributes["prefix"])
+		if found != announced {
+			return fmt.Errorf("prefix %s announced: %v, expected %v", rs.Primary.Attributes["prefix"], found, announced)
+		}
+		return nil
+	}
+}
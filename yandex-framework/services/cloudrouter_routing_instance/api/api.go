@@ -0,0 +1,194 @@
+package api
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/cloudrouter/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/retry"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/reflect/protoreflect"
+)
+
+// There is no Cloud Router client in the go-sdk, the connection is resolved by the service name.
+const routingInstanceService = protoreflect.FullName("yandex.cloud.cloudrouter.v1.RoutingInstanceService")
+
+func client(ctx context.Context, config *provider_config.Config) (cloudrouter.RoutingInstanceServiceClient, error) {
+	conn, err := config.SDKv2.GetConnection(ctx, routingInstanceService)
+	if err != nil {
+		return nil, err
+	}
+	return cloudrouter.NewRoutingInstanceServiceClient(conn), nil
+}
+
+func ReadRoutingInstance(ctx context.Context, config *provider_config.Config, diag *diag.Diagnostics, id string) *cloudrouter.RoutingInstance {
+	tflog.Debug(ctx, "Reading CloudRouter RoutingInstance", map[string]interface{}{"id": id})
+	c, err := client(ctx, config)
+	if err != nil {
+		diag.AddError(
+			"Failed to Read resource",
+			"Error while connecting to Cloud Router API: "+err.Error(),
+		)
+		return nil
+	}
+
+	ri, err := c.Get(ctx, &cloudrouter.GetRoutingInstanceRequest{
+		RoutingInstanceId: id,
+	})
+	if err != nil {
+		if validate.IsStatusWithCode(err, codes.NotFound) {
+			return nil
+		}
+
+		diag.AddError(
+			"Failed to Read resource",
+			"Error while requesting API to get Routing Instance: "+err.Error(),
+		)
+		return nil
+	}
+	return ri
+}
+
+func CreateRoutingInstance(ctx context.Context, config *provider_config.Config, diag *diag.Diagnostics, req *cloudrouter.CreateRoutingInstanceRequest) string {
+	tflog.Debug(ctx, "Creating CloudRouter RoutingInstance", map[string]interface{}{"name": req.Name})
+	c, err := client(ctx, config)
+	if err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while connecting to Cloud Router API: "+err.Error(),
+		)
+		return ""
+	}
+
+	op, err := config.SDK.WrapOperation(c.Create(ctx, req))
+	if err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while requesting API to create Routing Instance: "+err.Error(),
+		)
+		return ""
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while getting Routing Instance create operation metadata: "+err.Error(),
+		)
+		return ""
+	}
+
+	md, ok := protoMetadata.(*cloudrouter.CreateRoutingInstanceMetadata)
+	if !ok {
+		diag.AddError(
+			"Failed to Create resource",
+			"Could not get Routing Instance ID from create operation metadata",
+		)
+		return ""
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while waiting for operation to create Routing Instance: "+err.Error(),
+		)
+	}
+
+	return md.GetRoutingInstanceId()
+}
+
+func UpdateRoutingInstance(ctx context.Context, config *provider_config.Config, diag *diag.Diagnostics, req *cloudrouter.UpdateRoutingInstanceRequest) {
+	tflog.Debug(ctx, "Updating CloudRouter RoutingInstance", map[string]interface{}{"id": req.RoutingInstanceId})
+	runOperation(ctx, config, diag, "Failed to Update resource", "update Routing Instance", func(c cloudrouter.RoutingInstanceServiceClient) (*operation.Operation, error) {
+		return c.Update(ctx, req)
+	})
+}
+
+func AddPrivateConnection(ctx context.Context, config *provider_config.Config, diag *diag.Diagnostics, id, privateConnectionID string) {
+	tflog.Debug(ctx, "Adding Private Connection to CloudRouter RoutingInstance", map[string]interface{}{"id": id, "private_connection_id": privateConnectionID})
+	runOperation(ctx, config, diag, "Failed to Update resource", "add Private Connection to Routing Instance", func(c cloudrouter.RoutingInstanceServiceClient) (*operation.Operation, error) {
+		return c.AddPrivateConnection(ctx, &cloudrouter.AddPrivateConnectionRequest{
+			RoutingInstanceId:      id,
+			CicPrivateConnectionId: privateConnectionID,
+		})
+	})
+}
+
+func RemovePrivateConnection(ctx context.Context, config *provider_config.Config, diag *diag.Diagnostics, id, privateConnectionID string) {
+	tflog.Debug(ctx, "Removing Private Connection from CloudRouter RoutingInstance", map[string]interface{}{"id": id, "private_connection_id": privateConnectionID})
+	runOperation(ctx, config, diag, "Failed to Update resource", "remove Private Connection from Routing Instance", func(c cloudrouter.RoutingInstanceServiceClient) (*operation.Operation, error) {
+		return c.RemovePrivateConnection(ctx, &cloudrouter.RemovePrivateConnectionRequest{
+			RoutingInstanceId:      id,
+			CicPrivateConnectionId: privateConnectionID,
+		})
+	})
+}
+
+// UpsertPrefixes announces the prefixes of the VPC network availability zone through the routing instance.
+func UpsertPrefixes(ctx context.Context, config *provider_config.Config, diag *diag.Diagnostics, req *cloudrouter.UpsertPrefixesRequest) {
+	tflog.Debug(ctx, "Upserting CloudRouter RoutingInstance prefixes", map[string]interface{}{"id": req.RoutingInstanceId, "vpc_network_id": req.VpcNetworkId})
+	runOperation(ctx, config, diag, "Failed to Upsert Prefixes", "upsert prefixes of Routing Instance", func(c cloudrouter.RoutingInstanceServiceClient) (*operation.Operation, error) {
+		return c.UpsertPrefixes(ctx, req)
+	})
+}
+
+// RemovePrefixes stops announcing the prefixes of the VPC network availability zone through the routing instance.
+func RemovePrefixes(ctx context.Context, config *provider_config.Config, diag *diag.Diagnostics, req *cloudrouter.RemovePrefixesRequest) {
+	tflog.Debug(ctx, "Removing CloudRouter RoutingInstance prefixes", map[string]interface{}{"id": req.RoutingInstanceId, "vpc_network_id": req.VpcNetworkId})
+	runOperation(ctx, config, diag, "Failed to Remove Prefixes", "remove prefixes of Routing Instance", func(c cloudrouter.RoutingInstanceServiceClient) (*operation.Operation, error) {
+		return c.RemovePrefixes(ctx, req)
+	})
+}
+
+func DeleteRoutingInstance(ctx context.Context, config *provider_config.Config, diag *diag.Diagnostics, id string) {
+	tflog.Debug(ctx, "Deleting CloudRouter RoutingInstance", map[string]interface{}{"id": id})
+	runOperation(ctx, config, diag, "Failed to Delete resource", "delete Routing Instance", func(c cloudrouter.RoutingInstanceServiceClient) (*operation.Operation, error) {
+		return c.Delete(ctx, &cloudrouter.DeleteRoutingInstanceRequest{
+			RoutingInstanceId: id,
+		})
+	})
+}
+
+// FindPrefix reports whether the prefix is announced for the availability zone of the VPC network.
+func FindPrefix(ri *cloudrouter.RoutingInstance, vpcNetworkID, azID, prefix string) bool {
+	for _, vpcInfo := range ri.GetVpcInfo() {
+		if vpcInfo.GetVpcNetworkId() != vpcNetworkID {
+			continue
+		}
+		for _, azInfo := range vpcInfo.GetAzInfos() {
+			if azInfo.GetManualInfo().GetAzId() != azID {
+				continue
+			}
+			for _, p := range azInfo.GetManualInfo().GetPrefixes() {
+				if p == prefix {
+					return true
+				}
+			}
+		}
+	}
+	return false
+}
+
+func runOperation(ctx context.Context, config *provider_config.Config, diag *diag.Diagnostics, summary, action string, call func(cloudrouter.RoutingInstanceServiceClient) (*operation.Operation, error)) {
+	c, err := client(ctx, config)
+	if err != nil {
+		diag.AddError(summary, "Error while connecting to Cloud Router API: "+err.Error())
+		return
+	}
+
+	op, err := retry.ConflictingOperation(ctx, config.SDK, func() (*operation.Operation, error) {
+		return call(c)
+	})
+	if err != nil {
+		diag.AddError(summary, "Error while requesting API to "+action+": "+err.Error())
+		return
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diag.AddError(summary, "Error while waiting for operation to "+action+": "+err.Error())
+	}
+}
@@ -0,0 +1,43 @@
+package api
+
+import (
+	"testing"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/cloudrouter/v1"
+)
+
+func TestFindPrefix(t *testing.T) {
+	ri := &cloudrouter.RoutingInstance{
+		VpcInfo: []*cloudrouter.RoutingInstance_VpcInfo{
+			{
+				VpcNetworkId: "net1",
+				AzInfos: []*cloudrouter.RoutingInstance_VpcAzInfo{
+					{ManualInfo: &cloudrouter.RoutingInstance_VpcManualInfo{AzId: "ru-central1-a", Prefixes: []string{"10.0.0.0/24", "10.0.1.0/24"}}},
+					{ManualInfo: &cloudrouter.RoutingInstance_VpcManualInfo{AzId: "ru-central1-b", Prefixes: []string{"10.1.0.0/24"}}},
+				},
+			},
+			{
+				VpcNetworkId: "net2",
+				AzInfos: []*cloudrouter.RoutingInstance_VpcAzInfo{
+					{ManualInfo: &cloudrouter.RoutingInstance_VpcManualInfo{AzId: "ru-central1-a", Prefixes: []string{"10.2.0.0/24"}}},
+				},
+			},
+		},
+	}
+
+	for _, tc := range []struct {
+		network, zone, prefix string
+		found                 bool
+	}{
+		{"net1", "ru-central1-a", "10.0.1.0/24", true},
+		{"net1", "ru-central1-b", "10.1.0.0/24", true},
+		{"net2", "ru-central1-a", "10.2.0.0/24", true},
+		{"net1", "ru-central1-b", "10.0.1.0/24", false},
+		{"net2", "ru-central1-a", "10.0.0.0/24", false},
+		{"net3", "ru-central1-a", "10.0.0.0/24", false},
+	} {
+		if found := FindPrefix(ri, tc.network, tc.zone, tc.prefix); found != tc.found {
+			t.Errorf("FindPrefix(%s, %s, %s) = %v, expected %v", tc.network, tc.zone, tc.prefix, found, tc.found)
+		}
+	}
+}
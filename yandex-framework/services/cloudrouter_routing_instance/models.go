@@ -0,0 +1,108 @@
+package cloudrouter_routing_instance
+
+import (
+	"context"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/cloudrouter/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/timestamp"
+)
+
+type routingInstanceModel struct {
+	Timeouts                timeouts.Value `tfsdk:"timeouts"`
+	ID                      types.String   `tfsdk:"id"`
+	CreatedAt               types.String   `tfsdk:"created_at"`
+	Name                    types.String   `tfsdk:"name"`
+	Description             types.String   `tfsdk:"description"`
+	Labels                  types.Map      `tfsdk:"labels"`
+	FolderID                types.String   `tfsdk:"folder_id"`
+	RegionID                types.String   `tfsdk:"region_id"`
+	VpcNetworkIDs           types.Set      `tfsdk:"vpc_network_ids"`
+	CicPrivateConnectionIDs types.Set      `tfsdk:"cic_private_connection_ids"`
+	Status                  types.String   `tfsdk:"status"`
+}
+
+func routingInstanceToState(ctx context.Context, ri *cloudrouter.RoutingInstance, state *routingInstanceModel) diag.Diagnostics {
+	state.FolderID = types.StringValue(ri.GetFolderId())
+	state.RegionID = types.StringValue(ri.GetRegionId())
+	state.Status = types.StringValue(ri.GetStatus().String())
+	state.CreatedAt = types.StringValue(timestamp.Get(ri.GetCreatedAt()))
+	if !state.Name.IsNull() || ri.GetName() != "" {
+		state.Name = types.StringValue(ri.GetName())
+	}
+	if state.Description.IsUnknown() || ri.GetDescription() != "" {
+		state.Description = types.StringValue(ri.GetDescription())
+	}
+
+	var diags diag.Diagnostics
+	if state.Labels.IsUnknown() || ri.Labels != nil {
+		state.Labels, diags = types.MapValueFrom(ctx, types.StringType, ri.Labels)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if networks := vpcNetworkIDs(ri); !state.VpcNetworkIDs.IsNull() || len(networks) > 0 {
+		state.VpcNetworkIDs, diags = types.SetValueFrom(ctx, types.StringType, networks)
+		if diags.HasError() {
+			return diags
+		}
+	}
+
+	if connections := privateConnectionIDs(ri); !state.CicPrivateConnectionIDs.IsNull() || len(connections) > 0 {
+		state.CicPrivateConnectionIDs, diags = types.SetValueFrom(ctx, types.StringType, connections)
+	}
+	return diags
+}
+
+func vpcNetworkIDs(ri *cloudrouter.RoutingInstance) []string {
+	ids := make([]string, 0, len(ri.GetVpcInfo()))
+	for _, info := range ri.GetVpcInfo() {
+		ids = append(ids, info.GetVpcNetworkId())
+	}
+	return ids
+}
+
+func privateConnectionIDs(ri *cloudrouter.RoutingInstance) []string {
+	ids := make([]string, 0, len(ri.GetCicPrivateConnectionInfo()))
+	for _, info := range ri.GetCicPrivateConnectionInfo() {
+		ids = append(ids, info.GetCicPrivateConnectionId())
+	}
+	return ids
+}
+
+func expandStringSet(ctx context.Context, set types.Set) ([]string, diag.Diagnostics) {
+	var values []string
+	if set.IsNull() || set.IsUnknown() {
+		return values, nil
+	}
+	diags := set.ElementsAs(ctx, &values, false)
+	return values, diags
+}
+
+// stringSetDelta returns the sorted values which are missing in the old list and the ones missing in the new list.
+func stringSetDelta(old, new []string) (add, remove []string) {
+	oldSet := make(map[string]bool, len(old))
+	for _, v := range old {
+		oldSet[v] = true
+	}
+	newSet := make(map[string]bool, len(new))
+	for _, v := range new {
+		newSet[v] = true
+		if !oldSet[v] {
+			add = append(add, v)
+		}
+	}
+	for _, v := range old {
+		if !newSet[v] {
+			remove = append(remove, v)
+		}
+	}
+
+	sort.Strings(add)
+	sort.Strings(remove)
+	return add, remove
+}
@@ -0,0 +1,26 @@
+package cloudrouter_routing_instance
+
+import (
+	"reflect"
+	"testing"
+)
+
+func TestStringSetDelta(t *testing.T) {
+	add, remove := stringSetDelta([]string{"cf1", "cf2", "cf3"}, []string{"cf4", "cf2", "cf1", "cf0"})
+	if !reflect.DeepEqual(add, []string{"cf0", "cf4"}) {
+		t.Errorf("unexpected added values %v", add)
+	}
+	if !reflect.DeepEqual(remove, []string{"cf3"}) {
+		t.Errorf("unexpected removed values %v", remove)
+	}
+
+	add, remove = stringSetDelta([]string{"cf1"}, []string{"cf1"})
+	if len(add) != 0 || len(remove) != 0 {
+		t.Errorf("expected no changes, got added %v and removed %v", add, remove)
+	}
+
+	add, remove = stringSetDelta(nil, []string{"cf1"})
+	if !reflect.DeepEqual(add, []string{"cf1"}) || len(remove) != 0 {
+		t.Errorf("expected cf1 to be added, got added %v and removed %v", add, remove)
+	}
+}
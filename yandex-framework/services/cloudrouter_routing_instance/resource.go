@@ -0,0 +1,320 @@
+package cloudrouter_routing_instance
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/cloudrouter/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common/defaultschema"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	ri_api "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/cloudrouter_routing_instance/api"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+const YandexCloudRouterRoutingInstanceDefaultTimeout = 10 * time.Minute
+
+var (
+	_ resource.Resource                = &routingInstanceResource{}
+	_ resource.ResourceWithConfigure   = &routingInstanceResource{}
+	_ resource.ResourceWithImportState = &routingInstanceResource{}
+)
+
+type routingInstanceResource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewResource() resource.Resource {
+	return &routingInstanceResource{}
+}
+
+func (r *routingInstanceResource) Metadata(ctx context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_cloudrouter_routing_instance"
+}
+
+func (r *routingInstanceResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
+	tflog.Debug(ctx, "Initializing CloudRouter RoutingInstance schema")
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Cloud Router `Routing Instance` within the Yandex Cloud. A routing instance connects VPC networks with Cloud Interconnect private connections. For more information, see [Documentation](https://yandex.cloud/docs/cloud-router/concepts/routing-instance).\n\n~> The prefixes announced from the VPC networks are managed by `yandex_cloudrouter_prefix_announcement` resources.\n\n",
+		Attributes: map[string]schema.Attribute{
+			"id":          defaultschema.Id(),
+			"folder_id":   defaultschema.FolderId(),
+			"name":        defaultschema.Name(),
+			"description": defaultschema.Description(),
+			"labels":      defaultschema.Labels(),
+			"created_at":  defaultschema.CreatedAt(),
+			"region_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the region the routing instance belongs to.",
+				Optional:            true,
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"vpc_network_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the VPC networks attached to the routing instance. Changing the networks recreates the routing instance.",
+				Optional:            true,
+				ElementType:         types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"cic_private_connection_ids": schema.SetAttribute{
+				MarkdownDescription: "IDs of the Cloud Interconnect private connections attached to the routing instance.",
+				Optional:            true,
+				ElementType:         types.StringType,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "The Routing Instance status.",
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+		},
+		Blocks: map[string]schema.Block{
+			"timeouts": timeouts.Block(ctx, timeouts.Opts{
+				Create: true,
+				Update: true,
+				Delete: true,
+			}),
+		},
+	}
+}
+
+func (r *routingInstanceResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *routingInstanceResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan routingInstanceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, YandexCloudRouterRoutingInstanceDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	folderID, d := validate.FolderID(plan.FolderID, &r.providerConfig.ProviderState)
+	resp.Diagnostics.Append(d)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	labels := make(map[string]string, len(plan.Labels.Elements()))
+	resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &labels, false)...)
+	networks, diags := expandStringSet(ctx, plan.VpcNetworkIDs)
+	resp.Diagnostics.Append(diags...)
+	connections, diags := expandStringSet(ctx, plan.CicPrivateConnectionIDs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	createReq := &cloudrouter.CreateRoutingInstanceRequest{
+		FolderId:    folderID,
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Labels:      labels,
+		RegionId:    plan.RegionID.ValueString(),
+	}
+	for _, id := range networks {
+		createReq.VpcInfo = append(createReq.VpcInfo, &cloudrouter.RoutingInstance_VpcInfo{VpcNetworkId: id})
+	}
+	for _, id := range connections {
+		createReq.CicPrivateConnectionInfo = append(createReq.CicPrivateConnectionInfo, &cloudrouter.RoutingInstance_CicPrivateConnectionInfo{CicPrivateConnectionId: id})
+	}
+
+	plan.ID = types.StringValue(ri_api.CreateRoutingInstance(ctx, r.providerConfig, &resp.Diagnostics, createReq))
+	if plan.ID.ValueString() == "" {
+		return
+	}
+	// Save the ID even if waiting has failed, so the routing instance isn't lost
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refreshState(ctx, &plan, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *routingInstanceResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state routingInstanceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	ri := ri_api.ReadRoutingInstance(ctx, r.providerConfig, &resp.Diagnostics, state.ID.ValueString())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if ri == nil {
+		tflog.Warn(ctx, "CloudRouter RoutingInstance not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(routingInstanceToState(ctx, ri, &state)...)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *routingInstanceResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state routingInstanceModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, YandexCloudRouterRoutingInstanceDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	id := state.ID.ValueString()
+	updateReq := &cloudrouter.UpdateRoutingInstanceRequest{
+		RoutingInstanceId: id,
+		UpdateMask:        &field_mask.FieldMask{},
+	}
+	if !plan.Name.Equal(state.Name) {
+		updateReq.Name = plan.Name.ValueString()
+		updateReq.UpdateMask.Paths = append(updateReq.UpdateMask.Paths, "name")
+	}
+	if !plan.Description.Equal(state.Description) {
+		updateReq.Description = plan.Description.ValueString()
+		updateReq.UpdateMask.Paths = append(updateReq.UpdateMask.Paths, "description")
+	}
+	if !plan.Labels.Equal(state.Labels) {
+		labels := make(map[string]string, len(plan.Labels.Elements()))
+		resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &labels, false)...)
+		updateReq.Labels = labels
+		updateReq.UpdateMask.Paths = append(updateReq.UpdateMask.Paths, "labels")
+	}
+	if !plan.RegionID.Equal(state.RegionID) {
+		updateReq.RegionId = plan.RegionID.ValueString()
+		updateReq.UpdateMask.Paths = append(updateReq.UpdateMask.Paths, "region_id")
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// yandex_cloudrouter_prefix_announcement resources modify the routing instance under the same lock
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(id)
+	defer mutexKV.Unlock(id)
+
+	if len(updateReq.UpdateMask.Paths) > 0 {
+		ri_api.UpdateRoutingInstance(ctx, r.providerConfig, &resp.Diagnostics, updateReq)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if !plan.CicPrivateConnectionIDs.Equal(state.CicPrivateConnectionIDs) {
+		r.updatePrivateConnections(ctx, id, &plan, &state, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	r.refreshState(ctx, &plan, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// updatePrivateConnections detaches the private connections removed from the plan first, so a connection
+// can be replaced by another one of the same on-premise network.
+func (r *routingInstanceResource) updatePrivateConnections(ctx context.Context, id string, plan, state *routingInstanceModel, diags *diag.Diagnostics) {
+	planned, d := expandStringSet(ctx, plan.CicPrivateConnectionIDs)
+	diags.Append(d...)
+	current, d := expandStringSet(ctx, state.CicPrivateConnectionIDs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	add, remove := stringSetDelta(current, planned)
+	for _, connectionID := range remove {
+		ri_api.RemovePrivateConnection(ctx, r.providerConfig, diags, id, connectionID)
+		if diags.HasError() {
+			return
+		}
+	}
+	for _, connectionID := range add {
+		ri_api.AddPrivateConnection(ctx, r.providerConfig, diags, id, connectionID)
+		if diags.HasError() {
+			return
+		}
+	}
+}
+
+func (r *routingInstanceResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state routingInstanceModel
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	deleteTimeout, diags := state.Timeouts.Delete(ctx, YandexCloudRouterRoutingInstanceDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, deleteTimeout)
+	defer cancel()
+
+	ri_api.DeleteRoutingInstance(ctx, r.providerConfig, &resp.Diagnostics, state.ID.ValueString())
+}
+
+func (r *routingInstanceResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *routingInstanceResource) refreshState(ctx context.Context, state *routingInstanceModel, diags *diag.Diagnostics) {
+	id := state.ID.ValueString()
+	ri := ri_api.ReadRoutingInstance(ctx, r.providerConfig, diags, id)
+	if diags.HasError() {
+		return
+	}
+	if ri == nil {
+		diags.AddError(
+			"Failed to get RoutingInstance",
+			fmt.Sprintf("RoutingInstance with id %s not found", id))
+		return
+	}
+
+	diags.Append(routingInstanceToState(ctx, ri, state)...)
+}
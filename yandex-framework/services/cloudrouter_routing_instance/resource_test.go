@@ -0,0 +1,115 @@
+package cloudrouter_routing_instance_test
+
+import (
+	"context"
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+	ri_api "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/cloudrouter_routing_instance/api"
+)
+
+func TestAccCloudRouterRoutingInstance_basic(t *testing.T) {
+	networkName := acctest.RandomWithPrefix("tf-cloudrouter-network")
+	name := acctest.RandomWithPrefix("tf-cloudrouter-ri")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckCloudRouterRoutingInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccCloudRouterRoutingInstance(networkName, name, "first"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckCloudRouterRoutingInstanceExists("yandex_cloudrouter_routing_instance.test"),
+					resource.TestCheckResourceAttr("yandex_cloudrouter_routing_instance.test", "name", name),
+					resource.TestCheckResourceAttr("yandex_cloudrouter_routing_instance.test", "description", "first"),
+					resource.TestCheckResourceAttr("yandex_cloudrouter_routing_instance.test", "vpc_network_ids.#", "1"),
+					resource.TestCheckResourceAttrPair("yandex_cloudrouter_routing_instance.test", "vpc_network_ids.0", "yandex_vpc_network.test", "id"),
+					resource.TestCheckResourceAttr("yandex_cloudrouter_routing_instance.test", "status", "ACTIVE"),
+					test.AccCheckCreatedAtAttr("yandex_cloudrouter_routing_instance.test"),
+				),
+			},
+			{
+				Config: testAccCloudRouterRoutingInstance(networkName, name, "second"),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_cloudrouter_routing_instance.test", "description", "second"),
+				),
+			},
+			{
+				ResourceName:      "yandex_cloudrouter_routing_instance.test",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCloudRouterRoutingInstance(networkName, name, description string) string {
+	return fmt.Sprintf(`
+resource "yandex_vpc_network" "test" {
+  name = "%s"
+}
+
+resource "yandex_cloudrouter_routing_instance" "test" {
+  name            = "%s"
+  description     = "%s"
+  vpc_network_ids = [yandex_vpc_network.test.id]
+
+  labels = {
+    tf-label = "tf-label-value"
+  }
+}
+`, networkName, name, description)
+}
+
+func testAccCheckCloudRouterRoutingInstanceExists(name string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[name]
+		if !ok {
+			return fmt.Errorf("not found: %s", name)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("no ID is set")
+		}
+
+		var diags diag.Diagnostics
+		config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+		ri := ri_api.ReadRoutingInstance(context.Background(), &config, &diags, rs.Primary.ID)
+		if diags.HasError() {
+			return fmt.Errorf("failed to get routing instance: %v", diags)
+		}
+		if ri == nil {
+			return fmt.Errorf("routing instance not found")
+		}
+
+		return nil
+	}
+}
+
+func testAccCheckCloudRouterRoutingInstanceDestroy(s *terraform.State) error {
+	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_cloudrouter_routing_instance" {
+			continue
+		}
+
+		var diags diag.Diagnostics
+		ri := ri_api.ReadRoutingInstance(context.Background(), &config, &diags, rs.Primary.ID)
+		if diags.HasError() {
+			return fmt.Errorf("failed to get routing instance: %v", diags)
+		}
+		if ri != nil {
+			return fmt.Errorf("routing instance still exists")
+		}
+	}
+
+	return nil
+}
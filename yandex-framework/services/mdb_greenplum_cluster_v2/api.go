@@ -0,0 +1,210 @@
+package mdb_greenplum_cluster_v2
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/retry"
+	"google.golang.org/grpc"
+)
+
+const defaultMDBPageSize = 1000
+
+// expandDuration is the timeout of data redistribution after the segments expansion, in seconds.
+const expandDuration = 7200
+
+var greenplumApi = GreenplumAPI{}
+
+type GreenplumAPI struct{}
+
+// ==============================================================================
+//                                     HOST
+// ==============================================================================
+
+type listHostsFunc func(ctx context.Context, in *greenplum.ListClusterHostsRequest, opts ...grpc.CallOption) (*greenplum.ListClusterHostsResponse, error)
+
+func (r *GreenplumAPI) listHosts(ctx context.Context, diags *diag.Diagnostics, list listHostsFunc, cid string) []*greenplum.Host {
+	hosts := []*greenplum.Host{}
+	pageToken := ""
+
+	for {
+		resp, err := list(ctx, &greenplum.ListClusterHostsRequest{
+			ClusterId: cid,
+			PageSize:  defaultMDBPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			diags.AddError(
+				"Failed to List Greenplum Hosts",
+				"Error while requesting API to get Greenplum host:"+err.Error(),
+			)
+			return nil
+		}
+
+		hosts = append(hosts, resp.Hosts...)
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	return hosts
+}
+
+// Hosts of the Greenplum cluster are derived from the hosts count of subclusters,
+// so the API allows only to list them. Master and segment hosts are listed with different methods.
+type masterHostsAPI struct {
+	*GreenplumAPI
+}
+
+func (r *masterHostsAPI) ListHosts(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) []*greenplum.Host {
+	return r.listHosts(ctx, diags, sdk.MDB().Greenplum().Cluster().ListMasterHosts, cid)
+}
+
+type segmentHostsAPI struct {
+	*GreenplumAPI
+}
+
+func (r *segmentHostsAPI) ListHosts(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) []*greenplum.Host {
+	return r.listHosts(ctx, diags, sdk.MDB().Greenplum().Cluster().ListSegmentHosts, cid)
+}
+
+// ==============================================================================
+//                                 CLUSTER
+// ==============================================================================
+
+func (r *GreenplumAPI) GetCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) *greenplum.Cluster {
+	cluster, err := sdk.MDB().Greenplum().Cluster().Get(ctx, &greenplum.GetClusterRequest{
+		ClusterId: cid,
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Failed to read resource",
+			fmt.Sprintf("Error while requesting API to read Greenplum cluster %q: %s", cid, err.Error()),
+		)
+		return nil
+	}
+	return cluster
+}
+
+func (r *GreenplumAPI) DeleteCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) {
+	op, err := sdk.WrapOperation(sdk.MDB().Greenplum().Cluster().Delete(ctx, &greenplum.DeleteClusterRequest{
+		ClusterId: cid,
+	}))
+
+	if err != nil {
+		diags.AddError(
+			"Failed to delete resource",
+			fmt.Sprintf("Error while requesting API to delete Greenplum cluster %q: %s", cid, err.Error()),
+		)
+		return
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to delete resource",
+			fmt.Sprintf("Error while waiting for operation %q to delete Greenplum cluster %q: %s", op.Id(), cid, err.Error()),
+		)
+	}
+}
+
+func (r *GreenplumAPI) CreateCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, req *greenplum.CreateClusterRequest) string {
+	op, err := sdk.WrapOperation(sdk.MDB().Greenplum().Cluster().Create(ctx, req))
+	if err != nil {
+		diags.AddError(
+			"Failed to create resource",
+			fmt.Sprintf("Error while requesting API to create Greenplum cluster: %s", err.Error()),
+		)
+		return ""
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		diags.AddError(
+			"Failed to create resource",
+			fmt.Sprintf("Error while unmarshaling for operation %q API response metadata: %s", op.Id(), err.Error()),
+		)
+		return ""
+	}
+
+	md, ok := protoMetadata.(*greenplum.CreateClusterMetadata)
+	if !ok {
+		diags.AddError(
+			"Failed to create resource",
+			fmt.Sprintf("Error while unmarshaling for operation %q API response metadata", op.Id()),
+		)
+		return ""
+	}
+
+	log.Printf("[DEBUG] Creating Greenplum Cluster %q", md.ClusterId)
+
+	if err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to create resource",
+			fmt.Sprintf("Error while waiting for operation %q to create Greenplum cluster: %s", op.Id(), err.Error()),
+		)
+		return ""
+	}
+
+	return md.ClusterId
+}
+
+func (r *GreenplumAPI) UpdateCluster(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, req *greenplum.UpdateClusterRequest) {
+	if req == nil || len(req.UpdateMask.Paths) == 0 {
+		return
+	}
+
+	op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
+		log.Printf("[DEBUG] Sending Greenplum cluster update request: %+v", req)
+		return sdk.MDB().Greenplum().Cluster().Update(ctx, req)
+	})
+	if err != nil {
+		diag.AddError(
+			"Failed to update resource",
+			fmt.Sprintf("Error while requesting API to update Greenplum cluster: %s", err.Error()),
+		)
+		return
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diag.AddError(
+			"Failed to update resource",
+			fmt.Sprintf("Error while waiting for operation %q to update Greenplum cluster: %s", op.Id(), err.Error()),
+		)
+		return
+	}
+}
+
+// ExpandCluster adds segment hosts and segments per host, the request contains increments of both counters.
+func (r *GreenplumAPI) ExpandCluster(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, req *greenplum.ExpandRequest) {
+	if req == nil {
+		return
+	}
+
+	op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
+		log.Printf("[DEBUG] Sending Greenplum cluster expand request: %+v", req)
+		return sdk.MDB().Greenplum().Cluster().Expand(ctx, req)
+	})
+	if err != nil {
+		diag.AddError(
+			"Failed to update resource",
+			fmt.Sprintf("Error while requesting API to expand Greenplum cluster: %s", err.Error()),
+		)
+		return
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diag.AddError(
+			"Failed to update resource",
+			fmt.Sprintf("Error while waiting for operation %q to expand Greenplum cluster: %s", op.Id(), err.Error()),
+		)
+		return
+	}
+}
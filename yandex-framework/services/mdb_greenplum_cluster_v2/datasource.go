@@ -0,0 +1,506 @@
+package mdb_greenplum_cluster_v2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-sdk/sdkresolvers"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/objectid"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+func NewGreenplumClusterDataSourceV2() datasource.DataSource {
+	return &clusterDataSource{}
+}
+
+type clusterDataSource struct {
+	providerConfig *provider_config.Config
+}
+
+// ClusterDataSource is the Cluster model without the admin password, which can't be read from the API.
+type ClusterDataSource struct {
+	Id                   types.String               `tfsdk:"id"`
+	ClusterId            types.String               `tfsdk:"cluster_id"`
+	FolderId             types.String               `tfsdk:"folder_id"`
+	NetworkId            types.String               `tfsdk:"network_id"`
+	Name                 types.String               `tfsdk:"name"`
+	Description          types.String               `tfsdk:"description"`
+	Environment          types.String               `tfsdk:"environment"`
+	Labels               types.Map                  `tfsdk:"labels"`
+	ZoneId               types.String               `tfsdk:"zone_id"`
+	SubnetId             types.String               `tfsdk:"subnet_id"`
+	AssignPublicIp       types.Bool                 `tfsdk:"assign_public_ip"`
+	Version              types.String               `tfsdk:"version"`
+	MasterHostCount      types.Int64                `tfsdk:"master_host_count"`
+	SegmentHostCount     types.Int64                `tfsdk:"segment_host_count"`
+	SegmentInHost        types.Int64                `tfsdk:"segment_in_host"`
+	MasterSubcluster     types.Object               `tfsdk:"master_subcluster"`
+	SegmentSubcluster    types.Object               `tfsdk:"segment_subcluster"`
+	MasterHostGroupIds   types.Set                  `tfsdk:"master_host_group_ids"`
+	SegmentHostGroupIds  types.Set                  `tfsdk:"segment_host_group_ids"`
+	UserName             types.String               `tfsdk:"user_name"`
+	SecurityGroupIds     types.Set                  `tfsdk:"security_group_ids"`
+	DeletionProtection   types.Bool                 `tfsdk:"deletion_protection"`
+	ServiceAccountId     types.String               `tfsdk:"service_account_id"`
+	MaintenanceWindow    types.Object               `tfsdk:"maintenance_window"`
+	BackupWindowStart    types.Object               `tfsdk:"backup_window_start"`
+	Access               types.Object               `tfsdk:"access"`
+	CloudStorage         types.Object               `tfsdk:"cloud_storage"`
+	Logging              types.Object               `tfsdk:"logging"`
+	GreenplumConfig      mdbcommon.SettingsMapValue `tfsdk:"greenplum_config"`
+	PoolerConfig         mdbcommon.SettingsMapValue `tfsdk:"pooler_config"`
+	PxfConfig            mdbcommon.SettingsMapValue `tfsdk:"pxf_config"`
+	BackgroundActivities types.Object               `tfsdk:"background_activities"`
+	MasterHosts          types.Map                  `tfsdk:"master_hosts"`
+	SegmentHosts         types.Map                  `tfsdk:"segment_hosts"`
+	CreatedAt            types.String               `tfsdk:"created_at"`
+	Health               types.String               `tfsdk:"health"`
+	Status               types.String               `tfsdk:"status"`
+}
+
+func newClusterDataSource(c *Cluster) *ClusterDataSource {
+	return &ClusterDataSource{
+		Id:                   c.Id,
+		ClusterId:            c.ClusterId,
+		FolderId:             c.FolderId,
+		NetworkId:            c.NetworkId,
+		Name:                 c.Name,
+		Description:          c.Description,
+		Environment:          c.Environment,
+		Labels:               c.Labels,
+		ZoneId:               c.ZoneId,
+		SubnetId:             c.SubnetId,
+		AssignPublicIp:       c.AssignPublicIp,
+		Version:              c.Version,
+		MasterHostCount:      c.MasterHostCount,
+		SegmentHostCount:     c.SegmentHostCount,
+		SegmentInHost:        c.SegmentInHost,
+		MasterSubcluster:     c.MasterSubcluster,
+		SegmentSubcluster:    c.SegmentSubcluster,
+		MasterHostGroupIds:   c.MasterHostGroupIds,
+		SegmentHostGroupIds:  c.SegmentHostGroupIds,
+		UserName:             c.UserName,
+		SecurityGroupIds:     c.SecurityGroupIds,
+		DeletionProtection:   c.DeletionProtection,
+		ServiceAccountId:     c.ServiceAccountId,
+		MaintenanceWindow:    c.MaintenanceWindow,
+		BackupWindowStart:    c.BackupWindowStart,
+		Access:               c.Access,
+		CloudStorage:         c.CloudStorage,
+		Logging:              c.Logging,
+		GreenplumConfig:      c.GreenplumConfig,
+		PoolerConfig:         c.PoolerConfig,
+		PxfConfig:            c.PxfConfig,
+		BackgroundActivities: c.BackgroundActivities,
+		MasterHosts:          c.MasterHosts,
+		SegmentHosts:         c.SegmentHosts,
+		CreatedAt:            c.CreatedAt,
+		Health:               c.Health,
+		Status:               c.Status,
+	}
+}
+
+// Configure implements datasource.DataSource.
+func (d *clusterDataSource) Configure(_ context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected DataSource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+// Metadata implements datasource.DataSource.
+func (d *clusterDataSource) Metadata(_ context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_greenplum_cluster_v2"
+}
+
+// Read implements datasource.DataSource.
+func (d *clusterDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var config ClusterDataSource
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	clusterId := config.ClusterId.ValueString()
+	if clusterId == "" {
+		if config.Name.ValueString() == "" {
+			resp.Diagnostics.AddError(
+				"At least one of cluster_id or name is required",
+				"The cluster ID or Name must be specified in the configuration",
+			)
+			return
+		}
+
+		folderID, diag := validate.FolderID(config.FolderId, &d.providerConfig.ProviderState)
+		if diag != nil {
+			resp.Diagnostics.Append(diag)
+			return
+		}
+
+		clusterId, diag = objectid.ResolveByNameAndFolderID(ctx, d.providerConfig.SDK, folderID, config.Name.ValueString(), sdkresolvers.GreenplumClusterResolver)
+		if diag != nil {
+			resp.Diagnostics.Append(diag)
+			return
+		}
+	}
+
+	state := Cluster{Id: types.StringValue(clusterId)}
+	refreshClusterState(ctx, d.providerConfig, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, newClusterDataSource(&state))...)
+}
+
+func dataSourceSubclusterSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"resources": schema.SingleNestedAttribute{
+				MarkdownDescription: "Resources allocated to hosts of the subcluster.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"resource_preset_id": schema.StringAttribute{
+						MarkdownDescription: "The ID of the preset for computational resources available to a host.",
+						Computed:            true,
+					},
+					"disk_type_id": schema.StringAttribute{
+						MarkdownDescription: "Type of the storage of hosts.",
+						Computed:            true,
+					},
+					"disk_size": schema.Int64Attribute{
+						MarkdownDescription: "Volume of the storage available to a host, in gigabytes.",
+						Computed:            true,
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceQueryKillerSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		Attributes: map[string]schema.Attribute{
+			"enable": schema.BoolAttribute{
+				MarkdownDescription: "Flag that indicates whether script is enabled.",
+				Computed:            true,
+			},
+			"max_age": schema.Int64Attribute{
+				MarkdownDescription: "Maximum duration for this type of queries (in seconds).",
+				Computed:            true,
+			},
+			"ignore_users": schema.ListAttribute{
+				MarkdownDescription: "List of users to ignore when considering queries to terminate.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+		},
+	}
+}
+
+func dataSourceHostsSchema(description string) schema.MapNestedAttribute {
+	return schema.MapNestedAttribute{
+		MarkdownDescription: description,
+		Computed:            true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"fqdn": schema.StringAttribute{
+					MarkdownDescription: "The fully qualified domain name of the host.",
+					Computed:            true,
+				},
+				"zone": schema.StringAttribute{
+					MarkdownDescription: "The availability zone where the host is located.",
+					Computed:            true,
+				},
+				"type": schema.StringAttribute{
+					MarkdownDescription: "Type of the host in the cluster.",
+					Computed:            true,
+				},
+				"health": schema.StringAttribute{
+					MarkdownDescription: "Health of the host.",
+					Computed:            true,
+				},
+				"subnet_id": schema.StringAttribute{
+					MarkdownDescription: "ID of the subnet where the host is located.",
+					Computed:            true,
+				},
+				"assign_public_ip": schema.BoolAttribute{
+					MarkdownDescription: "Whether the host has a public IP address.",
+					Computed:            true,
+				},
+			},
+		},
+	}
+}
+
+func (d *clusterDataSource) Schema(ctx context.Context, _ datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Info(ctx, "Initializing Greenplum data source schema")
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Get information about a Yandex Managed Greenplum cluster. For more information, see [the official documentation](https://yandex.cloud/docs/managed-greenplum/concepts).\n\n~> Either `cluster_id` or `name` should be specified.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["id"],
+				Computed:            true,
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the Greenplum cluster.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "Name of the Greenplum cluster.",
+				Optional:            true,
+				Computed:            true,
+			},
+			"folder_id": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["folder_id"],
+				Optional:            true,
+				Computed:            true,
+			},
+			"network_id": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["network_id"],
+				Computed:            true,
+			},
+			"description": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["description"],
+				Computed:            true,
+			},
+			"environment": schema.StringAttribute{
+				MarkdownDescription: "Deployment environment of the Greenplum cluster.",
+				Computed:            true,
+			},
+			"labels": schema.MapAttribute{
+				MarkdownDescription: common.ResourceDescriptions["labels"],
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"zone_id": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["zone"],
+				Computed:            true,
+			},
+			"subnet_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of the subnet, to which the hosts belongs.",
+				Computed:            true,
+			},
+			"assign_public_ip": schema.BoolAttribute{
+				MarkdownDescription: "Whether the master hosts have a public IP address.",
+				Computed:            true,
+			},
+			"version": schema.StringAttribute{
+				MarkdownDescription: "Version of the Greenplum cluster.",
+				Computed:            true,
+			},
+			"master_host_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of hosts in the master subcluster.",
+				Computed:            true,
+			},
+			"segment_host_count": schema.Int64Attribute{
+				MarkdownDescription: "Number of hosts in the segment subcluster.",
+				Computed:            true,
+			},
+			"segment_in_host": schema.Int64Attribute{
+				MarkdownDescription: "Number of segments on a segment host.",
+				Computed:            true,
+			},
+			"master_subcluster":  dataSourceSubclusterSchema("Settings of the master subcluster."),
+			"segment_subcluster": dataSourceSubclusterSchema("Settings of the segment subcluster."),
+			"master_host_group_ids": schema.SetAttribute{
+				MarkdownDescription: "A list of IDs of the host groups hosting VMs of the master subcluster.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"segment_host_group_ids": schema.SetAttribute{
+				MarkdownDescription: "A list of IDs of the host groups hosting VMs of the segment subcluster.",
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"user_name": schema.StringAttribute{
+				MarkdownDescription: "Greenplum cluster admin user name.",
+				Computed:            true,
+			},
+			"security_group_ids": schema.SetAttribute{
+				MarkdownDescription: common.ResourceDescriptions["security_group_ids"],
+				Computed:            true,
+				ElementType:         types.StringType,
+			},
+			"deletion_protection": schema.BoolAttribute{
+				MarkdownDescription: common.ResourceDescriptions["deletion_protection"],
+				Computed:            true,
+			},
+			"service_account_id": schema.StringAttribute{
+				MarkdownDescription: "ID of service account used with Yandex Cloud resources.",
+				Computed:            true,
+			},
+			"maintenance_window": schema.SingleNestedAttribute{
+				MarkdownDescription: "Maintenance policy of the Greenplum cluster.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						MarkdownDescription: "Type of maintenance window.",
+						Computed:            true,
+					},
+					"day": schema.StringAttribute{
+						MarkdownDescription: "Day of the week (in DDD format).",
+						Computed:            true,
+					},
+					"hour": schema.Int64Attribute{
+						MarkdownDescription: "Hour of the day in UTC (in HH format).",
+						Computed:            true,
+					},
+				},
+			},
+			"backup_window_start": schema.SingleNestedAttribute{
+				MarkdownDescription: "Time to start the daily backup, in the UTC timezone.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"hours": schema.Int64Attribute{
+						MarkdownDescription: "The hour at which backup will be started (UTC).",
+						Computed:            true,
+					},
+					"minutes": schema.Int64Attribute{
+						MarkdownDescription: "The minute at which backup will be started (UTC).",
+						Computed:            true,
+					},
+				},
+			},
+			"access": schema.SingleNestedAttribute{
+				MarkdownDescription: "Access policy to the Greenplum cluster.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"data_lens": schema.BoolAttribute{
+						MarkdownDescription: "Allow access for Yandex DataLens.",
+						Computed:            true,
+					},
+					"web_sql": schema.BoolAttribute{
+						MarkdownDescription: "Allow access for SQL queries in the management console.",
+						Computed:            true,
+					},
+					"data_transfer": schema.BoolAttribute{
+						MarkdownDescription: "Allow access for DataTransfer.",
+						Computed:            true,
+					},
+					"yandex_query": schema.BoolAttribute{
+						MarkdownDescription: "Allow access for Yandex Query.",
+						Computed:            true,
+					},
+				},
+			},
+			"cloud_storage": schema.SingleNestedAttribute{
+				MarkdownDescription: "Cloud Storage settings of the Greenplum cluster.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"enable": schema.BoolAttribute{
+						MarkdownDescription: "Whether cloud storage is used.",
+						Computed:            true,
+					},
+				},
+			},
+			"logging": schema.SingleNestedAttribute{
+				MarkdownDescription: "Cloud Logging settings.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						MarkdownDescription: "Flag that indicates whether log delivery to Cloud Logging is enabled.",
+						Computed:            true,
+					},
+					"log_group_id": schema.StringAttribute{
+						MarkdownDescription: "Cloud Logging group ID logs are sent to.",
+						Computed:            true,
+					},
+					"folder_id": schema.StringAttribute{
+						MarkdownDescription: "ID of folder logs are delivered to.",
+						Computed:            true,
+					},
+					"command_center_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Deliver Yandex Command Center's logs to Cloud Logging.",
+						Computed:            true,
+					},
+					"greenplum_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Deliver Greenplum's logs to Cloud Logging.",
+						Computed:            true,
+					},
+					"pooler_enabled": schema.BoolAttribute{
+						MarkdownDescription: "Deliver connection pooler's logs to Cloud Logging.",
+						Computed:            true,
+					},
+				},
+			},
+			"greenplum_config": schema.MapAttribute{
+				CustomType:          NewGreenplumSettingsMapType(),
+				MarkdownDescription: "Greenplum cluster settings.",
+				Computed:            true,
+			},
+			"pooler_config": schema.MapAttribute{
+				CustomType:          NewPoolerSettingsMapType(),
+				MarkdownDescription: "Settings of the connection pooler.",
+				Computed:            true,
+			},
+			"pxf_config": schema.MapAttribute{
+				CustomType:          NewPxfSettingsMapType(),
+				MarkdownDescription: "Settings of the PXF daemon.",
+				Computed:            true,
+			},
+			"background_activities": schema.SingleNestedAttribute{
+				MarkdownDescription: "Background activities settings.",
+				Computed:            true,
+				Attributes: map[string]schema.Attribute{
+					"analyze_and_vacuum": schema.SingleNestedAttribute{
+						MarkdownDescription: "Settings of 'ANALYZE' and 'VACUUM' daily operations.",
+						Computed:            true,
+						Attributes: map[string]schema.Attribute{
+							"start_time": schema.StringAttribute{
+								MarkdownDescription: "Time of day in 'HH:MM' format when scripts run.",
+								Computed:            true,
+							},
+							"analyze_timeout": schema.Int64Attribute{
+								MarkdownDescription: "Maximum duration of the `ANALYZE` operation, in seconds.",
+								Computed:            true,
+							},
+							"vacuum_timeout": schema.Int64Attribute{
+								MarkdownDescription: "Maximum duration of the `VACUUM` operation, in seconds.",
+								Computed:            true,
+							},
+						},
+					},
+					"query_killer_idle":                dataSourceQueryKillerSchema("Settings of the script that kills long running queries that are in `idle` state."),
+					"query_killer_idle_in_transaction": dataSourceQueryKillerSchema("Settings of the script that kills long running queries that are in `idle in transaction` state."),
+					"query_killer_long_running":        dataSourceQueryKillerSchema("Settings of the script that kills long running queries (in any state)."),
+				},
+			},
+			"master_hosts":  dataSourceHostsSchema("Hosts of the master subcluster keyed by FQDN."),
+			"segment_hosts": dataSourceHostsSchema("Hosts of the segment subcluster keyed by FQDN."),
+			"created_at": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["created_at"],
+				Computed:            true,
+			},
+			"health": schema.StringAttribute{
+				MarkdownDescription: "Aggregated health of the cluster.",
+				Computed:            true,
+			},
+			"status": schema.StringAttribute{
+				MarkdownDescription: "Status of the cluster.",
+				Computed:            true,
+			},
+		},
+	}
+}
@@ -0,0 +1,91 @@
+package mdb_greenplum_cluster_v2_test
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+)
+
+func TestAccDataSourceMDBGreenplumClusterV2_byID(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("ds-greenplumv2-by-id")
+	clusterDesc := "Greenplum Cluster Terraform Datasource Test #1"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBGreenplumClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMDBGreenplumClusterConfig(clusterName, clusterDesc, true),
+				Check:  testAccDataSourceMDBGreenplumClusterCheck("data.yandex_mdb_greenplum_cluster_v2.bar", clusterName, clusterDesc),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceMDBGreenplumClusterV2_byName(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("ds-greenplumv2-by-name")
+	clusterDesc := "Greenplum Cluster Terraform Datasource Test #2"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBGreenplumClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceMDBGreenplumClusterConfig(clusterName, clusterDesc, false),
+				Check:  testAccDataSourceMDBGreenplumClusterCheck("data.yandex_mdb_greenplum_cluster_v2.bar", clusterName, clusterDesc),
+			},
+		},
+	})
+}
+
+func testAccDataSourceMDBGreenplumClusterCheck(datasourceName string, clusterName string, desc string) resource.TestCheckFunc {
+	folderID := test.GetExampleFolderID()
+
+	return resource.ComposeAggregateTestCheckFunc(
+		resource.TestCheckResourceAttrPair(datasourceName, "id", gpResource, "id"),
+		test.AccCheckResourceIDField(datasourceName, "cluster_id"),
+		resource.TestCheckResourceAttr(datasourceName, "name", clusterName),
+		resource.TestCheckResourceAttr(datasourceName, "folder_id", folderID),
+		resource.TestCheckResourceAttr(datasourceName, "description", desc),
+		resource.TestCheckResourceAttr(datasourceName, "environment", "PRESTABLE"),
+		resource.TestCheckResourceAttr(datasourceName, "version", "6.25"),
+		resource.TestCheckResourceAttr(datasourceName, "segment_host_count", "2"),
+		resource.TestCheckResourceAttr(datasourceName, "segment_in_host", "1"),
+		resource.TestCheckResourceAttrPair(datasourceName, "master_subcluster.resources.disk_size", gpResource, "master_subcluster.resources.disk_size"),
+		resource.TestCheckResourceAttrPair(datasourceName, "greenplum_config.max_connections", gpResource, "greenplum_config.max_connections"),
+		resource.TestCheckResourceAttr(datasourceName, "pooler_config.mode", "TRANSACTION"),
+		resource.TestCheckResourceAttr(datasourceName, "master_hosts.%", "1"),
+		resource.TestCheckResourceAttr(datasourceName, "segment_hosts.%", "2"),
+		resource.TestCheckNoResourceAttr(datasourceName, "user_password"),
+		test.AccCheckCreatedAtAttr(datasourceName),
+	)
+}
+
+const mdbGreenplumClusterByIDConfig = `
+data "yandex_mdb_greenplum_cluster_v2" "bar" {
+  cluster_id = yandex_mdb_greenplum_cluster_v2.foo.id
+}
+`
+
+const mdbGreenplumClusterByNameConfig = `
+data "yandex_mdb_greenplum_cluster_v2" "bar" {
+  name = yandex_mdb_greenplum_cluster_v2.foo.name
+}
+`
+
+func testAccDataSourceMDBGreenplumClusterConfig(name, description string, byID bool) string {
+	conf := testAccMDBGreenplumClusterBasic(name, description, 2, `max_connections = 395`)
+	if byID {
+		return conf + mdbGreenplumClusterByIDConfig
+	}
+	return conf + mdbGreenplumClusterByNameConfig
+}
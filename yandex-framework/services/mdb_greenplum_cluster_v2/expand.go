@@ -0,0 +1,288 @@
+package mdb_greenplum_cluster_v2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
+	protobuf_adapter "github.com/yandex-cloud/terraform-provider-yandex/pkg/adapters/protobuf"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	utils "github.com/yandex-cloud/terraform-provider-yandex/pkg/wrappers"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"google.golang.org/genproto/googleapis/type/timeofday"
+)
+
+func prepareCreateRequest(ctx context.Context, plan *Cluster, providerConfig *config.State) (*greenplum.CreateClusterRequest, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	request := &greenplum.CreateClusterRequest{
+		FolderId:            mdbcommon.ExpandFolderId(ctx, plan.FolderId, providerConfig, &diags),
+		Name:                plan.Name.ValueString(),
+		Description:         plan.Description.ValueString(),
+		Labels:              mdbcommon.ExpandLabels(ctx, plan.Labels, &diags),
+		Environment:         mdbcommon.ExpandEnvironment[greenplum.Cluster_Environment](ctx, plan.Environment, &diags),
+		Config:              expandGreenplumConfig(ctx, plan, &diags),
+		MasterConfig:        &greenplum.MasterSubclusterConfigSpec{Resources: expandSubclusterResources(ctx, plan.MasterSubcluster, &diags)},
+		SegmentConfig:       &greenplum.SegmentSubclusterConfigSpec{Resources: expandSubclusterResources(ctx, plan.SegmentSubcluster, &diags)},
+		MasterHostCount:     plan.MasterHostCount.ValueInt64(),
+		SegmentHostCount:    plan.SegmentHostCount.ValueInt64(),
+		SegmentInHost:       plan.SegmentInHost.ValueInt64(),
+		UserName:            plan.UserName.ValueString(),
+		UserPassword:        plan.UserPassword.ValueString(),
+		NetworkId:           plan.NetworkId.ValueString(),
+		SecurityGroupIds:    mdbcommon.ExpandSecurityGroupIds(ctx, plan.SecurityGroupIds, &diags),
+		DeletionProtection:  plan.DeletionProtection.ValueBool(),
+		MasterHostGroupIds:  mdbcommon.ExpandSecurityGroupIds(ctx, plan.MasterHostGroupIds, &diags),
+		SegmentHostGroupIds: mdbcommon.ExpandSecurityGroupIds(ctx, plan.SegmentHostGroupIds, &diags),
+		ServiceAccountId:    plan.ServiceAccountId.ValueString(),
+		CloudStorage:        expandCloudStorage(ctx, plan.CloudStorage, &diags),
+		Logging:             expandLogging(ctx, plan.Logging, &diags),
+		MaintenanceWindow: mdbcommon.ExpandClusterMaintenanceWindow[
+			greenplum.MaintenanceWindow,
+			greenplum.WeeklyMaintenanceWindow,
+			greenplum.AnytimeMaintenanceWindow,
+			greenplum.WeeklyMaintenanceWindow_WeekDay,
+		](ctx, plan.MaintenanceWindow, &diags),
+		ConfigSpec: &greenplum.ConfigSpec{
+			GreenplumConfig:      expandGreenplumSettings(ctx, plan.GreenplumConfig, &diags),
+			Pool:                 expandPoolerConfig(ctx, plan.PoolerConfig, &diags),
+			PxfConfig:            expandPxfConfig(ctx, plan.PxfConfig, &diags),
+			BackgroundActivities: expandBackgroundActivities(ctx, plan.BackgroundActivities, &diags),
+		},
+	}
+	return request, diags
+}
+
+func expandGreenplumConfig(ctx context.Context, plan *Cluster, diags *diag.Diagnostics) *greenplum.GreenplumConfig {
+	return &greenplum.GreenplumConfig{
+		Version:           plan.Version.ValueString(),
+		BackupWindowStart: expandBackupWindowStart(ctx, plan.BackupWindowStart, diags),
+		Access:            expandAccess(ctx, plan.Access, diags),
+		ZoneId:            plan.ZoneId.ValueString(),
+		SubnetId:          plan.SubnetId.ValueString(),
+		AssignPublicIp:    plan.AssignPublicIp.ValueBool(),
+	}
+}
+
+func expandBackupWindowStart(ctx context.Context, bws types.Object, diags *diag.Diagnostics) *timeofday.TimeOfDay {
+	if !utils.IsPresent(bws) {
+		return nil
+	}
+	return mdbcommon.ExpandBackupWindow(ctx, bws, diags)
+}
+
+func expandSubclusterResources(ctx context.Context, s types.Object, diags *diag.Diagnostics) *greenplum.Resources {
+	if !utils.IsPresent(s) {
+		return nil
+	}
+
+	var sc Subcluster
+	diags.Append(s.As(ctx, &sc, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+	return mdbcommon.ExpandResources[greenplum.Resources](ctx, sc.Resources, diags)
+}
+
+func expandAccess(ctx context.Context, a types.Object, diags *diag.Diagnostics) *greenplum.Access {
+	if !utils.IsPresent(a) {
+		return nil
+	}
+
+	var access Access
+	diags.Append(a.As(ctx, &access, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+	return &greenplum.Access{
+		DataLens:     access.DataLens.ValueBool(),
+		WebSql:       access.WebSql.ValueBool(),
+		DataTransfer: access.DataTransfer.ValueBool(),
+		YandexQuery:  access.YandexQuery.ValueBool(),
+	}
+}
+
+func expandCloudStorage(ctx context.Context, c types.Object, diags *diag.Diagnostics) *greenplum.CloudStorage {
+	if !utils.IsPresent(c) {
+		return nil
+	}
+
+	var cs CloudStorage
+	diags.Append(c.As(ctx, &cs, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+	return &greenplum.CloudStorage{
+		Enable: cs.Enable.ValueBool(),
+	}
+}
+
+func expandLogging(ctx context.Context, l types.Object, diags *diag.Diagnostics) *greenplum.LoggingConfig {
+	if !utils.IsPresent(l) {
+		return nil
+	}
+
+	var logging Logging
+	diags.Append(l.As(ctx, &logging, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	res := &greenplum.LoggingConfig{
+		Enabled:              logging.Enabled.ValueBool(),
+		CommandCenterEnabled: logging.CommandCenterEnabled.ValueBool(),
+		GreenplumEnabled:     logging.GreenplumEnabled.ValueBool(),
+		PoolerEnabled:        logging.PoolerEnabled.ValueBool(),
+	}
+	if utils.IsPresent(logging.LogGroupId) && logging.LogGroupId.ValueString() != "" {
+		res.SetLogGroupId(logging.LogGroupId.ValueString())
+	} else if utils.IsPresent(logging.FolderId) && logging.FolderId.ValueString() != "" {
+		res.SetFolderId(logging.FolderId.ValueString())
+	}
+	return res
+}
+
+func fillSettings(ctx context.Context, cfg any, settings mdbcommon.SettingsMapValue, diags *diag.Diagnostics) {
+	attrs := settings.PrimitiveElements(ctx, diags)
+	if diags.HasError() {
+		return
+	}
+	protobuf_adapter.NewProtobufMapDataAdapter().Fill(ctx, cfg, attrs, diags)
+}
+
+// All supported versions of Greenplum are configured with GreenplumConfig6.
+func expandGreenplumSettings(ctx context.Context, settings mdbcommon.SettingsMapValue, diags *diag.Diagnostics) greenplum.ConfigSpec_GreenplumConfig {
+	if !utils.IsPresent(settings) {
+		return nil
+	}
+
+	cfg := &greenplum.GreenplumConfig6{}
+	fillSettings(ctx, cfg, settings, diags)
+	return &greenplum.ConfigSpec_GreenplumConfig_6{GreenplumConfig_6: cfg}
+}
+
+func expandPoolerConfig(ctx context.Context, settings mdbcommon.SettingsMapValue, diags *diag.Diagnostics) *greenplum.ConnectionPoolerConfig {
+	if !utils.IsPresent(settings) {
+		return nil
+	}
+
+	cfg := &greenplum.ConnectionPoolerConfig{}
+	fillSettings(ctx, cfg, settings, diags)
+	return cfg
+}
+
+func expandPxfConfig(ctx context.Context, settings mdbcommon.SettingsMapValue, diags *diag.Diagnostics) *greenplum.PXFConfig {
+	if !utils.IsPresent(settings) {
+		return nil
+	}
+
+	cfg := &greenplum.PXFConfig{}
+	fillSettings(ctx, cfg, settings, diags)
+	return cfg
+}
+
+func expandBackgroundActivities(ctx context.Context, b types.Object, diags *diag.Diagnostics) *greenplum.BackgroundActivitiesConfig {
+	if !utils.IsPresent(b) {
+		return nil
+	}
+
+	var ba BackgroundActivities
+	diags.Append(b.As(ctx, &ba, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	res := &greenplum.BackgroundActivitiesConfig{
+		AnalyzeAndVacuum: expandAnalyzeAndVacuum(ctx, ba.AnalyzeAndVacuum, diags),
+	}
+
+	idle := expandQueryKiller(ctx, ba.QueryKillerIdle, diags)
+	idleInTransaction := expandQueryKiller(ctx, ba.QueryKillerIdleInTransaction, diags)
+	longRunning := expandQueryKiller(ctx, ba.QueryKillerLongRunning, diags)
+	if idle != nil || idleInTransaction != nil || longRunning != nil {
+		res.QueryKillerScripts = &greenplum.QueryKillerScripts{
+			Idle:              idle,
+			IdleInTransaction: idleInTransaction,
+			LongRunning:       longRunning,
+		}
+	}
+	return res
+}
+
+func expandAnalyzeAndVacuum(ctx context.Context, a types.Object, diags *diag.Diagnostics) *greenplum.AnalyzeAndVacuum {
+	if !utils.IsPresent(a) {
+		return nil
+	}
+
+	var av AnalyzeAndVacuum
+	diags.Append(a.As(ctx, &av, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	res := &greenplum.AnalyzeAndVacuum{
+		AnalyzeTimeout: mdbcommon.ExpandInt64Wrapper(ctx, av.AnalyzeTimeout, diags),
+		VacuumTimeout:  mdbcommon.ExpandInt64Wrapper(ctx, av.VacuumTimeout, diags),
+	}
+	if utils.IsPresent(av.StartTime) {
+		res.Start = expandStartTime(av.StartTime.ValueString(), diags)
+	}
+	return res
+}
+
+// expandStartTime parses time of day in the HH:MM format, the format is checked by the schema validator.
+func expandStartTime(s string, diags *diag.Diagnostics) *greenplum.BackgroundActivityStartAt {
+	var hours, minutes int64
+	if _, err := fmt.Sscanf(s, "%d:%d", &hours, &minutes); err != nil {
+		diags.AddError(
+			"Failed to expand start_time",
+			fmt.Sprintf("Can't parse %q as time of day in HH:MM format: %s", s, err.Error()),
+		)
+		return nil
+	}
+	return &greenplum.BackgroundActivityStartAt{
+		Hours:   hours,
+		Minutes: minutes,
+	}
+}
+
+func expandQueryKiller(ctx context.Context, q types.Object, diags *diag.Diagnostics) *greenplum.QueryKiller {
+	if !utils.IsPresent(q) {
+		return nil
+	}
+
+	var qk QueryKiller
+	diags.Append(q.As(ctx, &qk, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	res := &greenplum.QueryKiller{
+		Enable: mdbcommon.ExpandBoolWrapper(ctx, qk.Enable, diags),
+		MaxAge: mdbcommon.ExpandInt64Wrapper(ctx, qk.MaxAge, diags),
+	}
+	if utils.IsPresent(qk.IgnoreUsers) {
+		diags.Append(qk.IgnoreUsers.ElementsAs(ctx, &res.IgnoreUsers, false)...)
+	}
+	return res
+}
+
+// prepareExpandRequest computes increments of segment hosts and segments per host,
+// decreasing of the both counters is rejected at plan time.
+func prepareExpandRequest(state, plan *Cluster) *greenplum.ExpandRequest {
+	segmentHostCount := plan.SegmentHostCount.ValueInt64() - state.SegmentHostCount.ValueInt64()
+	segmentsPerHost := plan.SegmentInHost.ValueInt64() - state.SegmentInHost.ValueInt64()
+	if segmentHostCount <= 0 && segmentsPerHost <= 0 {
+		return nil
+	}
+
+	return &greenplum.ExpandRequest{
+		ClusterId:               state.Id.ValueString(),
+		SegmentHostCount:        max(segmentHostCount, 0),
+		AddSegmentsPerHostCount: max(segmentsPerHost, 0),
+		Duration:                expandDuration,
+	}
+}
@@ -0,0 +1,274 @@
+package mdb_greenplum_cluster_v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func newTestSettings(p mdbcommon.SettingsAttributeInfoProvider, elems map[string]attr.Value) mdbcommon.SettingsMapValue {
+	v, d := mdbcommon.NewSettingsMapValue(elems, p)
+	if d.HasError() {
+		panic(d)
+	}
+	return v
+}
+
+func TestYandexProvider_MDBGreenplumClusterSettingsExpand(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cases := []struct {
+		testname      string
+		expand        func(diags *diag.Diagnostics) proto.Message
+		expectedVal   proto.Message
+		expectedError bool
+	}{
+		{
+			testname: "CheckGreenplumConfig",
+			expand: func(diags *diag.Diagnostics) proto.Message {
+				cfg := expandGreenplumSettings(ctx, newTestSettings(greenplumAttrProvider, map[string]attr.Value{
+					"max_connections":         types.Int64Value(400),
+					"gp_workfile_compression": types.BoolValue(true),
+					"log_statement":           types.Int64Value(int64(greenplum.LogStatement_DDL)),
+				}), diags)
+				return cfg.(*greenplum.ConfigSpec_GreenplumConfig_6).GreenplumConfig_6
+			},
+			expectedVal: &greenplum.GreenplumConfig6{
+				MaxConnections:        wrapperspb.Int64(400),
+				GpWorkfileCompression: wrapperspb.Bool(true),
+				LogStatement:          greenplum.LogStatement_DDL,
+			},
+		},
+		{
+			testname: "CheckPoolerConfig",
+			expand: func(diags *diag.Diagnostics) proto.Message {
+				return expandPoolerConfig(ctx, newTestSettings(poolerAttrProvider, map[string]attr.Value{
+					"mode": types.Int64Value(int64(greenplum.ConnectionPoolerConfig_TRANSACTION)),
+					"size": types.Int64Value(10),
+				}), diags)
+			},
+			expectedVal: &greenplum.ConnectionPoolerConfig{
+				Mode: greenplum.ConnectionPoolerConfig_TRANSACTION,
+				Size: wrapperspb.Int64(10),
+			},
+		},
+		{
+			testname: "CheckUnknownAttribute",
+			expand: func(diags *diag.Diagnostics) proto.Message {
+				return expandPxfConfig(ctx, newTestSettings(pxfAttrProvider, map[string]attr.Value{
+					"unknown_setting": types.Int64Value(1),
+				}), diags)
+			},
+			expectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		diags := diag.Diagnostics{}
+		res := c.expand(&diags)
+		if diags.HasError() != c.expectedError {
+			t.Errorf(
+				"Unexpected expansion diagnostics status %s test: expected %t, actual %t with errors: %v",
+				c.testname,
+				c.expectedError,
+				diags.HasError(),
+				diags.Errors(),
+			)
+			continue
+		}
+
+		if !c.expectedError && !proto.Equal(res, c.expectedVal) {
+			t.Errorf("Unexpected expansion result value %s test: expected %s, actual %s", c.testname, c.expectedVal, res)
+		}
+	}
+}
+
+func TestYandexProvider_MDBGreenplumClusterStartTimeExpand(t *testing.T) {
+	t.Parallel()
+
+	diags := diag.Diagnostics{}
+	res := expandStartTime("03:30", &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected expansion diagnostics: %v", diags.Errors())
+	}
+	if res.Hours != 3 || res.Minutes != 30 {
+		t.Errorf("Unexpected expansion result value: %s", res)
+	}
+	if s := flattenStartTime(res).ValueString(); s != "03:30" {
+		t.Errorf("Unexpected flatten result value: expected %q, actual %q", "03:30", s)
+	}
+}
+
+func TestYandexProvider_MDBGreenplumClusterPrepareExpandRequest(t *testing.T) {
+	t.Parallel()
+
+	state := Cluster{
+		Id:               types.StringValue("cid"),
+		SegmentHostCount: types.Int64Value(2),
+		SegmentInHost:    types.Int64Value(1),
+	}
+
+	cases := []struct {
+		testname      string
+		hosts         int64
+		segments      int64
+		expectedHosts int64
+		expectedSegs  int64
+		expectedNil   bool
+	}{
+		{
+			testname:    "CheckNoChanges",
+			hosts:       2,
+			segments:    1,
+			expectedNil: true,
+		},
+		{
+			testname:      "CheckAddHosts",
+			hosts:         4,
+			segments:      1,
+			expectedHosts: 2,
+		},
+		{
+			testname:     "CheckAddSegmentsPerHost",
+			hosts:        2,
+			segments:     3,
+			expectedSegs: 2,
+		},
+		{
+			testname:      "CheckAddBoth",
+			hosts:         3,
+			segments:      2,
+			expectedHosts: 1,
+			expectedSegs:  1,
+		},
+	}
+
+	for _, c := range cases {
+		plan := state
+		plan.SegmentHostCount = types.Int64Value(c.hosts)
+		plan.SegmentInHost = types.Int64Value(c.segments)
+
+		req := prepareExpandRequest(&state, &plan)
+		if c.expectedNil {
+			if req != nil {
+				t.Errorf("Unexpected expand request %s test: %v", c.testname, req)
+			}
+			continue
+		}
+
+		if req == nil || req.ClusterId != "cid" || req.SegmentHostCount != c.expectedHosts || req.AddSegmentsPerHostCount != c.expectedSegs {
+			t.Errorf("Unexpected expand request %s test: %v", c.testname, req)
+		}
+	}
+}
+
+func TestYandexProvider_MDBGreenplumClusterExpandOnlyModifier(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cases := []struct {
+		testname      string
+		state         types.Int64
+		plan          types.Int64
+		expectedError bool
+	}{
+		{
+			testname: "CheckCreate",
+			state:    types.Int64Null(),
+			plan:     types.Int64Value(2),
+		},
+		{
+			testname: "CheckIncrease",
+			state:    types.Int64Value(2),
+			plan:     types.Int64Value(4),
+		},
+		{
+			testname: "CheckUnknown",
+			state:    types.Int64Value(2),
+			plan:     types.Int64Unknown(),
+		},
+		{
+			testname:      "CheckDecrease",
+			state:         types.Int64Value(4),
+			plan:          types.Int64Value(2),
+			expectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		req := planmodifier.Int64Request{
+			Path:       path.Root("segment_host_count"),
+			StateValue: c.state,
+			PlanValue:  c.plan,
+		}
+		resp := &planmodifier.Int64Response{PlanValue: c.plan}
+		ExpandOnly().PlanModifyInt64(ctx, req, resp)
+
+		if resp.Diagnostics.HasError() != c.expectedError {
+			t.Errorf(
+				"Unexpected plan diagnostics status %s test: expected %t, actual %t with errors: %v",
+				c.testname,
+				c.expectedError,
+				resp.Diagnostics.HasError(),
+				resp.Diagnostics.Errors(),
+			)
+		}
+	}
+}
+
+func TestYandexProvider_MDBGreenplumClusterMoveStateFromRaw(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		testname         string
+		rawState         string
+		expectedId       string
+		expectedPassword string
+		expectedError    bool
+	}{
+		{
+			testname:         "CheckLegacyState",
+			rawState:         `{"id":"cid","name":"greenplum","user_password":"secret","master_subcluster":[]}`,
+			expectedId:       "cid",
+			expectedPassword: "secret",
+		},
+		{
+			testname:      "CheckStateWithoutId",
+			rawState:      `{"name":"greenplum"}`,
+			expectedError: true,
+		},
+		{
+			testname:      "CheckBrokenState",
+			rawState:      `{`,
+			expectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		src, diags := legacyStateFromRaw([]byte(c.rawState))
+		if diags.HasError() != c.expectedError {
+			t.Errorf(
+				"Unexpected diagnostics status %s test: expected %t, actual %t with errors: %v",
+				c.testname,
+				c.expectedError,
+				diags.HasError(),
+				diags.Errors(),
+			)
+			continue
+		}
+
+		if src.Id != c.expectedId || src.UserPassword != c.expectedPassword {
+			t.Errorf("Unexpected moved state %s test: %+v", c.testname, src)
+		}
+	}
+}
@@ -0,0 +1,222 @@
+package mdb_greenplum_cluster_v2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
+	protobuf_adapter "github.com/yandex-cloud/terraform-provider-yandex/pkg/adapters/protobuf"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/timestamp"
+	utils "github.com/yandex-cloud/terraform-provider-yandex/pkg/wrappers"
+)
+
+func flattenStringValue(s string) types.String {
+	if s == "" {
+		return types.StringNull()
+	}
+	return types.StringValue(s)
+}
+
+func flattenStringList(ctx context.Context, ss []string, diags *diag.Diagnostics) types.List {
+	if ss == nil {
+		ss = []string{}
+	}
+	l, d := types.ListValueFrom(ctx, types.StringType, ss)
+	diags.Append(d...)
+	return l
+}
+
+func flattenSubcluster(ctx context.Context, r *greenplum.Resources, diags *diag.Diagnostics) types.Object {
+	if r == nil {
+		return types.ObjectNull(SubclusterAttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, SubclusterAttrTypes, Subcluster{
+		Resources: mdbcommon.FlattenResources(ctx, r, diags),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func flattenAccess(ctx context.Context, a *greenplum.Access, diags *diag.Diagnostics) types.Object {
+	obj, d := types.ObjectValueFrom(ctx, AccessAttrTypes, Access{
+		DataLens:     types.BoolValue(a.GetDataLens()),
+		WebSql:       types.BoolValue(a.GetWebSql()),
+		DataTransfer: types.BoolValue(a.GetDataTransfer()),
+		YandexQuery:  types.BoolValue(a.GetYandexQuery()),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func flattenCloudStorage(ctx context.Context, c *greenplum.CloudStorage, diags *diag.Diagnostics) types.Object {
+	obj, d := types.ObjectValueFrom(ctx, CloudStorageAttrTypes, CloudStorage{
+		Enable: types.BoolValue(c.GetEnable()),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func flattenLogging(ctx context.Context, l *greenplum.LoggingConfig, diags *diag.Diagnostics) types.Object {
+	obj, d := types.ObjectValueFrom(ctx, LoggingAttrTypes, Logging{
+		Enabled:              types.BoolValue(l.GetEnabled()),
+		LogGroupId:           flattenStringValue(l.GetLogGroupId()),
+		FolderId:             flattenStringValue(l.GetFolderId()),
+		CommandCenterEnabled: types.BoolValue(l.GetCommandCenterEnabled()),
+		GreenplumEnabled:     types.BoolValue(l.GetGreenplumEnabled()),
+		PoolerEnabled:        types.BoolValue(l.GetPoolerEnabled()),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+// flattenSettings keeps user defined settings from the state if they are known,
+// the API returns full config with default values which would produce an endless diff otherwise.
+func flattenSettings(
+	ctx context.Context,
+	state mdbcommon.SettingsMapValue,
+	userConfig any,
+	p mdbcommon.SettingsAttributeInfoProvider,
+	diags *diag.Diagnostics,
+) mdbcommon.SettingsMapValue {
+	if utils.IsPresent(state) {
+		return state
+	}
+
+	a := protobuf_adapter.NewProtobufMapDataAdapter()
+	attrs := a.Extract(ctx, userConfig, diags)
+	if diags.HasError() {
+		return mdbcommon.NewSettingsMapNull()
+	}
+
+	attrsPresent := make(map[string]attr.Value)
+	for attr, val := range attrs {
+		if ok := mdbcommon.IsAttrZeroValue(val, diags); !ok {
+			attrsPresent[attr] = val
+		}
+
+		if diags.HasError() {
+			diags.AddError("Flatten Greenplum Config Error", fmt.Sprintf("Can't check zero attribute %s", attr))
+		}
+	}
+
+	mv, d := mdbcommon.NewSettingsMapValue(attrsPresent, p)
+	diags.Append(d...)
+	return mv
+}
+
+func flattenGreenplumUserConfig(cs *greenplum.ClusterConfigSet) any {
+	if cfg := cs.GetGreenplumConfigSet_6().GetUserConfig(); cfg != nil {
+		return cfg
+	}
+	return &greenplum.GreenplumConfig6{}
+}
+
+func flattenPoolerUserConfig(cs *greenplum.ClusterConfigSet) any {
+	if cfg := cs.GetPool().GetUserConfig(); cfg != nil {
+		return cfg
+	}
+	return &greenplum.ConnectionPoolerConfig{}
+}
+
+func flattenPxfUserConfig(cs *greenplum.ClusterConfigSet) any {
+	if cfg := cs.GetPxfConfig().GetUserConfig(); cfg != nil {
+		return cfg
+	}
+	return &greenplum.PXFConfig{}
+}
+
+func flattenStartTime(s *greenplum.BackgroundActivityStartAt) types.String {
+	if s == nil {
+		return types.StringNull()
+	}
+	return types.StringValue(fmt.Sprintf("%02d:%02d", s.Hours, s.Minutes))
+}
+
+func flattenAnalyzeAndVacuum(ctx context.Context, a *greenplum.AnalyzeAndVacuum, diags *diag.Diagnostics) types.Object {
+	obj, d := types.ObjectValueFrom(ctx, AnalyzeAndVacuumAttrTypes, AnalyzeAndVacuum{
+		StartTime:      flattenStartTime(a.GetStart()),
+		AnalyzeTimeout: mdbcommon.FlattenInt64Wrapper(ctx, a.GetAnalyzeTimeout(), diags),
+		VacuumTimeout:  mdbcommon.FlattenInt64Wrapper(ctx, a.GetVacuumTimeout(), diags),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func flattenQueryKiller(ctx context.Context, q *greenplum.QueryKiller, diags *diag.Diagnostics) types.Object {
+	obj, d := types.ObjectValueFrom(ctx, QueryKillerAttrTypes, QueryKiller{
+		Enable:      mdbcommon.FlattenBoolWrapper(ctx, q.GetEnable(), diags),
+		MaxAge:      mdbcommon.FlattenInt64Wrapper(ctx, q.GetMaxAge(), diags),
+		IgnoreUsers: flattenStringList(ctx, q.GetIgnoreUsers(), diags),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func flattenBackgroundActivities(ctx context.Context, b *greenplum.BackgroundActivitiesConfig, diags *diag.Diagnostics) types.Object {
+	scripts := b.GetQueryKillerScripts()
+	obj, d := types.ObjectValueFrom(ctx, BackgroundActivitiesAttrTypes, BackgroundActivities{
+		AnalyzeAndVacuum:             flattenAnalyzeAndVacuum(ctx, b.GetAnalyzeAndVacuum(), diags),
+		QueryKillerIdle:              flattenQueryKiller(ctx, scripts.GetIdle(), diags),
+		QueryKillerIdleInTransaction: flattenQueryKiller(ctx, scripts.GetIdleInTransaction(), diags),
+		QueryKillerLongRunning:       flattenQueryKiller(ctx, scripts.GetLongRunning(), diags),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func flattenCluster(ctx context.Context, state *Cluster, cluster *greenplum.Cluster, diags *diag.Diagnostics) {
+	state.Id = types.StringValue(cluster.Id)
+	state.ClusterId = types.StringValue(cluster.Id)
+	state.FolderId = types.StringValue(cluster.FolderId)
+	state.NetworkId = types.StringValue(cluster.NetworkId)
+	state.Name = types.StringValue(cluster.Name)
+	state.Description = types.StringValue(cluster.Description)
+	state.Environment = types.StringValue(cluster.Environment.String())
+	state.Labels = mdbcommon.FlattenMapString(ctx, cluster.Labels, diags)
+	state.MasterHostCount = types.Int64Value(cluster.MasterHostCount)
+	state.SegmentHostCount = types.Int64Value(cluster.SegmentHostCount)
+	state.SegmentInHost = types.Int64Value(cluster.SegmentInHost)
+	state.MasterSubcluster = flattenSubcluster(ctx, cluster.GetMasterConfig().GetResources(), diags)
+	state.SegmentSubcluster = flattenSubcluster(ctx, cluster.GetSegmentConfig().GetResources(), diags)
+	state.MasterHostGroupIds = mdbcommon.FlattenSetString(ctx, cluster.MasterHostGroupIds, diags)
+	state.SegmentHostGroupIds = mdbcommon.FlattenSetString(ctx, cluster.SegmentHostGroupIds, diags)
+	state.UserName = types.StringValue(cluster.UserName)
+	state.SecurityGroupIds = mdbcommon.FlattenSetString(ctx, cluster.SecurityGroupIds, diags)
+	state.DeletionProtection = types.BoolValue(cluster.DeletionProtection)
+	state.ServiceAccountId = types.StringValue(cluster.ServiceAccountId)
+	state.MaintenanceWindow = mdbcommon.FlattenMaintenanceWindow[
+		greenplum.MaintenanceWindow,
+		greenplum.WeeklyMaintenanceWindow,
+		greenplum.AnytimeMaintenanceWindow,
+		greenplum.WeeklyMaintenanceWindow_WeekDay,
+	](ctx, cluster.MaintenanceWindow, diags)
+	state.CloudStorage = flattenCloudStorage(ctx, cluster.CloudStorage, diags)
+	state.Logging = flattenLogging(ctx, cluster.Logging, diags)
+	state.CreatedAt = types.StringValue(timestamp.Get(cluster.GetCreatedAt()))
+	state.Health = types.StringValue(cluster.Health.String())
+	state.Status = types.StringValue(cluster.Status.String())
+
+	cfg := cluster.GetConfig()
+	if cfg == nil {
+		diags.AddError("Failed to flatten config.", "Config of cluster can't be nil. It's error in provider")
+		return
+	}
+
+	state.Version = types.StringValue(cfg.Version)
+	state.ZoneId = types.StringValue(cfg.ZoneId)
+	state.SubnetId = types.StringValue(cfg.SubnetId)
+	state.AssignPublicIp = types.BoolValue(cfg.AssignPublicIp)
+	state.BackupWindowStart = mdbcommon.FlattenBackupWindowStart(ctx, cfg.BackupWindowStart, diags)
+	state.Access = flattenAccess(ctx, cfg.Access, diags)
+
+	cs := cluster.GetClusterConfig()
+	state.GreenplumConfig = flattenSettings(ctx, state.GreenplumConfig, flattenGreenplumUserConfig(cs), greenplumAttrProvider, diags)
+	state.PoolerConfig = flattenSettings(ctx, state.PoolerConfig, flattenPoolerUserConfig(cs), poolerAttrProvider, diags)
+	state.PxfConfig = flattenSettings(ctx, state.PxfConfig, flattenPxfUserConfig(cs), pxfAttrProvider, diags)
+	state.BackgroundActivities = flattenBackgroundActivities(ctx, cs.GetBackgroundActivities(), diags)
+}
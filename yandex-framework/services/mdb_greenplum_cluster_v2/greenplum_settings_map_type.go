@@ -0,0 +1,60 @@
+package mdb_greenplum_cluster_v2
+
+import (
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+// Greenplum settings, connection pooler and PXF configs are different protobuf messages with their own enums,
+// so each of them has its own attribute info provider.
+type settingsAttributeInfoProvider struct {
+	enumNames  map[string]map[int32]string
+	enumValues map[string]map[string]int32
+}
+
+func (p *settingsAttributeInfoProvider) GetSettingsEnumNames() map[string]map[int32]string {
+	return p.enumNames
+}
+
+func (p *settingsAttributeInfoProvider) GetSettingsEnumValues() map[string]map[string]int32 {
+	return p.enumValues
+}
+
+func (p *settingsAttributeInfoProvider) GetSetAttributes() map[string]struct{} {
+	return listAttributes
+}
+
+// None of the configs has list settings.
+var listAttributes = map[string]struct{}{}
+
+var greenplumAttrProvider = &settingsAttributeInfoProvider{
+	enumNames: map[string]map[int32]string{
+		"log_statement": greenplum.LogStatement_name,
+	},
+	enumValues: map[string]map[string]int32{
+		"log_statement": greenplum.LogStatement_value,
+	},
+}
+
+var poolerAttrProvider = &settingsAttributeInfoProvider{
+	enumNames: map[string]map[int32]string{
+		"mode": greenplum.ConnectionPoolerConfig_PoolMode_name,
+	},
+	enumValues: map[string]map[string]int32{
+		"mode": greenplum.ConnectionPoolerConfig_PoolMode_value,
+	},
+}
+
+var pxfAttrProvider = &settingsAttributeInfoProvider{}
+
+func NewGreenplumSettingsMapType() mdbcommon.SettingsMapType {
+	return mdbcommon.NewSettingsMapType(greenplumAttrProvider)
+}
+
+func NewPoolerSettingsMapType() mdbcommon.SettingsMapType {
+	return mdbcommon.NewSettingsMapType(poolerAttrProvider)
+}
+
+func NewPxfSettingsMapType() mdbcommon.SettingsMapType {
+	return mdbcommon.NewSettingsMapType(pxfAttrProvider)
+}
@@ -0,0 +1,21 @@
+package mdb_greenplum_cluster_v2
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
+)
+
+func convertHostFromProto(apiHost *greenplum.Host) Host {
+	return Host{
+		FQDN:           types.StringValue(apiHost.Name),
+		Zone:           types.StringValue(apiHost.ZoneId),
+		Type:           types.StringValue(apiHost.Type.String()),
+		Health:         types.StringValue(apiHost.Health.String()),
+		SubnetId:       types.StringValue(apiHost.SubnetId),
+		AssignPublicIp: types.BoolValue(apiHost.AssignPublicIp),
+	}
+}
+
+func (h Host) GetFQDN() types.String {
+	return h.FQDN
+}
@@ -0,0 +1,154 @@
+package mdb_greenplum_cluster_v2
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+type Cluster struct {
+	Id                   types.String               `tfsdk:"id"`
+	ClusterId            types.String               `tfsdk:"cluster_id"`
+	FolderId             types.String               `tfsdk:"folder_id"`
+	NetworkId            types.String               `tfsdk:"network_id"`
+	Name                 types.String               `tfsdk:"name"`
+	Description          types.String               `tfsdk:"description"`
+	Environment          types.String               `tfsdk:"environment"`
+	Labels               types.Map                  `tfsdk:"labels"`
+	ZoneId               types.String               `tfsdk:"zone_id"`
+	SubnetId             types.String               `tfsdk:"subnet_id"`
+	AssignPublicIp       types.Bool                 `tfsdk:"assign_public_ip"`
+	Version              types.String               `tfsdk:"version"`
+	MasterHostCount      types.Int64                `tfsdk:"master_host_count"`
+	SegmentHostCount     types.Int64                `tfsdk:"segment_host_count"`
+	SegmentInHost        types.Int64                `tfsdk:"segment_in_host"`
+	MasterSubcluster     types.Object               `tfsdk:"master_subcluster"`
+	SegmentSubcluster    types.Object               `tfsdk:"segment_subcluster"`
+	MasterHostGroupIds   types.Set                  `tfsdk:"master_host_group_ids"`
+	SegmentHostGroupIds  types.Set                  `tfsdk:"segment_host_group_ids"`
+	UserName             types.String               `tfsdk:"user_name"`
+	UserPassword         types.String               `tfsdk:"user_password"`
+	SecurityGroupIds     types.Set                  `tfsdk:"security_group_ids"`
+	DeletionProtection   types.Bool                 `tfsdk:"deletion_protection"`
+	ServiceAccountId     types.String               `tfsdk:"service_account_id"`
+	MaintenanceWindow    types.Object               `tfsdk:"maintenance_window"`
+	BackupWindowStart    types.Object               `tfsdk:"backup_window_start"`
+	Access               types.Object               `tfsdk:"access"`
+	CloudStorage         types.Object               `tfsdk:"cloud_storage"`
+	Logging              types.Object               `tfsdk:"logging"`
+	GreenplumConfig      mdbcommon.SettingsMapValue `tfsdk:"greenplum_config"`
+	PoolerConfig         mdbcommon.SettingsMapValue `tfsdk:"pooler_config"`
+	PxfConfig            mdbcommon.SettingsMapValue `tfsdk:"pxf_config"`
+	BackgroundActivities types.Object               `tfsdk:"background_activities"`
+	MasterHosts          types.Map                  `tfsdk:"master_hosts"`
+	SegmentHosts         types.Map                  `tfsdk:"segment_hosts"`
+	CreatedAt            types.String               `tfsdk:"created_at"`
+	Health               types.String               `tfsdk:"health"`
+	Status               types.String               `tfsdk:"status"`
+}
+
+type Subcluster struct {
+	Resources types.Object `tfsdk:"resources"`
+}
+
+var SubclusterAttrTypes = map[string]attr.Type{
+	"resources": mdbcommon.ResourceType,
+}
+
+type Access struct {
+	DataLens     types.Bool `tfsdk:"data_lens"`
+	WebSql       types.Bool `tfsdk:"web_sql"`
+	DataTransfer types.Bool `tfsdk:"data_transfer"`
+	YandexQuery  types.Bool `tfsdk:"yandex_query"`
+}
+
+var AccessAttrTypes = map[string]attr.Type{
+	"data_lens":     types.BoolType,
+	"web_sql":       types.BoolType,
+	"data_transfer": types.BoolType,
+	"yandex_query":  types.BoolType,
+}
+
+type CloudStorage struct {
+	Enable types.Bool `tfsdk:"enable"`
+}
+
+var CloudStorageAttrTypes = map[string]attr.Type{
+	"enable": types.BoolType,
+}
+
+type Logging struct {
+	Enabled              types.Bool   `tfsdk:"enabled"`
+	LogGroupId           types.String `tfsdk:"log_group_id"`
+	FolderId             types.String `tfsdk:"folder_id"`
+	CommandCenterEnabled types.Bool   `tfsdk:"command_center_enabled"`
+	GreenplumEnabled     types.Bool   `tfsdk:"greenplum_enabled"`
+	PoolerEnabled        types.Bool   `tfsdk:"pooler_enabled"`
+}
+
+var LoggingAttrTypes = map[string]attr.Type{
+	"enabled":                types.BoolType,
+	"log_group_id":           types.StringType,
+	"folder_id":              types.StringType,
+	"command_center_enabled": types.BoolType,
+	"greenplum_enabled":      types.BoolType,
+	"pooler_enabled":         types.BoolType,
+}
+
+type BackgroundActivities struct {
+	AnalyzeAndVacuum             types.Object `tfsdk:"analyze_and_vacuum"`
+	QueryKillerIdle              types.Object `tfsdk:"query_killer_idle"`
+	QueryKillerIdleInTransaction types.Object `tfsdk:"query_killer_idle_in_transaction"`
+	QueryKillerLongRunning       types.Object `tfsdk:"query_killer_long_running"`
+}
+
+type AnalyzeAndVacuum struct {
+	StartTime      types.String `tfsdk:"start_time"`
+	AnalyzeTimeout types.Int64  `tfsdk:"analyze_timeout"`
+	VacuumTimeout  types.Int64  `tfsdk:"vacuum_timeout"`
+}
+
+var AnalyzeAndVacuumAttrTypes = map[string]attr.Type{
+	"start_time":      types.StringType,
+	"analyze_timeout": types.Int64Type,
+	"vacuum_timeout":  types.Int64Type,
+}
+
+type QueryKiller struct {
+	Enable      types.Bool  `tfsdk:"enable"`
+	MaxAge      types.Int64 `tfsdk:"max_age"`
+	IgnoreUsers types.List  `tfsdk:"ignore_users"`
+}
+
+var QueryKillerAttrTypes = map[string]attr.Type{
+	"enable":       types.BoolType,
+	"max_age":      types.Int64Type,
+	"ignore_users": types.ListType{ElemType: types.StringType},
+}
+
+var BackgroundActivitiesAttrTypes = map[string]attr.Type{
+	"analyze_and_vacuum":               types.ObjectType{AttrTypes: AnalyzeAndVacuumAttrTypes},
+	"query_killer_idle":                types.ObjectType{AttrTypes: QueryKillerAttrTypes},
+	"query_killer_idle_in_transaction": types.ObjectType{AttrTypes: QueryKillerAttrTypes},
+	"query_killer_long_running":        types.ObjectType{AttrTypes: QueryKillerAttrTypes},
+}
+
+type Host struct {
+	FQDN           types.String `tfsdk:"fqdn"`
+	Zone           types.String `tfsdk:"zone"`
+	Type           types.String `tfsdk:"type"`
+	Health         types.String `tfsdk:"health"`
+	SubnetId       types.String `tfsdk:"subnet_id"`
+	AssignPublicIp types.Bool   `tfsdk:"assign_public_ip"`
+}
+
+var hostType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"fqdn":             types.StringType,
+		"zone":             types.StringType,
+		"type":             types.StringType,
+		"health":           types.StringType,
+		"subnet_id":        types.StringType,
+		"assign_public_ip": types.BoolType,
+	},
+}
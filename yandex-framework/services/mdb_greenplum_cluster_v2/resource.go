@@ -0,0 +1,687 @@
+package mdb_greenplum_cluster_v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/listplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+// legacyResourceTypeName is the SDKv2 resource which state can be moved to the v2 resource with a `moved` block.
+const legacyResourceTypeName = "yandex_mdb_greenplum_cluster"
+
+type clusterResource struct {
+	providerConfig *provider_config.Config
+}
+
+var (
+	_ resource.ResourceWithConfigure   = &clusterResource{}
+	_ resource.ResourceWithImportState = &clusterResource{}
+	_ resource.ResourceWithMoveState   = &clusterResource{}
+)
+
+func NewGreenplumClusterResourceV2() resource.Resource {
+	return &clusterResource{}
+}
+
+func (r *clusterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_greenplum_cluster_v2"
+}
+
+func (r *clusterResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func subclusterSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Required:    true,
+		Attributes: map[string]schema.Attribute{
+			"resources": schema.SingleNestedAttribute{
+				Description: "Resources allocated to hosts of the subcluster.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"resource_preset_id": schema.StringAttribute{
+						Description: "The ID of the preset for computational resources available to a host (CPU, memory etc.). For more information, see [the official documentation](https://yandex.cloud/docs/managed-greenplum/concepts/instance-types).",
+						Required:    true,
+					},
+					"disk_type_id": schema.StringAttribute{
+						Description: "Type of the storage of hosts. For more information see [the official documentation](https://yandex.cloud/docs/managed-greenplum/concepts/storage).",
+						Required:    true,
+					},
+					"disk_size": schema.Int64Attribute{
+						Description: "Volume of the storage available to a host, in gigabytes.",
+						Required:    true,
+					},
+				},
+			},
+		},
+	}
+}
+
+// Nested attributes below are filled by the API with defaults if they are not set,
+// so all of them are optional and computed with the value kept from the state.
+
+func optionalBoolSchema(description string) schema.BoolAttribute {
+	return schema.BoolAttribute{
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Bool{
+			boolplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func optionalInt64Schema(description string) schema.Int64Attribute {
+	return schema.Int64Attribute{
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Int64{
+			int64planmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func optionalObjectSchema(description string, attributes map[string]schema.Attribute) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: attributes,
+	}
+}
+
+func queryKillerSchema(description string) schema.SingleNestedAttribute {
+	return optionalObjectSchema(description, map[string]schema.Attribute{
+		"enable":  optionalBoolSchema("Flag that indicates whether script is enabled."),
+		"max_age": optionalInt64Schema("Maximum duration for this type of queries (in seconds)."),
+		"ignore_users": schema.ListAttribute{
+			Description: "List of users to ignore when considering queries to terminate.",
+			Optional:    true,
+			Computed:    true,
+			ElementType: types.StringType,
+			PlanModifiers: []planmodifier.List{
+				listplanmodifier.UseStateForUnknown(),
+			},
+		},
+	})
+}
+
+func hostsSchema(description string) schema.MapNestedAttribute {
+	return schema.MapNestedAttribute{
+		Description: description,
+		Computed:    true,
+		NestedObject: schema.NestedAttributeObject{
+			Attributes: map[string]schema.Attribute{
+				"fqdn": schema.StringAttribute{
+					Description: "The fully qualified domain name of the host.",
+					Computed:    true,
+				},
+				"zone": schema.StringAttribute{
+					Description: "The availability zone where the host is located.",
+					Computed:    true,
+				},
+				"type": schema.StringAttribute{
+					Description: "Type of the host in the cluster.",
+					Computed:    true,
+				},
+				"health": schema.StringAttribute{
+					Description: "Health of the host.",
+					Computed:    true,
+				},
+				"subnet_id": schema.StringAttribute{
+					Description: "ID of the subnet where the host is located.",
+					Computed:    true,
+				},
+				"assign_public_ip": schema.BoolAttribute{
+					Description: "Whether the host has a public IP address.",
+					Computed:    true,
+				},
+			},
+		},
+	}
+}
+
+func settingsSchema(customType mdbcommon.SettingsMapType, description string) schema.MapAttribute {
+	return schema.MapAttribute{
+		CustomType:  customType,
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Map{
+			mapplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Greenplum cluster within the Yandex Cloud. For more information, see [the official documentation](https://yandex.cloud/docs/managed-greenplum/concepts).\n\n" +
+			"Unlike `yandex_mdb_greenplum_cluster`, Greenplum settings, connection pooler and PXF configs are maps validated against the API schema, " +
+			"and segments are added to the cluster in place with the expand operation. Users and resource groups are managed with `yandex_mdb_greenplum_user` and `yandex_mdb_greenplum_resource_group`. " +
+			"The state of `yandex_mdb_greenplum_cluster` can be moved to the resource with a `moved` block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: common.ResourceDescriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				Description: "ID of the Greenplum cluster. This ID is assigned by MDB at creation time.",
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the Greenplum cluster. Provided by the client when the cluster is created.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the Greenplum cluster.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"folder_id": schema.StringAttribute{
+				Description: common.ResourceDescriptions["folder_id"],
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Description: common.ResourceDescriptions["network_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment": schema.StringAttribute{
+				Description: "Deployment environment of the Greenplum cluster. Can be either `PRESTABLE` or `PRODUCTION`. The default is `PRODUCTION`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(greenplum.Cluster_PRODUCTION.String()),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(greenplum.Cluster_PRODUCTION.String(), greenplum.Cluster_PRESTABLE.String()),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: common.ResourceDescriptions["labels"],
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"zone_id": schema.StringAttribute{
+				Description: common.ResourceDescriptions["zone"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"subnet_id": schema.StringAttribute{
+				Description: "The ID of the subnet, to which the hosts belongs. The subnet must be a part of the network to which the cluster belongs.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"assign_public_ip": schema.BoolAttribute{
+				Description: "Sets whether the master hosts should get a public IP address on creation.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.RequiresReplace(),
+				},
+			},
+			"version": schema.StringAttribute{
+				Description: "Version of the Greenplum cluster.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"master_host_count": schema.Int64Attribute{
+				Description: "Number of hosts in the master subcluster (1 or 2).",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.RequiresReplace(),
+				},
+				Validators: []validator.Int64{
+					int64validator.Between(1, 2),
+				},
+			},
+			"segment_host_count": schema.Int64Attribute{
+				Description: "Number of hosts in the segment subcluster. The value can be only increased, new hosts are added to the cluster with the expand operation.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					ExpandOnly(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"segment_in_host": schema.Int64Attribute{
+				Description: "Number of segments on a segment host (not more than 1 + RAM/8). The value can be only increased, new segments are added to the cluster with the expand operation.",
+				Required:    true,
+				PlanModifiers: []planmodifier.Int64{
+					ExpandOnly(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"master_subcluster":  subclusterSchema("Settings of the master subcluster."),
+			"segment_subcluster": subclusterSchema("Settings of the segment subcluster."),
+			"master_host_group_ids": schema.SetAttribute{
+				Description: "A list of IDs of the host groups to place VMs of the master subcluster on.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"segment_host_group_ids": schema.SetAttribute{
+				Description: "A list of IDs of the host groups to place VMs of the segment subcluster on.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_name": schema.StringAttribute{
+				Description: "Greenplum cluster admin user name.",
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"user_password": schema.StringAttribute{
+				Description: "Greenplum cluster admin password.",
+				Required:    true,
+				Sensitive:   true,
+			},
+			"security_group_ids": schema.SetAttribute{
+				Description: common.ResourceDescriptions["security_group_ids"],
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: common.ResourceDescriptions["deletion_protection"],
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"service_account_id": schema.StringAttribute{
+				Description: "ID of service account to use with Yandex Cloud resources (e.g. S3, Cloud Logging).",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			// Optional nested attribute maintenance_window required all optional nested attributes
+			// But if the block is specified explicitly, then the type attribute is required
+			"maintenance_window": schema.SingleNestedAttribute{
+				Description: "Maintenance policy of the Greenplum cluster.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Object{
+					NewMaintenanceWindowStructValidator(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "Type of maintenance window. Can be either ANYTIME or WEEKLY. A day and hour of window need to be specified with weekly window.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("ANYTIME", "WEEKLY"),
+						},
+					},
+					"day": schema.StringAttribute{
+						Description: "Day of the week (in DDD format). Allowed values: \"MON\", \"TUE\", \"WED\", \"THU\", \"FRI\", \"SAT\",\"SUN\"",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								"MON", "TUE",
+								"WED", "THU",
+								"FRI", "SAT",
+								"SUN",
+							),
+						},
+					},
+					"hour": schema.Int64Attribute{
+						Description: "Hour of the day in UTC (in HH format). Allowed value is between 1 and 24.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 24),
+						},
+					},
+				},
+			},
+			"backup_window_start": optionalObjectSchema("Time to start the daily backup, in the UTC timezone.", map[string]schema.Attribute{
+				"hours": schema.Int64Attribute{
+					Description: "The hour at which backup will be started (UTC).",
+					Optional:    true,
+					Computed:    true,
+					Validators: []validator.Int64{
+						int64validator.Between(0, 23),
+					},
+				},
+				"minutes": schema.Int64Attribute{
+					Description: "The minute at which backup will be started (UTC).",
+					Optional:    true,
+					Computed:    true,
+					Validators: []validator.Int64{
+						int64validator.Between(0, 59),
+					},
+				},
+			}),
+			"access": optionalObjectSchema("Access policy to the Greenplum cluster.", map[string]schema.Attribute{
+				"data_lens":     optionalBoolSchema("Allow access for [Yandex DataLens](https://yandex.cloud/services/datalens)."),
+				"web_sql":       optionalBoolSchema("Allows access for [SQL queries in the management console](https://yandex.cloud/docs/managed-greenplum/operations/web-sql-query)."),
+				"data_transfer": optionalBoolSchema("Allow access for [DataTransfer](https://yandex.cloud/services/data-transfer)."),
+				"yandex_query":  optionalBoolSchema("Allow access for [Yandex Query](https://yandex.cloud/services/query)."),
+			}),
+			"cloud_storage": optionalObjectSchema("Cloud Storage settings of the Greenplum cluster.", map[string]schema.Attribute{
+				"enable": optionalBoolSchema("Whether to use cloud storage or not."),
+			}),
+			"logging": optionalObjectSchema("Cloud Logging settings.", map[string]schema.Attribute{
+				"enabled": optionalBoolSchema("Flag that indicates whether log delivery to Cloud Logging is enabled."),
+				"log_group_id": schema.StringAttribute{
+					Description: "Cloud Logging group ID to send logs to.",
+					Optional:    true,
+					Validators: []validator.String{
+						stringvalidator.ConflictsWith(path.MatchRelative().AtParent().AtName("folder_id")),
+					},
+				},
+				"folder_id": schema.StringAttribute{
+					Description: "ID of folder to which deliver logs.",
+					Optional:    true,
+				},
+				"command_center_enabled": optionalBoolSchema("Deliver Yandex Command Center's logs to Cloud Logging."),
+				"greenplum_enabled":      optionalBoolSchema("Deliver Greenplum's logs to Cloud Logging."),
+				"pooler_enabled":         optionalBoolSchema("Deliver connection pooler's logs to Cloud Logging."),
+			}),
+			"greenplum_config": settingsSchema(
+				NewGreenplumSettingsMapType(),
+				"Greenplum cluster settings. For more information, see [the official documentation](https://yandex.cloud/docs/managed-greenplum/concepts/settings-list).",
+			),
+			"pooler_config": settingsSchema(
+				NewPoolerSettingsMapType(),
+				"Settings of the connection pooler: `mode` (`SESSION` or `TRANSACTION`), `size` and `client_idle_timeout`. For more information, see [the Odyssey documentation](https://github.com/yandex/odyssey/blob/master/documentation/configuration.md).",
+			),
+			"pxf_config": settingsSchema(
+				NewPxfSettingsMapType(),
+				"Settings of the PXF daemon. For more information, see [the official documentation](https://yandex.cloud/docs/managed-greenplum/concepts/external-tables).",
+			),
+			"background_activities": optionalObjectSchema("Background activities settings.", map[string]schema.Attribute{
+				"analyze_and_vacuum": optionalObjectSchema("Block to configure 'ANALYZE' and 'VACUUM' daily operations.", map[string]schema.Attribute{
+					"start_time": schema.StringAttribute{
+						Description: "Time of day in 'HH:MM' format when scripts should run.",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.String{
+							stringplanmodifier.UseStateForUnknown(),
+						},
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(startTimeRegexp, "must be a time of day in HH:MM format"),
+						},
+					},
+					"analyze_timeout": optionalInt64Schema("Maximum duration of the `ANALYZE` operation, in seconds. As soon as this period expires, the `ANALYZE` operation will be forced to terminate."),
+					"vacuum_timeout":  optionalInt64Schema("Maximum duration of the `VACUUM` operation, in seconds. As soon as this period expires, the `VACUUM` operation will be forced to terminate."),
+				}),
+				"query_killer_idle":                queryKillerSchema("Block to configure script that kills long running queries that are in `idle` state."),
+				"query_killer_idle_in_transaction": queryKillerSchema("Block to configure script that kills long running queries that are in `idle in transaction` state."),
+				"query_killer_long_running":        queryKillerSchema("Block to configure script that kills long running queries (in any state)."),
+			}),
+			"master_hosts":  hostsSchema("Hosts of the master subcluster keyed by FQDN."),
+			"segment_hosts": hostsSchema("Hosts of the segment subcluster keyed by FQDN."),
+			"created_at": schema.StringAttribute{
+				Description: common.ResourceDescriptions["created_at"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"health": schema.StringAttribute{
+				Description: "Aggregated health of the cluster. For more information see `health` field of JSON representation in [the official documentation](https://yandex.cloud/docs/managed-greenplum/api-ref/Cluster/).",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the cluster. For more information see `status` field of JSON representation in [the official documentation](https://yandex.cloud/docs/managed-greenplum/api-ref/Cluster/).",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Cluster
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refreshResourceState(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(d...)
+}
+
+func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Cluster
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Greenplum Cluster")
+
+	request, diags := prepareCreateRequest(ctx, &plan, &r.providerConfig.ProviderState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := greenplumApi.CreateCluster(ctx, r.providerConfig.SDK, &resp.Diagnostics, request)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(cid)
+
+	r.refreshResourceState(ctx, &plan, &resp.Diagnostics)
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Cluster
+	var state Cluster
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Greenplum Cluster", map[string]interface{}{"id": plan.Id.ValueString()})
+
+	updateRequest, d := prepareUpdateRequest(ctx, &state, &plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	greenplumApi.UpdateCluster(ctx, r.providerConfig.SDK, &resp.Diagnostics, updateRequest)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	// Segments are added after the update, so new hosts are created with the updated resources.
+	greenplumApi.ExpandCluster(ctx, r.providerConfig.SDK, &resp.Diagnostics, prepareExpandRequest(&state, &plan))
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refreshResourceState(ctx, &plan, &resp.Diagnostics)
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Cluster
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	greenplumApi.DeleteCluster(ctx, r.providerConfig.SDK, &resp.Diagnostics, state.Id.ValueString())
+}
+
+func (r *clusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// MoveState allows to migrate from yandex_mdb_greenplum_cluster with a `moved` block.
+// Only the cluster ID and the admin password, which the API doesn't return, are taken from the source state.
+// All other attributes are refreshed from the API.
+func (r *clusterResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != legacyResourceTypeName {
+					return
+				}
+
+				src, diags := legacyStateFromRaw(req.SourceRawState.JSON)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), src.Id)...)
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("user_password"), src.UserPassword)...)
+			},
+		},
+	}
+}
+
+type legacyState struct {
+	Id           string `json:"id"`
+	UserPassword string `json:"user_password"`
+}
+
+func legacyStateFromRaw(rawState []byte) (legacyState, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var src legacyState
+	if err := json.Unmarshal(rawState, &src); err != nil {
+		diags.AddError(
+			"Failed to move resource state",
+			fmt.Sprintf("Error while unmarshaling %s state: %s", legacyResourceTypeName, err.Error()),
+		)
+		return src, diags
+	}
+
+	if src.Id == "" {
+		diags.AddError(
+			"Failed to move resource state",
+			fmt.Sprintf("Source %s state has no cluster id", legacyResourceTypeName),
+		)
+	}
+	return src, diags
+}
+
+func (r *clusterResource) refreshResourceState(ctx context.Context, state *Cluster, respDiagnostics *diag.Diagnostics) {
+	refreshClusterState(ctx, r.providerConfig, state, respDiagnostics)
+}
+
+// refreshClusterState is shared by the resource and the data source.
+func refreshClusterState(ctx context.Context, providerConfig *provider_config.Config, state *Cluster, respDiagnostics *diag.Diagnostics) {
+	cid := state.Id.ValueString()
+	cluster := greenplumApi.GetCluster(ctx, providerConfig.SDK, respDiagnostics, cid)
+	if respDiagnostics.HasError() {
+		return
+	}
+
+	masterHosts := mdbcommon.ReadComputedHosts(ctx, providerConfig.SDK, respDiagnostics, convertHostFromProto, &masterHostsAPI{&greenplumApi}, cid)
+	segmentHosts := mdbcommon.ReadComputedHosts(ctx, providerConfig.SDK, respDiagnostics, convertHostFromProto, &segmentHostsAPI{&greenplumApi}, cid)
+	if respDiagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+	state.MasterHosts, diags = types.MapValueFrom(ctx, hostType, masterHosts)
+	respDiagnostics.Append(diags...)
+	state.SegmentHosts, diags = types.MapValueFrom(ctx, hostType, segmentHosts)
+	respDiagnostics.Append(diags...)
+	if respDiagnostics.HasError() {
+		return
+	}
+
+	flattenCluster(ctx, state, cluster, respDiagnostics)
+}
@@ -0,0 +1,343 @@
+package mdb_greenplum_cluster_v2_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+const (
+	defaultMDBPageSize                     = 1000
+	gpResource                             = "yandex_mdb_greenplum_cluster_v2.foo"
+	yandexMDBGreenplumClusterDeleteTimeout = 60 * time.Minute
+)
+
+const gpVPCDependencies = `
+resource "yandex_vpc_network" "mdb-greenplum-test-net" {}
+
+resource "yandex_vpc_subnet" "mdb-greenplum-test-subnet-b" {
+  zone           = "ru-central1-b"
+  network_id     = yandex_vpc_network.mdb-greenplum-test-net.id
+  v4_cidr_blocks = ["10.2.0.0/24"]
+}
+`
+
+func init() {
+	resource.AddTestSweepers("yandex_mdb_greenplum_cluster_v2", &resource.Sweeper{
+		Name: "yandex_mdb_greenplum_cluster_v2",
+		F:    testSweepMDBGreenplumCluster,
+	})
+}
+
+// TestMain - add sweepers flag to the go test command
+// important for sweepers run.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func testSweepMDBGreenplumCluster(_ string) error {
+	conf, err := test.ConfigForSweepers()
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	resp, err := conf.SDK.MDB().Greenplum().Cluster().List(context.Background(), &greenplum.ListClustersRequest{
+		FolderId: conf.ProviderState.FolderID.ValueString(),
+		PageSize: defaultMDBPageSize,
+	})
+	if err != nil {
+		return fmt.Errorf("error getting Greenplum clusters: %s", err)
+	}
+
+	result := &multierror.Error{}
+	for _, c := range resp.Clusters {
+		if !sweepMDBGreenplumCluster(conf, c.Id) {
+			result = multierror.Append(result, fmt.Errorf("failed to sweep Greenplum cluster %q", c.Id))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func sweepMDBGreenplumCluster(conf *config.Config, id string) bool {
+	return test.SweepWithRetry(sweepMDBGreenplumClusterOnce, conf, "Greenplum cluster", id)
+}
+
+func sweepMDBGreenplumClusterOnce(conf *config.Config, id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), yandexMDBGreenplumClusterDeleteTimeout)
+	defer cancel()
+
+	mask := field_mask.FieldMask{Paths: []string{"deletion_protection"}}
+
+	op, err := conf.SDK.MDB().Greenplum().Cluster().Update(ctx, &greenplum.UpdateClusterRequest{
+		ClusterId:          id,
+		DeletionProtection: false,
+		UpdateMask:         &mask,
+	})
+	err = test.HandleSweepOperation(ctx, conf, op, err)
+	if err != nil && !strings.EqualFold(test.ErrorMessage(err), "no changes detected") {
+		return err
+	}
+
+	op, err = conf.SDK.MDB().Greenplum().Cluster().Delete(ctx, &greenplum.DeleteClusterRequest{
+		ClusterId: id,
+	})
+	return test.HandleSweepOperation(ctx, conf, op, err)
+}
+
+func mdbGreenplumClusterImportStep(name string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      name,
+		ImportState:       true,
+		ImportStateVerify: true,
+		ImportStateVerifyIgnore: []string{
+			"health",        // volatile value
+			"master_hosts",  // volatile value
+			"segment_hosts", // volatile value
+			"user_password", // not returned by the API
+		},
+	}
+}
+
+// Test that a Greenplum Cluster can be created, updated, expanded and destroyed
+func TestAccMDBGreenplumCluster_basic(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-greenplum-cluster-basic")
+	folderID := test.GetExampleFolderID()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBGreenplumClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBGreenplumClusterBasic(clusterName, "Greenplum Cluster Terraform Test", 2, `max_connections = 395`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(gpResource, tfjsonpath.New("name"), knownvalue.StringExact(clusterName)),
+					statecheck.ExpectKnownValue(gpResource, tfjsonpath.New("folder_id"), knownvalue.StringExact(folderID)),
+					statecheck.ExpectKnownValue(gpResource, tfjsonpath.New("environment"), knownvalue.StringExact("PRESTABLE")),
+					statecheck.ExpectKnownValue(gpResource, tfjsonpath.New("greenplum_config"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"max_connections": knownvalue.StringExact("395"),
+					})),
+					statecheck.ExpectKnownValue(gpResource, tfjsonpath.New("pooler_config"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"mode": knownvalue.StringExact("TRANSACTION"),
+						"size": knownvalue.StringExact("10"),
+					})),
+					statecheck.ExpectKnownValue(gpResource, tfjsonpath.New("master_hosts"), knownvalue.MapSizeExact(1)),
+					statecheck.ExpectKnownValue(gpResource, tfjsonpath.New("segment_hosts"), knownvalue.MapSizeExact(2)),
+				},
+			},
+			mdbGreenplumClusterImportStep(gpResource),
+			{
+				Config: testAccMDBGreenplumClusterBasic(clusterName, "Greenplum Cluster Terraform Test Updated", 3, `max_connections = 400`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(gpResource, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(gpResource, tfjsonpath.New("description"), knownvalue.StringExact("Greenplum Cluster Terraform Test Updated")),
+					statecheck.ExpectKnownValue(gpResource, tfjsonpath.New("segment_host_count"), knownvalue.Int64Exact(3)),
+					statecheck.ExpectKnownValue(gpResource, tfjsonpath.New("segment_hosts"), knownvalue.MapSizeExact(3)),
+					statecheck.ExpectKnownValue(gpResource, tfjsonpath.New("greenplum_config"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"max_connections": knownvalue.StringExact("400"),
+					})),
+				},
+			},
+			mdbGreenplumClusterImportStep(gpResource),
+		},
+	})
+}
+
+// Test that the state of yandex_mdb_greenplum_cluster can be moved to yandex_mdb_greenplum_cluster_v2 without recreation
+func TestAccMDBGreenplumCluster_moved(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-greenplum-cluster-moved")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBGreenplumClusterDestroy,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBGreenplumClusterLegacy(clusterName),
+			},
+			{
+				Config: testAccMDBGreenplumClusterMoved(clusterName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(gpResource, plancheck.ResourceActionNoop),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(gpResource, tfjsonpath.New("name"), knownvalue.StringExact(clusterName)),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckMDBGreenplumClusterDestroy(s *terraform.State) error {
+	conf := test.AccProvider.(*provider.Provider).GetConfig()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_mdb_greenplum_cluster_v2" {
+			continue
+		}
+
+		_, err := conf.SDK.MDB().Greenplum().Cluster().Get(context.Background(), &greenplum.GetClusterRequest{
+			ClusterId: rs.Primary.ID,
+		})
+
+		if err == nil {
+			return fmt.Errorf("Greenplum Cluster still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccMDBGreenplumClusterBasic(name, description string, segmentHostCount int, greenplumConfig string) string {
+	return fmt.Sprintf(gpVPCDependencies+`
+resource "yandex_mdb_greenplum_cluster_v2" "foo" {
+  name             = "%s"
+  description      = "%s"
+  environment      = "PRESTABLE"
+  network_id       = yandex_vpc_network.mdb-greenplum-test-net.id
+  zone_id          = "ru-central1-b"
+  subnet_id        = yandex_vpc_subnet.mdb-greenplum-test-subnet-b.id
+  assign_public_ip = false
+  version          = "6.25"
+
+  master_host_count  = 1
+  segment_host_count = %d
+  segment_in_host    = 1
+
+  master_subcluster = {
+    resources = {
+      resource_preset_id = "s2.micro"
+      disk_size          = 24
+      disk_type_id       = "network-ssd"
+    }
+  }
+  segment_subcluster = {
+    resources = {
+      resource_preset_id = "s2.micro"
+      disk_size          = 24
+      disk_type_id       = "network-ssd"
+    }
+  }
+
+  user_name     = "user1"
+  user_password = "mysecurepassword"
+
+  greenplum_config = {
+    %s
+  }
+
+  pooler_config = {
+    mode = "TRANSACTION"
+    size = 10
+  }
+}
+`, name, description, segmentHostCount, greenplumConfig)
+}
+
+func testAccMDBGreenplumClusterLegacy(name string) string {
+	return fmt.Sprintf(gpVPCDependencies+`
+resource "yandex_mdb_greenplum_cluster" "foo" {
+  name             = "%s"
+  environment      = "PRESTABLE"
+  network_id       = yandex_vpc_network.mdb-greenplum-test-net.id
+  zone             = "ru-central1-b"
+  subnet_id        = yandex_vpc_subnet.mdb-greenplum-test-subnet-b.id
+  assign_public_ip = false
+  version          = "6.25"
+
+  master_host_count  = 1
+  segment_host_count = 2
+  segment_in_host    = 1
+
+  master_subcluster {
+    resources {
+      resource_preset_id = "s2.micro"
+      disk_size          = 24
+      disk_type_id       = "network-ssd"
+    }
+  }
+  segment_subcluster {
+    resources {
+      resource_preset_id = "s2.micro"
+      disk_size          = 24
+      disk_type_id       = "network-ssd"
+    }
+  }
+
+  user_name     = "user1"
+  user_password = "mysecurepassword"
+}
+`, name)
+}
+
+func testAccMDBGreenplumClusterMoved(name string) string {
+	return fmt.Sprintf(gpVPCDependencies+`
+moved {
+  from = yandex_mdb_greenplum_cluster.foo
+  to   = yandex_mdb_greenplum_cluster_v2.foo
+}
+
+resource "yandex_mdb_greenplum_cluster_v2" "foo" {
+  name             = "%s"
+  environment      = "PRESTABLE"
+  network_id       = yandex_vpc_network.mdb-greenplum-test-net.id
+  zone_id          = "ru-central1-b"
+  subnet_id        = yandex_vpc_subnet.mdb-greenplum-test-subnet-b.id
+  assign_public_ip = false
+  version          = "6.25"
+
+  master_host_count  = 1
+  segment_host_count = 2
+  segment_in_host    = 1
+
+  master_subcluster = {
+    resources = {
+      resource_preset_id = "s2.micro"
+      disk_size          = 24
+      disk_type_id       = "network-ssd"
+    }
+  }
+  segment_subcluster = {
+    resources = {
+      resource_preset_id = "s2.micro"
+      disk_size          = 24
+      disk_type_id       = "network-ssd"
+    }
+  }
+
+  user_name     = "user1"
+  user_password = "mysecurepassword"
+}
+`, name)
+}
@@ -0,0 +1,246 @@
+package mdb_greenplum_cluster_v2
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+func subclusterResources(ctx context.Context, s types.Object, diags *diag.Diagnostics) mdbcommon.Resource {
+	var sc Subcluster
+	diags.Append(s.As(ctx, &sc, datasize.UnhandledOpts)...)
+
+	var r mdbcommon.Resource
+	if !sc.Resources.IsNull() && !sc.Resources.IsUnknown() {
+		diags.Append(sc.Resources.As(ctx, &r, datasize.UnhandledOpts)...)
+	}
+	return r
+}
+
+func resourcesUpdatePaths(ctx context.Context, prefix string, plan, state types.Object, diags *diag.Diagnostics) []string {
+	pr := subclusterResources(ctx, plan, diags)
+	sr := subclusterResources(ctx, state, diags)
+
+	var paths []string
+	if !pr.ResourcePresetId.Equal(sr.ResourcePresetId) {
+		paths = append(paths, prefix+".resources.resource_preset_id")
+	}
+	if !pr.DiskTypeId.Equal(sr.DiskTypeId) {
+		paths = append(paths, prefix+".resources.disk_type_id")
+	}
+	if !pr.DiskSize.Equal(sr.DiskSize) {
+		paths = append(paths, prefix+".resources.disk_size")
+	}
+	return paths
+}
+
+// settingsUpdatePaths returns paths of all settings which are set in the plan or in the state,
+// removed settings are reset to defaults by the API.
+func settingsUpdatePaths(prefix string, plan, state mdbcommon.SettingsMapValue, diags *diag.Diagnostics) []string {
+	attrsState := mdbcommon.GetAttrNamesSetFromMap(state.MapValue, diags)
+	attrsPlan := mdbcommon.GetAttrNamesSetFromMap(plan.MapValue, diags)
+	maps.Copy(attrsPlan, attrsState)
+
+	attrs := make([]string, 0, len(attrsPlan))
+	for attr := range attrsPlan {
+		attrs = append(attrs, attr)
+	}
+	sort.Strings(attrs)
+
+	paths := make([]string, 0, len(attrs))
+	for _, attr := range attrs {
+		paths = append(paths, fmt.Sprintf("%s.%s", prefix, attr))
+	}
+	return paths
+}
+
+func objectAttrsUpdatePaths(prefix string, plan, state types.Object) []string {
+	var paths []string
+	stateAttrs := state.Attributes()
+	for name, pv := range plan.Attributes() {
+		if sv, ok := stateAttrs[name]; !ok || !pv.Equal(sv) {
+			paths = append(paths, prefix+"."+name)
+		}
+	}
+	sort.Strings(paths)
+	return paths
+}
+
+func backgroundActivitiesUpdatePaths(ctx context.Context, plan, state types.Object, diags *diag.Diagnostics) []string {
+	var pb, sb BackgroundActivities
+	diags.Append(plan.As(ctx, &pb, datasize.UnhandledOpts)...)
+	diags.Append(state.As(ctx, &sb, datasize.UnhandledOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	const prefix = "config_spec.background_activities"
+
+	var paths []string
+	if !pb.AnalyzeAndVacuum.Equal(sb.AnalyzeAndVacuum) {
+		for _, p := range objectAttrsUpdatePaths(prefix+".analyze_and_vacuum", pb.AnalyzeAndVacuum, sb.AnalyzeAndVacuum) {
+			if p == prefix+".analyze_and_vacuum.start_time" {
+				p = prefix + ".analyze_and_vacuum.start"
+			}
+			paths = append(paths, p)
+		}
+	}
+	if !pb.QueryKillerIdle.Equal(sb.QueryKillerIdle) {
+		paths = append(paths, objectAttrsUpdatePaths(prefix+".query_killer_scripts.idle", pb.QueryKillerIdle, sb.QueryKillerIdle)...)
+	}
+	if !pb.QueryKillerIdleInTransaction.Equal(sb.QueryKillerIdleInTransaction) {
+		paths = append(paths, objectAttrsUpdatePaths(prefix+".query_killer_scripts.idle_in_transaction", pb.QueryKillerIdleInTransaction, sb.QueryKillerIdleInTransaction)...)
+	}
+	if !pb.QueryKillerLongRunning.Equal(sb.QueryKillerLongRunning) {
+		paths = append(paths, objectAttrsUpdatePaths(prefix+".query_killer_scripts.long_running", pb.QueryKillerLongRunning, sb.QueryKillerLongRunning)...)
+	}
+	return paths
+}
+
+func prepareUpdateRequest(ctx context.Context, state, plan *Cluster) (*greenplum.UpdateClusterRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	request := &greenplum.UpdateClusterRequest{
+		ClusterId:  state.Id.ValueString(),
+		UpdateMask: &field_mask.FieldMask{},
+	}
+
+	if !plan.Name.Equal(state.Name) {
+		request.SetName(plan.Name.ValueString())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "name")
+	}
+
+	if !plan.Description.Equal(state.Description) {
+		request.SetDescription(plan.Description.ValueString())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "description")
+	}
+
+	if !plan.Labels.Equal(state.Labels) {
+		request.SetLabels(mdbcommon.ExpandLabels(ctx, plan.Labels, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "labels")
+	}
+
+	if !plan.UserPassword.Equal(state.UserPassword) {
+		request.SetUserPassword(plan.UserPassword.ValueString())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "user_password")
+	}
+
+	if !plan.ServiceAccountId.Equal(state.ServiceAccountId) {
+		request.SetServiceAccountId(plan.ServiceAccountId.ValueString())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "service_account_id")
+	}
+
+	config := expandGreenplumConfig(ctx, plan, &diags)
+	updConf := false
+
+	if !plan.BackupWindowStart.Equal(state.BackupWindowStart) {
+		updConf = true
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config.backup_window_start")
+	}
+
+	if !plan.Access.Equal(state.Access) {
+		updConf = true
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, objectAttrsUpdatePaths("config.access", plan.Access, state.Access)...)
+	}
+
+	if updConf {
+		request.SetConfig(config)
+	}
+
+	if paths := resourcesUpdatePaths(ctx, "master_config", plan.MasterSubcluster, state.MasterSubcluster, &diags); len(paths) > 0 {
+		request.SetMasterConfig(&greenplum.MasterSubclusterConfigSpec{
+			Resources: expandSubclusterResources(ctx, plan.MasterSubcluster, &diags),
+		})
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, paths...)
+	}
+
+	if paths := resourcesUpdatePaths(ctx, "segment_config", plan.SegmentSubcluster, state.SegmentSubcluster, &diags); len(paths) > 0 {
+		request.SetSegmentConfig(&greenplum.SegmentSubclusterConfigSpec{
+			Resources: expandSubclusterResources(ctx, plan.SegmentSubcluster, &diags),
+		})
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, paths...)
+	}
+
+	configSpec := &greenplum.ConfigSpec{}
+	updConfSpec := false
+
+	if !plan.GreenplumConfig.Equal(state.GreenplumConfig) {
+		updConfSpec = true
+		// All settings may be removed, reset them to defaults with an empty config
+		configSpec.SetGreenplumConfig_6(&greenplum.GreenplumConfig6{})
+		if cfg := expandGreenplumSettings(ctx, plan.GreenplumConfig, &diags); cfg != nil {
+			configSpec.SetGreenplumConfig(cfg)
+		}
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, settingsUpdatePaths("config_spec.greenplum_config_6", plan.GreenplumConfig, state.GreenplumConfig, &diags)...)
+	}
+
+	if !plan.PoolerConfig.Equal(state.PoolerConfig) {
+		updConfSpec = true
+		configSpec.SetPool(&greenplum.ConnectionPoolerConfig{})
+		if cfg := expandPoolerConfig(ctx, plan.PoolerConfig, &diags); cfg != nil {
+			configSpec.SetPool(cfg)
+		}
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, settingsUpdatePaths("config_spec.pool", plan.PoolerConfig, state.PoolerConfig, &diags)...)
+	}
+
+	if !plan.PxfConfig.Equal(state.PxfConfig) {
+		updConfSpec = true
+		configSpec.SetPxfConfig(&greenplum.PXFConfig{})
+		if cfg := expandPxfConfig(ctx, plan.PxfConfig, &diags); cfg != nil {
+			configSpec.SetPxfConfig(cfg)
+		}
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, settingsUpdatePaths("config_spec.pxf_config", plan.PxfConfig, state.PxfConfig, &diags)...)
+	}
+
+	if !plan.BackgroundActivities.Equal(state.BackgroundActivities) {
+		if paths := backgroundActivitiesUpdatePaths(ctx, plan.BackgroundActivities, state.BackgroundActivities, &diags); len(paths) > 0 {
+			updConfSpec = true
+			configSpec.SetBackgroundActivities(expandBackgroundActivities(ctx, plan.BackgroundActivities, &diags))
+			request.UpdateMask.Paths = append(request.UpdateMask.Paths, paths...)
+		}
+	}
+
+	if updConfSpec {
+		request.SetConfigSpec(configSpec)
+	}
+
+	if !plan.CloudStorage.Equal(state.CloudStorage) {
+		request.SetCloudStorage(expandCloudStorage(ctx, plan.CloudStorage, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "cloud_storage")
+	}
+
+	if !plan.Logging.Equal(state.Logging) {
+		request.SetLogging(expandLogging(ctx, plan.Logging, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, objectAttrsUpdatePaths("logging", plan.Logging, state.Logging)...)
+	}
+
+	if !plan.DeletionProtection.Equal(state.DeletionProtection) {
+		request.SetDeletionProtection(plan.DeletionProtection.ValueBool())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "deletion_protection")
+	}
+
+	if !plan.SecurityGroupIds.Equal(state.SecurityGroupIds) {
+		request.SetSecurityGroupIds(mdbcommon.ExpandSecurityGroupIds(ctx, plan.SecurityGroupIds, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "security_group_ids")
+	}
+
+	if !plan.MaintenanceWindow.Equal(state.MaintenanceWindow) {
+		request.SetMaintenanceWindow(mdbcommon.ExpandClusterMaintenanceWindow[
+			greenplum.MaintenanceWindow,
+			greenplum.WeeklyMaintenanceWindow,
+			greenplum.AnytimeMaintenanceWindow,
+			greenplum.WeeklyMaintenanceWindow_WeekDay,
+		](ctx, plan.MaintenanceWindow, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "maintenance_window")
+	}
+
+	return request, diags
+}
@@ -0,0 +1,181 @@
+package mdb_greenplum_cluster_v2
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/greenplum/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+func buildTestSubclusterObj(preset string, diskSize int64) types.Object {
+	return types.ObjectValueMust(SubclusterAttrTypes, map[string]attr.Value{
+		"resources": types.ObjectValueMust(mdbcommon.ResourceType.AttrTypes, map[string]attr.Value{
+			"resource_preset_id": types.StringValue(preset),
+			"disk_type_id":       types.StringValue("network-ssd"),
+			"disk_size":          types.Int64Value(diskSize),
+		}),
+	})
+}
+
+func buildTestAccessObj(dataLens, webSql bool) types.Object {
+	return types.ObjectValueMust(AccessAttrTypes, map[string]attr.Value{
+		"data_lens":     types.BoolValue(dataLens),
+		"web_sql":       types.BoolValue(webSql),
+		"data_transfer": types.BoolValue(false),
+		"yandex_query":  types.BoolValue(false),
+	})
+}
+
+func buildTestQueryKillerObj(enable bool, maxAge int64) types.Object {
+	return types.ObjectValueMust(QueryKillerAttrTypes, map[string]attr.Value{
+		"enable":       types.BoolValue(enable),
+		"max_age":      types.Int64Value(maxAge),
+		"ignore_users": types.ListValueMust(types.StringType, []attr.Value{}),
+	})
+}
+
+func buildTestBackgroundActivitiesObj(startTime string, idle types.Object) types.Object {
+	return types.ObjectValueMust(BackgroundActivitiesAttrTypes, map[string]attr.Value{
+		"analyze_and_vacuum": types.ObjectValueMust(AnalyzeAndVacuumAttrTypes, map[string]attr.Value{
+			"start_time":      types.StringValue(startTime),
+			"analyze_timeout": types.Int64Value(36000),
+			"vacuum_timeout":  types.Int64Value(36000),
+		}),
+		"query_killer_idle":                idle,
+		"query_killer_idle_in_transaction": buildTestQueryKillerObj(false, 3600),
+		"query_killer_long_running":        buildTestQueryKillerObj(false, 3600),
+	})
+}
+
+func buildTestCluster() Cluster {
+	return Cluster{
+		Id:                   types.StringValue("test-id"),
+		Name:                 types.StringValue("test-cluster"),
+		Description:          types.StringValue("test-description"),
+		Labels:               types.MapNull(types.StringType),
+		UserPassword:         types.StringValue("password"),
+		ServiceAccountId:     types.StringNull(),
+		Version:              types.StringValue("6.25"),
+		ZoneId:               types.StringValue("ru-central1-a"),
+		SubnetId:             types.StringValue("test-subnet"),
+		AssignPublicIp:       types.BoolValue(false),
+		SegmentHostCount:     types.Int64Value(2),
+		SegmentInHost:        types.Int64Value(1),
+		MasterSubcluster:     buildTestSubclusterObj("s2.micro", 24),
+		SegmentSubcluster:    buildTestSubclusterObj("s2.micro", 24),
+		SecurityGroupIds:     types.SetValueMust(types.StringType, []attr.Value{}),
+		DeletionProtection:   types.BoolValue(false),
+		MaintenanceWindow:    types.ObjectNull(mdbcommon.MaintenanceWindowType.AttrTypes),
+		BackupWindowStart:    types.ObjectNull(mdbcommon.BackupWindowType.AttrTypes),
+		Access:               buildTestAccessObj(false, false),
+		CloudStorage:         types.ObjectValueMust(CloudStorageAttrTypes, map[string]attr.Value{"enable": types.BoolValue(false)}),
+		Logging:              types.ObjectNull(LoggingAttrTypes),
+		GreenplumConfig:      newTestSettings(greenplumAttrProvider, map[string]attr.Value{"max_connections": types.Int64Value(400)}),
+		PoolerConfig:         mdbcommon.NewSettingsMapNull(),
+		PxfConfig:            mdbcommon.NewSettingsMapNull(),
+		BackgroundActivities: buildTestBackgroundActivitiesObj("03:00", buildTestQueryKillerObj(false, 3600)),
+	}
+}
+
+func TestYandexProvider_MDBGreenplumClusterPrepareUpdateRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	state := buildTestCluster()
+
+	cases := []struct {
+		testname      string
+		modify        func(c *Cluster)
+		expectedPaths []string
+	}{
+		{
+			testname:      "CheckNoChanges",
+			modify:        func(c *Cluster) {},
+			expectedPaths: nil,
+		},
+		{
+			testname: "CheckBaseAttributes",
+			modify: func(c *Cluster) {
+				c.Name = types.StringValue("test-cluster-new")
+				c.UserPassword = types.StringValue("new-password")
+				c.DeletionProtection = types.BoolValue(true)
+				c.Access = buildTestAccessObj(true, false)
+			},
+			expectedPaths: []string{
+				"name",
+				"user_password",
+				"config.access.data_lens",
+				"deletion_protection",
+			},
+		},
+		{
+			testname: "CheckSubclusterResources",
+			modify: func(c *Cluster) {
+				c.MasterSubcluster = buildTestSubclusterObj("s2.small", 24)
+				c.SegmentSubcluster = buildTestSubclusterObj("s2.micro", 32)
+			},
+			expectedPaths: []string{
+				"master_config.resources.resource_preset_id",
+				"segment_config.resources.disk_size",
+			},
+		},
+		{
+			testname: "CheckSettings",
+			modify: func(c *Cluster) {
+				c.GreenplumConfig = newTestSettings(greenplumAttrProvider, map[string]attr.Value{
+					"log_statement": types.Int64Value(int64(greenplum.LogStatement_DDL)),
+				})
+				c.PoolerConfig = newTestSettings(poolerAttrProvider, map[string]attr.Value{
+					"size": types.Int64Value(10),
+				})
+			},
+			expectedPaths: []string{
+				"config_spec.greenplum_config_6.log_statement",
+				"config_spec.greenplum_config_6.max_connections",
+				"config_spec.pool.size",
+			},
+		},
+		{
+			testname: "CheckBackgroundActivities",
+			modify: func(c *Cluster) {
+				c.BackgroundActivities = buildTestBackgroundActivitiesObj("04:30", buildTestQueryKillerObj(true, 3600))
+			},
+			expectedPaths: []string{
+				"config_spec.background_activities.analyze_and_vacuum.start",
+				"config_spec.background_activities.query_killer_scripts.idle.enable",
+			},
+		},
+		{
+			testname: "CheckSegmentsExpansionIsNotUpdated",
+			modify: func(c *Cluster) {
+				c.SegmentHostCount = types.Int64Value(4)
+				c.SegmentInHost = types.Int64Value(2)
+			},
+			expectedPaths: nil,
+		},
+	}
+
+	for _, c := range cases {
+		plan := buildTestCluster()
+		c.modify(&plan)
+
+		req, diags := prepareUpdateRequest(ctx, &state, &plan)
+		if diags.HasError() {
+			t.Errorf("Unexpected update diagnostics %s test: %v", c.testname, diags.Errors())
+			continue
+		}
+
+		if !reflect.DeepEqual(req.UpdateMask.Paths, c.expectedPaths) {
+			t.Errorf(
+				"Unexpected update mask %s test: expected %v, actual %v",
+				c.testname,
+				c.expectedPaths,
+				req.UpdateMask.Paths,
+			)
+		}
+	}
+}
@@ -0,0 +1,115 @@
+package mdb_greenplum_cluster_v2
+
+import (
+	"context"
+	"fmt"
+	"regexp"
+
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+)
+
+var _ validator.Object = &maintenanceWindowStructValidator{}
+
+type maintenanceWindowStructValidator struct{}
+
+func NewMaintenanceWindowStructValidator() *maintenanceWindowStructValidator {
+	return &maintenanceWindowStructValidator{}
+}
+
+func (m *maintenanceWindowStructValidator) ValidateObject(ctx context.Context, req validator.ObjectRequest, resp *validator.ObjectResponse) {
+
+	if req.ConfigValue.IsNull() || req.ConfigValue.IsUnknown() {
+		return
+	}
+
+	var t, d types.String
+	var h types.Int64
+
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.AtName("type"), &t)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.AtName("day"), &d)...)
+	resp.Diagnostics.Append(req.Config.GetAttribute(ctx, req.Path.AtName("hour"), &h)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if t.IsNull() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Failed to validate maintenance_window",
+			`Field "type" should be set`,
+		)
+		return
+	}
+
+	if t.ValueString() == "ANYTIME" && (!d.IsNull() || !h.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Failed to validate maintenance_window",
+			`day and hour should not be set, when using ANYTIME`,
+		)
+		return
+	}
+
+	if t.ValueString() == "WEEKLY" && (d.IsNull() || h.IsNull()) {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Failed to validate maintenance_window",
+			`day and hour should be set, when using WEEKLY`,
+		)
+	}
+}
+
+func (m *maintenanceWindowStructValidator) Description(_ context.Context) string {
+	return `
+		Maintenance window block validation. 
+		Check block structure in general for ANYTIME and WEEKLY maintenance. 
+		Attributes hour and day should be set ONLY for WEEKLY maintenance.
+	`
+}
+
+func (m *maintenanceWindowStructValidator) MarkdownDescription(_ context.Context) string {
+	return `
+		Maintenance window block validation. 
+		Check block structure in general for *ANYTIME* and *WEEKLY* maintenance. 
+		Attributes hour and day should be set ONLY for *WEEKLY* maintenance.
+	`
+}
+
+var _ planmodifier.Int64 = &expandOnlyModifier{}
+
+// expandOnlyModifier rejects decreasing of segment counters at plan time.
+// Increasing of them is applied with the Expand API call, so it doesn't require replacement of the cluster.
+type expandOnlyModifier struct{}
+
+func ExpandOnly() *expandOnlyModifier {
+	return &expandOnlyModifier{}
+}
+
+func (m *expandOnlyModifier) PlanModifyInt64(_ context.Context, req planmodifier.Int64Request, resp *planmodifier.Int64Response) {
+	if req.StateValue.IsNull() || req.PlanValue.IsNull() || req.PlanValue.IsUnknown() {
+		return
+	}
+
+	if req.PlanValue.ValueInt64() < req.StateValue.ValueInt64() {
+		resp.Diagnostics.AddAttributeError(
+			req.Path,
+			"Failed to plan segments expansion",
+			fmt.Sprintf(
+				"The value can't be decreased from %d to %d: segments of the Greenplum cluster can be only added.",
+				req.StateValue.ValueInt64(), req.PlanValue.ValueInt64(),
+			),
+		)
+	}
+}
+
+func (m *expandOnlyModifier) Description(_ context.Context) string {
+	return "The value can be only increased, the cluster is expanded in place."
+}
+
+func (m *expandOnlyModifier) MarkdownDescription(ctx context.Context) string {
+	return m.Description(ctx)
+}
+
+var startTimeRegexp = regexp.MustCompile(`^([01]?[0-9]|2[0-3]):[0-5][0-9]$`)
@@ -0,0 +1,216 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	protobuf_adapter "github.com/yandex-cloud/terraform-provider-yandex/pkg/adapters/protobuf"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	utils "github.com/yandex-cloud/terraform-provider-yandex/pkg/wrappers"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func prepareCreateRequest(ctx context.Context, plan *Cluster, providerConfig *config.State) (*kafka.CreateClusterRequest, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	request := &kafka.CreateClusterRequest{
+		Name:               plan.Name.ValueString(),
+		Description:        plan.Description.ValueString(),
+		FolderId:           mdbcommon.ExpandFolderId(ctx, plan.FolderId, providerConfig, &diags),
+		NetworkId:          plan.NetworkId.ValueString(),
+		Environment:        mdbcommon.ExpandEnvironment[kafka.Cluster_Environment](ctx, plan.Environment, &diags),
+		Labels:             mdbcommon.ExpandLabels(ctx, plan.Labels, &diags),
+		ConfigSpec:         expandConfigSpec(ctx, plan, &diags),
+		SubnetId:           expandStringList(ctx, plan.SubnetIds, &diags),
+		SecurityGroupIds:   mdbcommon.ExpandSecurityGroupIds(ctx, plan.SecurityGroupIds, &diags),
+		HostGroupIds:       mdbcommon.ExpandSecurityGroupIds(ctx, plan.HostGroupIds, &diags),
+		DeletionProtection: plan.DeletionProtection.ValueBool(),
+		MaintenanceWindow: mdbcommon.ExpandClusterMaintenanceWindow[
+			kafka.MaintenanceWindow,
+			kafka.WeeklyMaintenanceWindow,
+			kafka.AnytimeMaintenanceWindow,
+			kafka.WeeklyMaintenanceWindow_WeekDay,
+		](ctx, plan.MaintenanceWindow, &diags),
+	}
+	return request, diags
+}
+
+func expandStringList(ctx context.Context, l types.List, diags *diag.Diagnostics) []string {
+	if !utils.IsPresent(l) {
+		return nil
+	}
+
+	var res []string
+	diags.Append(l.ElementsAs(ctx, &res, false)...)
+	return res
+}
+
+func expandConfigSpec(ctx context.Context, plan *Cluster, diags *diag.Diagnostics) *kafka.ConfigSpec {
+	return &kafka.ConfigSpec{
+		Version:             plan.Version.ValueString(),
+		Kafka:               expandKafka(ctx, plan.Version.ValueString(), plan.Kafka, diags),
+		Zookeeper:           expandZookeeper(ctx, plan.Zookeeper, diags),
+		Kraft:               expandKraft(ctx, plan.Kraft, diags),
+		ZoneId:              expandStringList(ctx, plan.Zones, diags),
+		BrokersCount:        expandBrokersCount(plan.BrokersCount),
+		AssignPublicIp:      plan.AssignPublicIp.ValueBool(),
+		SchemaRegistry:      plan.SchemaRegistry.ValueBool(),
+		Access:              expandAccess(ctx, plan.Access, diags),
+		RestApiConfig:       expandRestAPI(ctx, plan.RestApi, diags),
+		KafkaUiConfig:       expandKafkaUI(ctx, plan.KafkaUI, diags),
+		DiskSizeAutoscaling: expandDiskSizeAutoscaling(ctx, plan.DiskSizeAutoscaling, diags),
+	}
+}
+
+func expandBrokersCount(b types.Int64) *wrapperspb.Int64Value {
+	if !utils.IsPresent(b) {
+		return nil
+	}
+	return wrapperspb.Int64(b.ValueInt64())
+}
+
+func expandKafka(ctx context.Context, version string, k types.Object, diags *diag.Diagnostics) *kafka.ConfigSpec_Kafka {
+	if !utils.IsPresent(k) {
+		return nil
+	}
+
+	var kf Kafka
+	diags.Append(k.As(ctx, &kf, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	res := &kafka.ConfigSpec_Kafka{
+		Resources: mdbcommon.ExpandResources[kafka.Resources](ctx, kf.Resources, diags),
+	}
+	if utils.IsPresent(kf.KafkaConfig) {
+		res.KafkaConfig = expandKafkaConfig(ctx, version, kf.KafkaConfig, diags)
+	}
+	return res
+}
+
+// isKafkaConfig2_8 reports whether the cluster version is configured with KafkaConfig2_8.
+// All other supported versions are configured with KafkaConfig3.
+func isKafkaConfig2_8(version string) bool {
+	return strings.HasPrefix(version, "2.")
+}
+
+func getKafkaConfigFieldName(version string) string {
+	if isKafkaConfig2_8(version) {
+		return "kafka_config_2_8"
+	}
+	return "kafka_config_3"
+}
+
+func expandKafkaConfig(ctx context.Context, version string, config mdbcommon.SettingsMapValue, diags *diag.Diagnostics) kafka.ConfigSpec_Kafka_KafkaConfig {
+	a := protobuf_adapter.NewProtobufMapDataAdapter()
+	attrs := config.PrimitiveElements(ctx, diags)
+	if diags.HasError() {
+		return nil
+	}
+
+	if isKafkaConfig2_8(version) {
+		cfg := &kafka.KafkaConfig2_8{}
+		a.Fill(ctx, cfg, attrs, diags)
+		return &kafka.ConfigSpec_Kafka_KafkaConfig_2_8{KafkaConfig_2_8: cfg}
+	}
+
+	cfg := &kafka.KafkaConfig3{}
+	a.Fill(ctx, cfg, attrs, diags)
+	return &kafka.ConfigSpec_Kafka_KafkaConfig_3{KafkaConfig_3: cfg}
+}
+
+func expandController(ctx context.Context, c types.Object, diags *diag.Diagnostics) *kafka.Resources {
+	if !utils.IsPresent(c) {
+		return nil
+	}
+
+	var ctrl Controller
+	diags.Append(c.As(ctx, &ctrl, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+	return mdbcommon.ExpandResources[kafka.Resources](ctx, ctrl.Resources, diags)
+}
+
+func expandZookeeper(ctx context.Context, z types.Object, diags *diag.Diagnostics) *kafka.ConfigSpec_Zookeeper {
+	r := expandController(ctx, z, diags)
+	if r == nil {
+		return nil
+	}
+	return &kafka.ConfigSpec_Zookeeper{Resources: r}
+}
+
+func expandKraft(ctx context.Context, k types.Object, diags *diag.Diagnostics) *kafka.ConfigSpec_KRaft {
+	r := expandController(ctx, k, diags)
+	if r == nil {
+		return nil
+	}
+	return &kafka.ConfigSpec_KRaft{Resources: r}
+}
+
+func expandAccess(ctx context.Context, a types.Object, diags *diag.Diagnostics) *kafka.Access {
+	if !utils.IsPresent(a) {
+		return nil
+	}
+
+	var access Access
+	diags.Append(a.As(ctx, &access, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+	return &kafka.Access{
+		DataTransfer: access.DataTransfer.ValueBool(),
+	}
+}
+
+func expandToggle(ctx context.Context, t types.Object, diags *diag.Diagnostics) (bool, bool) {
+	if !utils.IsPresent(t) {
+		return false, false
+	}
+
+	var toggle Toggle
+	diags.Append(t.As(ctx, &toggle, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return false, false
+	}
+	return toggle.Enabled.ValueBool(), true
+}
+
+func expandRestAPI(ctx context.Context, r types.Object, diags *diag.Diagnostics) *kafka.ConfigSpec_RestAPIConfig {
+	enabled, ok := expandToggle(ctx, r, diags)
+	if !ok {
+		return nil
+	}
+	return &kafka.ConfigSpec_RestAPIConfig{Enabled: enabled}
+}
+
+func expandKafkaUI(ctx context.Context, k types.Object, diags *diag.Diagnostics) *kafka.ConfigSpec_KafkaUIConfig {
+	enabled, ok := expandToggle(ctx, k, diags)
+	if !ok {
+		return nil
+	}
+	return &kafka.ConfigSpec_KafkaUIConfig{Enabled: enabled}
+}
+
+func expandDiskSizeAutoscaling(ctx context.Context, d types.Object, diags *diag.Diagnostics) *kafka.DiskSizeAutoscaling {
+	if !utils.IsPresent(d) {
+		return nil
+	}
+
+	var dsa DiskSizeAutoscaling
+	diags.Append(d.As(ctx, &dsa, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+	return &kafka.DiskSizeAutoscaling{
+		DiskSizeLimit:           datasize.ToBytes(dsa.DiskSizeLimit.ValueInt64()),
+		PlannedUsageThreshold:   dsa.PlannedUsageThreshold.ValueInt64(),
+		EmergencyUsageThreshold: dsa.EmergencyUsageThreshold.ValueInt64(),
+	}
+}
@@ -0,0 +1,246 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func buildTestResourcesObj(preset, diskType string, diskSize int64) types.Object {
+	return types.ObjectValueMust(mdbcommon.ResourceType.AttrTypes, map[string]attr.Value{
+		"resource_preset_id": types.StringValue(preset),
+		"disk_type_id":       types.StringValue(diskType),
+		"disk_size":          types.Int64Value(diskSize),
+	})
+}
+
+func buildTestKafkaObj(resources types.Object, cfg mdbcommon.SettingsMapValue) types.Object {
+	return types.ObjectValueMust(KafkaAttrTypes, map[string]attr.Value{
+		"resources":    resources,
+		"kafka_config": cfg,
+	})
+}
+
+func TestYandexProvider_MDBKafkaClusterKafkaExpand(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	resources := buildTestResourcesObj("s2.micro", "network-ssd", 16)
+	expectedResources := &kafka.Resources{
+		ResourcePresetId: "s2.micro",
+		DiskTypeId:       "network-ssd",
+		DiskSize:         16 * 1024 * 1024 * 1024,
+	}
+
+	cases := []struct {
+		testname      string
+		version       string
+		reqVal        types.Object
+		expectedVal   *kafka.ConfigSpec_Kafka
+		expectedError bool
+	}{
+		{
+			testname: "CheckKafkaConfig3",
+			version:  "3.6",
+			reqVal: buildTestKafkaObj(resources, NewKafkaSettingsMapValueMust(map[string]attr.Value{
+				"compression_type":          types.Int64Value(int64(kafka.CompressionType_COMPRESSION_TYPE_ZSTD)),
+				"log_retention_ms":          types.Int64Value(3600000),
+				"auto_create_topics_enable": types.BoolValue(true),
+				"sasl_enabled_mechanisms": types.TupleValueMust(
+					[]attr.Type{types.Int64Type},
+					[]attr.Value{types.Int64Value(int64(kafka.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_512))},
+				),
+			})),
+			expectedVal: &kafka.ConfigSpec_Kafka{
+				Resources: expectedResources,
+				KafkaConfig: &kafka.ConfigSpec_Kafka_KafkaConfig_3{
+					KafkaConfig_3: &kafka.KafkaConfig3{
+						CompressionType:        kafka.CompressionType_COMPRESSION_TYPE_ZSTD,
+						LogRetentionMs:         wrapperspb.Int64(3600000),
+						AutoCreateTopicsEnable: wrapperspb.Bool(true),
+						SaslEnabledMechanisms:  []kafka.SaslMechanism{kafka.SaslMechanism_SASL_MECHANISM_SCRAM_SHA_512},
+					},
+				},
+			},
+		},
+		{
+			testname: "CheckKafkaConfig2_8",
+			version:  "2.8",
+			reqVal: buildTestKafkaObj(resources, NewKafkaSettingsMapValueMust(map[string]attr.Value{
+				"num_partitions": types.Int64Value(3),
+			})),
+			expectedVal: &kafka.ConfigSpec_Kafka{
+				Resources: expectedResources,
+				KafkaConfig: &kafka.ConfigSpec_Kafka_KafkaConfig_2_8{
+					KafkaConfig_2_8: &kafka.KafkaConfig2_8{
+						NumPartitions: wrapperspb.Int64(3),
+					},
+				},
+			},
+		},
+		{
+			testname:    "CheckWithoutKafkaConfig",
+			version:     "3.6",
+			reqVal:      buildTestKafkaObj(resources, NewKafkaSettingsMapNull()),
+			expectedVal: &kafka.ConfigSpec_Kafka{Resources: expectedResources},
+		},
+		{
+			testname:    "CheckNullKafka",
+			version:     "3.6",
+			reqVal:      types.ObjectNull(KafkaAttrTypes),
+			expectedVal: nil,
+		},
+		{
+			testname: "CheckUnknownSetting",
+			version:  "3.6",
+			reqVal: buildTestKafkaObj(resources, NewKafkaSettingsMapValueMust(map[string]attr.Value{
+				"unknown_setting": types.Int64Value(3),
+			})),
+			expectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		diags := diag.Diagnostics{}
+		res := expandKafka(ctx, c.version, c.reqVal, &diags)
+		if diags.HasError() != c.expectedError {
+			t.Errorf(
+				"Unexpected expansion diagnostics status %s test: expected %t, actual %t with errors: %v",
+				c.testname,
+				c.expectedError,
+				diags.HasError(),
+				diags.Errors(),
+			)
+			continue
+		}
+
+		if !c.expectedError && !proto.Equal(res, c.expectedVal) {
+			t.Errorf(
+				"Unexpected expansion result value %s test: expected %s, actual %s",
+				c.testname,
+				c.expectedVal,
+				res,
+			)
+		}
+	}
+}
+
+func TestYandexProvider_MDBKafkaClusterKafkaConfigFlatten(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	k := &kafka.ConfigSpec_Kafka{
+		KafkaConfig: &kafka.ConfigSpec_Kafka_KafkaConfig_3{
+			KafkaConfig_3: &kafka.KafkaConfig3{
+				CompressionType: kafka.CompressionType_COMPRESSION_TYPE_LZ4,
+				NumPartitions:   wrapperspb.Int64(5),
+			},
+		},
+	}
+
+	diags := diag.Diagnostics{}
+	res := flattenKafkaConfig(ctx, NewKafkaSettingsMapNull(), k, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected flatten diagnostics: %v", diags.Errors())
+	}
+
+	expected := NewKafkaSettingsMapValueMust(map[string]attr.Value{
+		"compression_type": types.Int64Value(int64(kafka.CompressionType_COMPRESSION_TYPE_LZ4)),
+		"num_partitions":   types.Int64Value(5),
+	})
+	if !res.Equal(expected) {
+		t.Errorf("Unexpected flatten result value: expected %s, actual %s", expected, res)
+	}
+
+	if null := flattenKafkaConfig(ctx, NewKafkaSettingsMapNull(), &kafka.ConfigSpec_Kafka{}, &diags); !null.IsNull() {
+		t.Errorf("Unexpected flatten result value for empty config: expected null, actual %s", null)
+	}
+}
+
+func TestYandexProvider_MDBKafkaClusterKafkaConfigFlattenDrift(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	k := &kafka.ConfigSpec_Kafka{
+		KafkaConfig: &kafka.ConfigSpec_Kafka_KafkaConfig_3{
+			KafkaConfig_3: &kafka.KafkaConfig3{
+				CompressionType:          kafka.CompressionType_COMPRESSION_TYPE_LZ4,
+				NumPartitions:            wrapperspb.Int64(7),
+				DefaultReplicationFactor: wrapperspb.Int64(3),
+			},
+		},
+	}
+
+	state := NewKafkaSettingsMapValueMust(map[string]attr.Value{
+		"compression_type": types.Int64Value(int64(kafka.CompressionType_COMPRESSION_TYPE_LZ4)),
+		"num_partitions":   types.Int64Value(5),
+		"log_retention_ms": types.Int64Value(1000),
+	})
+
+	diags := diag.Diagnostics{}
+	res := flattenKafkaConfig(ctx, state, k, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected flatten diagnostics: %v", diags.Errors())
+	}
+
+	// the changed value comes from the API, the removed one is gone, the default one isn't added
+	expected := NewKafkaSettingsMapValueMust(map[string]attr.Value{
+		"compression_type": types.Int64Value(int64(kafka.CompressionType_COMPRESSION_TYPE_LZ4)),
+		"num_partitions":   types.Int64Value(7),
+	})
+	if !res.Equal(expected) {
+		t.Errorf("Unexpected flatten result value: expected %s, actual %s", expected, res)
+	}
+}
+
+func TestYandexProvider_MDBKafkaClusterMoveStateClusterId(t *testing.T) {
+	t.Parallel()
+
+	cases := []struct {
+		testname      string
+		rawState      string
+		expectedVal   string
+		expectedError bool
+	}{
+		{
+			testname:    "CheckLegacyState",
+			rawState:    `{"id":"cid","name":"kafka","config":[{"version":"3.6"}],"topic":[]}`,
+			expectedVal: "cid",
+		},
+		{
+			testname:      "CheckStateWithoutId",
+			rawState:      `{"name":"kafka"}`,
+			expectedError: true,
+		},
+		{
+			testname:      "CheckBrokenState",
+			rawState:      `{`,
+			expectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		cid, diags := clusterIdFromRawState([]byte(c.rawState))
+		if diags.HasError() != c.expectedError {
+			t.Errorf(
+				"Unexpected diagnostics status %s test: expected %t, actual %t with errors: %v",
+				c.testname,
+				c.expectedError,
+				diags.HasError(),
+				diags.Errors(),
+			)
+			continue
+		}
+
+		if cid != c.expectedVal {
+			t.Errorf("Unexpected cluster id %s test: expected %q, actual %q", c.testname, c.expectedVal, cid)
+		}
+	}
+}
@@ -0,0 +1,205 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	protobuf_adapter "github.com/yandex-cloud/terraform-provider-yandex/pkg/adapters/protobuf"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/timestamp"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func flattenStringList(ctx context.Context, ss []string, diags *diag.Diagnostics) types.List {
+	if ss == nil {
+		ss = []string{}
+	}
+	l, d := types.ListValueFrom(ctx, types.StringType, ss)
+	diags.Append(d...)
+	return l
+}
+
+func flattenBrokersCount(b *wrapperspb.Int64Value) types.Int64 {
+	if b == nil {
+		return types.Int64Null()
+	}
+	return types.Int64Value(b.GetValue())
+}
+
+func flattenKafka(ctx context.Context, state types.Object, k *kafka.ConfigSpec_Kafka, diags *diag.Diagnostics) types.Object {
+	if k == nil {
+		return types.ObjectNull(KafkaAttrTypes)
+	}
+
+	var stateKafka Kafka
+	if !state.IsNull() && !state.IsUnknown() {
+		diags.Append(state.As(ctx, &stateKafka, datasize.UnhandledOpts)...)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, KafkaAttrTypes, Kafka{
+		Resources:   mdbcommon.FlattenResources(ctx, k.Resources, diags),
+		KafkaConfig: flattenKafkaConfig(ctx, stateKafka.KafkaConfig, k, diags),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+// flattenKafkaConfig returns the settings of the API for the keys known in the state, so out-of-band changes are
+// detected while the defaults returned by the API don't produce an endless diff. Values equal to the state ones
+// keep their state notation. All the non-zero settings are returned if the state is unknown, e.g. on import.
+func flattenKafkaConfig(ctx context.Context, state mdbcommon.SettingsMapValue, k *kafka.ConfigSpec_Kafka, diags *diag.Diagnostics) mdbcommon.SettingsMapValue {
+	var src any
+	switch {
+	case k.GetKafkaConfig_3() != nil:
+		src = k.GetKafkaConfig_3()
+	case k.GetKafkaConfig_2_8() != nil:
+		src = k.GetKafkaConfig_2_8()
+	default:
+		return NewKafkaSettingsMapNull()
+	}
+
+	a := protobuf_adapter.NewProtobufMapDataAdapter()
+	attrs := a.Extract(ctx, src, diags)
+	if diags.HasError() {
+		return NewKafkaSettingsMapNull()
+	}
+
+	if state.IsNull() || state.IsUnknown() {
+		attrsPresent := make(map[string]attr.Value)
+		for attr, val := range attrs {
+			if ok := mdbcommon.IsAttrZeroValue(val, diags); !ok {
+				attrsPresent[attr] = val
+			}
+
+			if diags.HasError() {
+				diags.AddError("Flatten Kafka Config Error", fmt.Sprintf("Can't check zero attribute %s", attr))
+			}
+		}
+
+		mv, d := NewKafkaSettingsMapValue(attrsPresent)
+		diags.Append(d...)
+		return mv
+	}
+
+	stateAttrs := state.PrimitiveElements(ctx, diags)
+	apiAttrs := make(map[string]attr.Value)
+	for attr := range stateAttrs {
+		if val, ok := attrs[attr]; ok && !val.IsNull() {
+			apiAttrs[attr] = val
+		}
+	}
+
+	apiSettings, d := NewKafkaSettingsMapValue(apiAttrs)
+	diags.Append(d...)
+	if diags.HasError() {
+		return NewKafkaSettingsMapNull()
+	}
+
+	stateElements := state.Elements()
+	elements := make(map[string]attr.Value, len(apiAttrs))
+	for attr, val := range apiSettings.Elements() {
+		if val.Equal(stateElements[attr]) || apiAttrs[attr].Equal(stateAttrs[attr]) {
+			elements[attr] = stateElements[attr]
+			continue
+		}
+		elements[attr] = val
+	}
+
+	mv, d := types.MapValue(types.StringType, elements)
+	diags.Append(d...)
+	settings, d := NewKafkaSettingsMapType().ValueFromMap(ctx, mv)
+	diags.Append(d...)
+	return settings.(mdbcommon.SettingsMapValue)
+}
+
+func flattenController(ctx context.Context, r *kafka.Resources, diags *diag.Diagnostics) types.Object {
+	if r == nil {
+		return types.ObjectNull(ControllerAttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, ControllerAttrTypes, Controller{
+		Resources: mdbcommon.FlattenResources(ctx, r, diags),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func flattenAccess(ctx context.Context, a *kafka.Access, diags *diag.Diagnostics) types.Object {
+	if a == nil {
+		return types.ObjectNull(AccessAttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, AccessAttrTypes, Access{
+		DataTransfer: types.BoolValue(a.DataTransfer),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func flattenToggle(ctx context.Context, enabled bool, diags *diag.Diagnostics) types.Object {
+	obj, d := types.ObjectValueFrom(ctx, ToggleAttrTypes, Toggle{
+		Enabled: types.BoolValue(enabled),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func flattenDiskSizeAutoscaling(ctx context.Context, dsa *kafka.DiskSizeAutoscaling, diags *diag.Diagnostics) types.Object {
+	if dsa == nil {
+		return types.ObjectNull(DiskSizeAutoscalingAttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, DiskSizeAutoscalingAttrTypes, DiskSizeAutoscaling{
+		DiskSizeLimit:           types.Int64Value(datasize.ToGigabytes(dsa.DiskSizeLimit)),
+		PlannedUsageThreshold:   types.Int64Value(dsa.PlannedUsageThreshold),
+		EmergencyUsageThreshold: types.Int64Value(dsa.EmergencyUsageThreshold),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func flattenCluster(ctx context.Context, state *Cluster, cluster *kafka.Cluster, diags *diag.Diagnostics) {
+	state.Id = types.StringValue(cluster.Id)
+	state.FolderId = types.StringValue(cluster.FolderId)
+	state.NetworkId = types.StringValue(cluster.NetworkId)
+	state.Name = types.StringValue(cluster.Name)
+	state.Description = types.StringValue(cluster.Description)
+	state.Environment = types.StringValue(cluster.Environment.String())
+	state.Labels = mdbcommon.FlattenMapString(ctx, cluster.Labels, diags)
+	state.DeletionProtection = types.BoolValue(cluster.GetDeletionProtection())
+	state.MaintenanceWindow = mdbcommon.FlattenMaintenanceWindow[
+		kafka.MaintenanceWindow,
+		kafka.WeeklyMaintenanceWindow,
+		kafka.AnytimeMaintenanceWindow,
+		kafka.WeeklyMaintenanceWindow_WeekDay,
+	](ctx, cluster.MaintenanceWindow, diags)
+	state.SecurityGroupIds = mdbcommon.FlattenSetString(ctx, cluster.SecurityGroupIds, diags)
+	state.HostGroupIds = mdbcommon.FlattenSetString(ctx, cluster.HostGroupIds, diags)
+	state.CreatedAt = types.StringValue(timestamp.Get(cluster.GetCreatedAt()))
+	state.Health = types.StringValue(cluster.Health.String())
+	state.Status = types.StringValue(cluster.Status.String())
+
+	cfg := cluster.GetConfig()
+	if cfg == nil {
+		diags.AddError("Failed to flatten config.", "Config of cluster can't be nil. It's error in provider")
+		return
+	}
+
+	state.Version = types.StringValue(cfg.Version)
+	state.Zones = flattenStringList(ctx, cfg.ZoneId, diags)
+	state.BrokersCount = flattenBrokersCount(cfg.BrokersCount)
+	state.AssignPublicIp = types.BoolValue(cfg.AssignPublicIp)
+	state.SchemaRegistry = types.BoolValue(cfg.SchemaRegistry)
+	state.Kafka = flattenKafka(ctx, state.Kafka, cfg.Kafka, diags)
+	state.Zookeeper = flattenController(ctx, cfg.GetZookeeper().GetResources(), diags)
+	state.Kraft = flattenController(ctx, cfg.GetKraft().GetResources(), diags)
+	state.Access = flattenAccess(ctx, cfg.Access, diags)
+	state.RestApi = flattenToggle(ctx, cfg.GetRestApiConfig().GetEnabled(), diags)
+	state.KafkaUI = flattenToggle(ctx, cfg.GetKafkaUiConfig().GetEnabled(), diags)
+	state.DiskSizeAutoscaling = flattenDiskSizeAutoscaling(ctx, cfg.DiskSizeAutoscaling, diags)
+}
@@ -0,0 +1,21 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+)
+
+func convertHostFromProto(apiHost *kafka.Host) Host {
+	return Host{
+		FQDN:           types.StringValue(apiHost.Name),
+		Zone:           types.StringValue(apiHost.ZoneId),
+		Role:           types.StringValue(apiHost.Role.String()),
+		Health:         types.StringValue(apiHost.Health.String()),
+		SubnetId:       types.StringValue(apiHost.SubnetId),
+		AssignPublicIp: types.BoolValue(apiHost.AssignPublicIp),
+	}
+}
+
+func (h Host) GetFQDN() types.String {
+	return h.FQDN
+}
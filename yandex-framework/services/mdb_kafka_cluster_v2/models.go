@@ -0,0 +1,105 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+type Cluster struct {
+	Id                  types.String `tfsdk:"id"`
+	FolderId            types.String `tfsdk:"folder_id"`
+	NetworkId           types.String `tfsdk:"network_id"`
+	Name                types.String `tfsdk:"name"`
+	Description         types.String `tfsdk:"description"`
+	Environment         types.String `tfsdk:"environment"`
+	Labels              types.Map    `tfsdk:"labels"`
+	SubnetIds           types.List   `tfsdk:"subnet_ids"`
+	SecurityGroupIds    types.Set    `tfsdk:"security_group_ids"`
+	HostGroupIds        types.Set    `tfsdk:"host_group_ids"`
+	DeletionProtection  types.Bool   `tfsdk:"deletion_protection"`
+	MaintenanceWindow   types.Object `tfsdk:"maintenance_window"`
+	Version             types.String `tfsdk:"version"`
+	Zones               types.List   `tfsdk:"zones"`
+	BrokersCount        types.Int64  `tfsdk:"brokers_count"`
+	AssignPublicIp      types.Bool   `tfsdk:"assign_public_ip"`
+	SchemaRegistry      types.Bool   `tfsdk:"schema_registry"`
+	Kafka               types.Object `tfsdk:"kafka"`
+	Zookeeper           types.Object `tfsdk:"zookeeper"`
+	Kraft               types.Object `tfsdk:"kraft"`
+	DiskSizeAutoscaling types.Object `tfsdk:"disk_size_autoscaling"`
+	Access              types.Object `tfsdk:"access"`
+	RestApi             types.Object `tfsdk:"rest_api"`
+	KafkaUI             types.Object `tfsdk:"kafka_ui"`
+	Hosts               types.Map    `tfsdk:"hosts"`
+	CreatedAt           types.String `tfsdk:"created_at"`
+	Health              types.String `tfsdk:"health"`
+	Status              types.String `tfsdk:"status"`
+}
+
+type Kafka struct {
+	Resources   types.Object               `tfsdk:"resources"`
+	KafkaConfig mdbcommon.SettingsMapValue `tfsdk:"kafka_config"`
+}
+
+var KafkaAttrTypes = map[string]attr.Type{
+	"resources":    mdbcommon.ResourceType,
+	"kafka_config": NewKafkaSettingsMapType(),
+}
+
+// Controller describes both ZooKeeper and KRaft subclusters, they differ only by role.
+type Controller struct {
+	Resources types.Object `tfsdk:"resources"`
+}
+
+var ControllerAttrTypes = map[string]attr.Type{
+	"resources": mdbcommon.ResourceType,
+}
+
+type DiskSizeAutoscaling struct {
+	DiskSizeLimit           types.Int64 `tfsdk:"disk_size_limit"`
+	PlannedUsageThreshold   types.Int64 `tfsdk:"planned_usage_threshold"`
+	EmergencyUsageThreshold types.Int64 `tfsdk:"emergency_usage_threshold"`
+}
+
+var DiskSizeAutoscalingAttrTypes = map[string]attr.Type{
+	"disk_size_limit":           types.Int64Type,
+	"planned_usage_threshold":   types.Int64Type,
+	"emergency_usage_threshold": types.Int64Type,
+}
+
+type Access struct {
+	DataTransfer types.Bool `tfsdk:"data_transfer"`
+}
+
+var AccessAttrTypes = map[string]attr.Type{
+	"data_transfer": types.BoolType,
+}
+
+type Toggle struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+var ToggleAttrTypes = map[string]attr.Type{
+	"enabled": types.BoolType,
+}
+
+type Host struct {
+	FQDN           types.String `tfsdk:"fqdn"`
+	Zone           types.String `tfsdk:"zone"`
+	Role           types.String `tfsdk:"role"`
+	Health         types.String `tfsdk:"health"`
+	SubnetId       types.String `tfsdk:"subnet_id"`
+	AssignPublicIp types.Bool   `tfsdk:"assign_public_ip"`
+}
+
+var hostType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"fqdn":             types.StringType,
+		"zone":             types.StringType,
+		"role":             types.StringType,
+		"health":           types.StringType,
+		"subnet_id":        types.StringType,
+		"assign_public_ip": types.BoolType,
+	},
+}
@@ -0,0 +1,609 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"encoding/json"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/listvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/objectvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	utils "github.com/yandex-cloud/terraform-provider-yandex/pkg/wrappers"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+// legacyResourceTypeName is the SDKv2 resource which state can be moved to the v2 resource with a `moved` block.
+const legacyResourceTypeName = "yandex_mdb_kafka_cluster"
+
+type clusterResource struct {
+	providerConfig *provider_config.Config
+}
+
+var (
+	_ resource.ResourceWithConfigure   = &clusterResource{}
+	_ resource.ResourceWithImportState = &clusterResource{}
+	_ resource.ResourceWithMoveState   = &clusterResource{}
+	_ resource.ResourceWithModifyPlan  = &clusterResource{}
+)
+
+func NewKafkaClusterResourceV2() resource.Resource {
+	return &clusterResource{}
+}
+
+func (r *clusterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_kafka_cluster_v2"
+}
+
+func (r *clusterResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func resourcesSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Required:    true,
+		Attributes: map[string]schema.Attribute{
+			"resource_preset_id": schema.StringAttribute{
+				Description: "The ID of the preset for computational resources available to a host (CPU, memory etc.). For more information, see [the official documentation](https://yandex.cloud/docs/managed-kafka/concepts/instance-types).",
+				Required:    true,
+			},
+			"disk_type_id": schema.StringAttribute{
+				Description: "Type of the storage of hosts. For more information see [the official documentation](https://yandex.cloud/docs/managed-kafka/concepts/storage).",
+				Required:    true,
+			},
+			"disk_size": schema.Int64Attribute{
+				Description: "Volume of the storage available to a host, in gigabytes.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func controllerSchema(description string, conflictsWith string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Validators: []validator.Object{
+			objectvalidator.ConflictsWith(path.MatchRoot(conflictsWith)),
+		},
+		Attributes: map[string]schema.Attribute{
+			"resources": resourcesSchema("Resources allocated to hosts of the subcluster."),
+		},
+	}
+}
+
+func toggleSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"enabled": schema.BoolAttribute{
+				Description: "Enables the feature. Can be either `true` or `false`.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a Kafka cluster within the Yandex Cloud. For more information, see [the official documentation](https://yandex.cloud/docs/managed-kafka/concepts).\n\n" +
+			"Unlike `yandex_mdb_kafka_cluster`, the resource has no inline topics and users: use `yandex_mdb_kafka_topic`, `yandex_mdb_kafka_user` and `yandex_mdb_kafka_connector` to manage them. " +
+			"The state of `yandex_mdb_kafka_cluster` can be moved to the resource with a `moved` block.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: common.ResourceDescriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the Kafka cluster. Provided by the client when the cluster is created.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the Kafka cluster.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"folder_id": schema.StringAttribute{
+				Description: common.ResourceDescriptions["folder_id"],
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Description: common.ResourceDescriptions["network_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment": schema.StringAttribute{
+				Description: "Deployment environment of the Kafka cluster. Can be either `PRESTABLE` or `PRODUCTION`. The default is `PRODUCTION`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(kafka.Cluster_PRODUCTION.String()),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(kafka.Cluster_PRODUCTION.String(), kafka.Cluster_PRESTABLE.String()),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: common.ResourceDescriptions["labels"],
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"subnet_ids": schema.ListAttribute{
+				Description: common.ResourceDescriptions["subnet_ids"],
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"security_group_ids": schema.SetAttribute{
+				Description: common.ResourceDescriptions["security_group_ids"],
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"host_group_ids": schema.SetAttribute{
+				Description: "A list of IDs of the host groups to place VMs of the cluster on.",
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+					setplanmodifier.RequiresReplace(),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: common.ResourceDescriptions["deletion_protection"],
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			// Optional nested attribute maintenance_window required all optional nested attributes
+			// But if the block is specified explicitly, then the type attribute is required
+			"maintenance_window": schema.SingleNestedAttribute{
+				Description: "Maintenance policy of the Kafka cluster.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Object{
+					NewMaintenanceWindowStructValidator(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "Type of maintenance window. Can be either ANYTIME or WEEKLY. A day and hour of window need to be specified with weekly window.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("ANYTIME", "WEEKLY"),
+						},
+					},
+					"day": schema.StringAttribute{
+						Description: "Day of the week (in DDD format). Allowed values: \"MON\", \"TUE\", \"WED\", \"THU\", \"FRI\", \"SAT\",\"SUN\"",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								"MON", "TUE",
+								"WED", "THU",
+								"FRI", "SAT",
+								"SUN",
+							),
+						},
+					},
+					"hour": schema.Int64Attribute{
+						Description: "Hour of the day in UTC (in HH format). Allowed value is between 1 and 24.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 24),
+						},
+					},
+				},
+			},
+			"version": schema.StringAttribute{
+				Description: "Version of the Kafka server software. Versions are upgraded one by one, e.g. `3.5` is upgraded to `3.7` through `3.6`.",
+				Required:    true,
+			},
+			"zones": schema.ListAttribute{
+				Description: "List of availability zones.",
+				Required:    true,
+				ElementType: types.StringType,
+				Validators: []validator.List{
+					listvalidator.SizeAtLeast(1),
+				},
+			},
+			"brokers_count": schema.Int64Attribute{
+				Description: "Count of brokers per availability zone. The default is `1`.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Int64{
+					int64validator.AtLeast(1),
+				},
+			},
+			"assign_public_ip": schema.BoolAttribute{
+				Description: "Determines whether each broker will be assigned a public IP address. The default is `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"schema_registry": schema.BoolAttribute{
+				Description: "Enables managed schema registry on cluster. The default is `false`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     booldefault.StaticBool(false),
+			},
+			"kafka": schema.SingleNestedAttribute{
+				Description: "Configuration of the Kafka subcluster.",
+				Required:    true,
+				Attributes: map[string]schema.Attribute{
+					"resources": resourcesSchema("Resources allocated to hosts of the Kafka subcluster."),
+					"kafka_config": schema.MapAttribute{
+						CustomType:  NewKafkaSettingsMapType(),
+						Description: "User-defined settings for the Kafka cluster. For more information, see [the official documentation](https://yandex.cloud/docs/managed-kafka/operations/cluster-update#change-kafka-settings) and [the Kafka documentation](https://kafka.apache.org/documentation/#configuration).",
+						Optional:    true,
+						Computed:    true,
+						PlanModifiers: []planmodifier.Map{
+							mapplanmodifier.UseStateForUnknown(),
+						},
+					},
+				},
+			},
+			"zookeeper": controllerSchema("Configuration of the ZooKeeper subcluster.", "kraft"),
+			"kraft":     controllerSchema("Configuration of the KRaft-controller subcluster.", "zookeeper"),
+			"access": schema.SingleNestedAttribute{
+				Description: "Access policy to the Kafka cluster.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"data_transfer": schema.BoolAttribute{
+						Description: "Allow access for DataTransfer.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+				},
+			},
+			"rest_api": toggleSchema("REST API settings of the Kafka cluster."),
+			"kafka_ui": toggleSchema("Kafka UI settings of the Kafka cluster."),
+			"disk_size_autoscaling": schema.SingleNestedAttribute{
+				Description: "Disk autoscaling settings of the Kafka cluster.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"disk_size_limit": schema.Int64Attribute{
+						Description: "Maximum possible size of disk in gigabytes.",
+						Required:    true,
+					},
+					"planned_usage_threshold": schema.Int64Attribute{
+						Description: "Percent of disk utilization. During maintenance disk will autoscale, if this threshold reached. Value is between 0 and 100. Default value is 0 (autoscaling disabled). Must be not greater than 'emergency_usage_threshold' value.",
+						Optional:    true,
+						Computed:    true,
+						Validators: []validator.Int64{
+							int64validator.Between(0, 100),
+						},
+					},
+					"emergency_usage_threshold": schema.Int64Attribute{
+						Description: "Percent of disk utilization. Disk will autoscale immediately, if this threshold reached. Value is between 0 and 100. Default value is 0 (autoscaling disabled). Must be not less then 'planned_usage_threshold' value.",
+						Optional:    true,
+						Computed:    true,
+						Validators: []validator.Int64{
+							int64validator.Between(0, 100),
+						},
+					},
+				},
+			},
+			"hosts": schema.MapNestedAttribute{
+				Description: "Hosts of the Kafka cluster keyed by FQDN. Hosts are derived from `zones` and `brokers_count`.",
+				Computed:    true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"fqdn": schema.StringAttribute{
+							Description: "The fully qualified domain name of the host.",
+							Computed:    true,
+						},
+						"zone": schema.StringAttribute{
+							Description: "The availability zone where the host is located.",
+							Computed:    true,
+						},
+						"role": schema.StringAttribute{
+							Description: "Role of the host in the cluster.",
+							Computed:    true,
+						},
+						"health": schema.StringAttribute{
+							Description: "Health of the host.",
+							Computed:    true,
+						},
+						"subnet_id": schema.StringAttribute{
+							Description: "ID of the subnet where the host is located.",
+							Computed:    true,
+						},
+						"assign_public_ip": schema.BoolAttribute{
+							Description: "Whether the host has a public IP address.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"created_at": schema.StringAttribute{
+				Description: common.ResourceDescriptions["created_at"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"health": schema.StringAttribute{
+				Description: "Aggregated health of the cluster. For more information see `health` field of JSON representation in [the official documentation](https://yandex.cloud/docs/managed-kafka/api-ref/Cluster/).",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the cluster. For more information see `status` field of JSON representation in [the official documentation](https://yandex.cloud/docs/managed-kafka/api-ref/Cluster/).",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Cluster
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refreshResourceState(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(d...)
+}
+
+func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan Cluster
+	var state Cluster
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kafkaConfig := NewKafkaSettingsMapNull()
+	if utils.IsPresent(plan.Kafka) {
+		var kf Kafka
+		resp.Diagnostics.Append(plan.Kafka.As(ctx, &kf, datasize.DefaultOpts)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		kafkaConfig = kf.KafkaConfig
+	}
+
+	kafkaUpgradePlanner.ValidateUpgrade(
+		state.Version, plan.Version, path.Root("version"),
+		kafkaConfig, path.Root("kafka").AtName("kafka_config"),
+		&resp.Diagnostics,
+	)
+}
+
+func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Cluster
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating Kafka Cluster")
+
+	request, diags := prepareCreateRequest(ctx, &plan, &r.providerConfig.ProviderState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := kafkaApi.CreateCluster(ctx, r.providerConfig.SDK, &resp.Diagnostics, request)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(cid)
+
+	r.refreshResourceState(ctx, &plan, &resp.Diagnostics)
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Cluster
+	var state Cluster
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating Kafka Cluster", map[string]interface{}{"id": plan.Id.ValueString()})
+
+	updateVersionRequest, d := prepareVersionUpdateRequest(&state, &plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if updateVersionRequest != nil {
+		kafkaUpgradePlanner.Upgrade(ctx, state.Version.ValueString(), updateVersionRequest.ConfigSpec.Version, func(version string, diags *diag.Diagnostics) {
+			updateVersionRequest.ConfigSpec.Version = version
+			kafkaApi.UpdateCluster(ctx, r.providerConfig.SDK, diags, updateVersionRequest)
+		}, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	updateRequest, d := prepareUpdateRequest(ctx, &state, &plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kafkaApi.UpdateCluster(ctx, r.providerConfig.SDK, &resp.Diagnostics, updateRequest)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refreshResourceState(ctx, &plan, &resp.Diagnostics)
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Cluster
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	kafkaApi.DeleteCluster(ctx, r.providerConfig.SDK, &resp.Diagnostics, state.Id.ValueString())
+}
+
+func (r *clusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+// MoveState allows to migrate from yandex_mdb_kafka_cluster with a `moved` block.
+// Only the cluster ID is taken from the source state, all other attributes are refreshed from the API.
+func (r *clusterResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != legacyResourceTypeName {
+					return
+				}
+
+				cid, diags := clusterIdFromRawState(req.SourceRawState.JSON)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), cid)...)
+			},
+		},
+	}
+}
+
+func clusterIdFromRawState(rawState []byte) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var src struct {
+		Id string `json:"id"`
+	}
+	if err := json.Unmarshal(rawState, &src); err != nil {
+		diags.AddError(
+			"Failed to move resource state",
+			fmt.Sprintf("Error while unmarshaling %s state: %s", legacyResourceTypeName, err.Error()),
+		)
+		return "", diags
+	}
+
+	if src.Id == "" {
+		diags.AddError(
+			"Failed to move resource state",
+			fmt.Sprintf("Source %s state has no cluster id", legacyResourceTypeName),
+		)
+	}
+	return src.Id, diags
+}
+
+func (r *clusterResource) refreshResourceState(ctx context.Context, state *Cluster, respDiagnostics *diag.Diagnostics) {
+	cid := state.Id.ValueString()
+	cluster := kafkaApi.GetCluster(ctx, r.providerConfig.SDK, respDiagnostics, cid)
+	if respDiagnostics.HasError() {
+		return
+	}
+
+	hosts := mdbcommon.ReadComputedHosts(ctx, r.providerConfig.SDK, respDiagnostics, convertHostFromProto, &kafkaApi, cid)
+	if respDiagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+	state.Hosts, diags = types.MapValueFrom(ctx, hostType, hosts)
+	respDiagnostics.Append(diags...)
+	if respDiagnostics.HasError() {
+		return
+	}
+
+	flattenCluster(ctx, state, cluster, respDiagnostics)
+}
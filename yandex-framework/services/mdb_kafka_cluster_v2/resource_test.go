@@ -0,0 +1,291 @@
+package mdb_kafka_cluster_v2_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/hashicorp/terraform-plugin-testing/tfversion"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+const (
+	defaultMDBPageSize                 = 1000
+	kfResource                         = "yandex_mdb_kafka_cluster_v2.foo"
+	yandexMDBKafkaClusterDeleteTimeout = 60 * time.Minute
+)
+
+const kfVPCDependencies = `
+resource "yandex_vpc_network" "mdb-kafka-test-net" {}
+
+resource "yandex_vpc_subnet" "mdb-kafka-test-subnet-a" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.mdb-kafka-test-net.id
+  v4_cidr_blocks = ["10.1.0.0/24"]
+}
+`
+
+func init() {
+	resource.AddTestSweepers("yandex_mdb_kafka_cluster_v2", &resource.Sweeper{
+		Name: "yandex_mdb_kafka_cluster_v2",
+		F:    testSweepMDBKafkaCluster,
+	})
+}
+
+// TestMain - add sweepers flag to the go test command
+// important for sweepers run.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func testSweepMDBKafkaCluster(_ string) error {
+	conf, err := test.ConfigForSweepers()
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	resp, err := conf.SDK.MDB().Kafka().Cluster().List(context.Background(), &kafka.ListClustersRequest{
+		FolderId: conf.ProviderState.FolderID.ValueString(),
+		PageSize: defaultMDBPageSize,
+	})
+	if err != nil {
+		return fmt.Errorf("error getting Kafka clusters: %s", err)
+	}
+
+	result := &multierror.Error{}
+	for _, c := range resp.Clusters {
+		if !sweepMDBKafkaCluster(conf, c.Id) {
+			result = multierror.Append(result, fmt.Errorf("failed to sweep Kafka cluster %q", c.Id))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func sweepMDBKafkaCluster(conf *config.Config, id string) bool {
+	return test.SweepWithRetry(sweepMDBKafkaClusterOnce, conf, "Kafka cluster", id)
+}
+
+func sweepMDBKafkaClusterOnce(conf *config.Config, id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), yandexMDBKafkaClusterDeleteTimeout)
+	defer cancel()
+
+	mask := field_mask.FieldMask{Paths: []string{"deletion_protection"}}
+
+	op, err := conf.SDK.MDB().Kafka().Cluster().Update(ctx, &kafka.UpdateClusterRequest{
+		ClusterId:          id,
+		DeletionProtection: false,
+		UpdateMask:         &mask,
+	})
+	err = test.HandleSweepOperation(ctx, conf, op, err)
+	if err != nil && !strings.EqualFold(test.ErrorMessage(err), "no changes detected") {
+		return err
+	}
+
+	op, err = conf.SDK.MDB().Kafka().Cluster().Delete(ctx, &kafka.DeleteClusterRequest{
+		ClusterId: id,
+	})
+	return test.HandleSweepOperation(ctx, conf, op, err)
+}
+
+func mdbKafkaClusterImportStep(name string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      name,
+		ImportState:       true,
+		ImportStateVerify: true,
+		ImportStateVerifyIgnore: []string{
+			"health",     // volatile value
+			"hosts",      // volatile value
+			"subnet_ids", // not returned by the API
+		},
+	}
+}
+
+// Test that a Kafka Cluster can be created, updated and destroyed
+func TestAccMDBKafkaCluster_basic(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-kafka-cluster-basic")
+	folderID := test.GetExampleFolderID()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBKafkaClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBKafkaClusterBasic(clusterName, "Kafka Cluster Terraform Test", 10, `log_retention_ms = 3600000`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(kfResource, tfjsonpath.New("name"), knownvalue.StringExact(clusterName)),
+					statecheck.ExpectKnownValue(kfResource, tfjsonpath.New("folder_id"), knownvalue.StringExact(folderID)),
+					statecheck.ExpectKnownValue(kfResource, tfjsonpath.New("environment"), knownvalue.StringExact("PRESTABLE")),
+					statecheck.ExpectKnownValue(kfResource, tfjsonpath.New("kafka").AtMapKey("resources").AtMapKey("disk_size"), knownvalue.Int64Exact(10)),
+					statecheck.ExpectKnownValue(kfResource, tfjsonpath.New("kafka").AtMapKey("kafka_config"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"log_retention_ms": knownvalue.StringExact("3600000"),
+					})),
+					statecheck.ExpectKnownValue(kfResource, tfjsonpath.New("hosts"), knownvalue.MapSizeExact(1)),
+				},
+			},
+			mdbKafkaClusterImportStep(kfResource),
+			{
+				Config: testAccMDBKafkaClusterBasic(clusterName, "Kafka Cluster Terraform Test Updated", 12, `log_retention_ms = 7200000`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(kfResource, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(kfResource, tfjsonpath.New("description"), knownvalue.StringExact("Kafka Cluster Terraform Test Updated")),
+					statecheck.ExpectKnownValue(kfResource, tfjsonpath.New("kafka").AtMapKey("resources").AtMapKey("disk_size"), knownvalue.Int64Exact(12)),
+					statecheck.ExpectKnownValue(kfResource, tfjsonpath.New("kafka").AtMapKey("kafka_config"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"log_retention_ms": knownvalue.StringExact("7200000"),
+					})),
+				},
+			},
+			mdbKafkaClusterImportStep(kfResource),
+		},
+	})
+}
+
+// Test that the state of yandex_mdb_kafka_cluster can be moved to yandex_mdb_kafka_cluster_v2 without recreation
+func TestAccMDBKafkaCluster_moved(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-kafka-cluster-moved")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBKafkaClusterDestroy,
+		TerraformVersionChecks: []tfversion.TerraformVersionCheck{
+			tfversion.SkipBelow(tfversion.Version1_8_0),
+		},
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBKafkaClusterLegacy(clusterName),
+			},
+			{
+				Config: testAccMDBKafkaClusterMoved(clusterName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(kfResource, plancheck.ResourceActionNoop),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(kfResource, tfjsonpath.New("name"), knownvalue.StringExact(clusterName)),
+				},
+			},
+		},
+	})
+}
+
+func testAccCheckMDBKafkaClusterDestroy(s *terraform.State) error {
+	conf := test.AccProvider.(*provider.Provider).GetConfig()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_mdb_kafka_cluster_v2" {
+			continue
+		}
+
+		_, err := conf.SDK.MDB().Kafka().Cluster().Get(context.Background(), &kafka.GetClusterRequest{
+			ClusterId: rs.Primary.ID,
+		})
+
+		if err == nil {
+			return fmt.Errorf("Kafka Cluster still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccMDBKafkaClusterBasic(name, description string, diskSize int, kafkaConfig string) string {
+	return fmt.Sprintf(kfVPCDependencies+`
+resource "yandex_mdb_kafka_cluster_v2" "foo" {
+  name        = "%s"
+  description = "%s"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.mdb-kafka-test-net.id
+  subnet_ids  = [yandex_vpc_subnet.mdb-kafka-test-subnet-a.id]
+
+  version = "3.6"
+  zones   = ["ru-central1-a"]
+
+  kafka = {
+    resources = {
+      resource_preset_id = "s2.micro"
+      disk_type_id       = "network-hdd"
+      disk_size          = %d
+    }
+    kafka_config = {
+      %s
+    }
+  }
+}
+`, name, description, diskSize, kafkaConfig)
+}
+
+func testAccMDBKafkaClusterLegacy(name string) string {
+	return fmt.Sprintf(kfVPCDependencies+`
+resource "yandex_mdb_kafka_cluster" "foo" {
+  name        = "%s"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.mdb-kafka-test-net.id
+  subnet_ids  = [yandex_vpc_subnet.mdb-kafka-test-subnet-a.id]
+
+  config {
+    version = "3.6"
+    zones   = ["ru-central1-a"]
+
+    kafka {
+      resources {
+        resource_preset_id = "s2.micro"
+        disk_type_id       = "network-hdd"
+        disk_size          = 10
+      }
+    }
+  }
+}
+`, name)
+}
+
+func testAccMDBKafkaClusterMoved(name string) string {
+	return fmt.Sprintf(kfVPCDependencies+`
+moved {
+  from = yandex_mdb_kafka_cluster.foo
+  to   = yandex_mdb_kafka_cluster_v2.foo
+}
+
+resource "yandex_mdb_kafka_cluster_v2" "foo" {
+  name        = "%s"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.mdb-kafka-test-net.id
+  subnet_ids  = [yandex_vpc_subnet.mdb-kafka-test-subnet-a.id]
+
+  version = "3.6"
+  zones   = ["ru-central1-a"]
+
+  kafka = {
+    resources = {
+      resource_preset_id = "s2.micro"
+      disk_type_id       = "network-hdd"
+      disk_size          = 10
+    }
+  }
+}
+`, name)
+}
@@ -0,0 +1,246 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"fmt"
+	"maps"
+	"sort"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/kafka/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+// kafkaUpgradePlanner upgrades versions one by one, e.g. 3.5 is upgraded to 3.7 through 3.6.
+var kafkaUpgradePlanner = &mdbcommon.VersionUpgradePlanner{
+	Engine: "Kafka",
+	Rules: mdbcommon.SequentialUpgradeRules(
+		[]string{"2.8", "3.0", "3.1", "3.2", "3.3", "3.4", "3.5", "3.6", "3.7", "3.8", "3.9"},
+	),
+	ConfigSpec:  &kafka.ConfigSpec_Kafka{},
+	ConfigField: getKafkaConfigFieldName,
+}
+
+// Version is upgraded with a standalone request, the API doesn't allow to change it with other fields.
+func prepareVersionUpdateRequest(state, plan *Cluster) (*kafka.UpdateClusterRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	if plan.Version.Equal(state.Version) {
+		return nil, diags
+	}
+
+	return &kafka.UpdateClusterRequest{
+		ClusterId: state.Id.ValueString(),
+		ConfigSpec: &kafka.ConfigSpec{
+			Version: plan.Version.ValueString(),
+		},
+		UpdateMask: &field_mask.FieldMask{Paths: []string{"config_spec.version"}},
+	}, diags
+}
+
+func resourcesUpdatePaths(ctx context.Context, prefix string, plan, state types.Object, diags *diag.Diagnostics) []string {
+	var pr, sr mdbcommon.Resource
+	diags.Append(plan.As(ctx, &pr, datasize.UnhandledOpts)...)
+	diags.Append(state.As(ctx, &sr, datasize.UnhandledOpts)...)
+
+	var paths []string
+	if !pr.ResourcePresetId.Equal(sr.ResourcePresetId) {
+		paths = append(paths, prefix+".resources.resource_preset_id")
+	}
+	if !pr.DiskTypeId.Equal(sr.DiskTypeId) {
+		paths = append(paths, prefix+".resources.disk_type_id")
+	}
+	if !pr.DiskSize.Equal(sr.DiskSize) {
+		paths = append(paths, prefix+".resources.disk_size")
+	}
+	return paths
+}
+
+func controllerResources(ctx context.Context, c types.Object, diags *diag.Diagnostics) types.Object {
+	var ctrl Controller
+	diags.Append(c.As(ctx, &ctrl, datasize.UnhandledOpts)...)
+	if ctrl.Resources.IsNull() {
+		return types.ObjectNull(mdbcommon.ResourceType.AttrTypes)
+	}
+	return ctrl.Resources
+}
+
+func prepareUpdateRequest(ctx context.Context, state, plan *Cluster) (*kafka.UpdateClusterRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	request := &kafka.UpdateClusterRequest{
+		ClusterId:  state.Id.ValueString(),
+		UpdateMask: &field_mask.FieldMask{},
+	}
+
+	if !plan.Name.Equal(state.Name) {
+		request.SetName(plan.Name.ValueString())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "name")
+	}
+
+	if !plan.Description.Equal(state.Description) {
+		request.SetDescription(plan.Description.ValueString())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "description")
+	}
+
+	if !plan.Labels.Equal(state.Labels) {
+		request.SetLabels(mdbcommon.ExpandLabels(ctx, plan.Labels, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "labels")
+	}
+
+	if !plan.SubnetIds.Equal(state.SubnetIds) {
+		request.SetSubnetIds(expandStringList(ctx, plan.SubnetIds, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "subnet_ids")
+	}
+
+	config := &kafka.ConfigSpec{}
+	updConf := false
+
+	if !plan.Zones.Equal(state.Zones) {
+		updConf = true
+		config.SetZoneId(expandStringList(ctx, plan.Zones, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.zone_id")
+	}
+
+	if !plan.BrokersCount.Equal(state.BrokersCount) {
+		updConf = true
+		config.SetBrokersCount(expandBrokersCount(plan.BrokersCount))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.brokers_count")
+	}
+
+	if !plan.AssignPublicIp.Equal(state.AssignPublicIp) {
+		updConf = true
+		config.SetAssignPublicIp(plan.AssignPublicIp.ValueBool())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.assign_public_ip")
+	}
+
+	if !plan.SchemaRegistry.Equal(state.SchemaRegistry) {
+		updConf = true
+		config.SetSchemaRegistry(plan.SchemaRegistry.ValueBool())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.schema_registry")
+	}
+
+	if !plan.Kafka.Equal(state.Kafka) {
+		var pk, sk Kafka
+		diags.Append(plan.Kafka.As(ctx, &pk, datasize.UnhandledOpts)...)
+		diags.Append(state.Kafka.As(ctx, &sk, datasize.UnhandledOpts)...)
+
+		config.SetKafka(expandKafka(ctx, plan.Version.ValueString(), plan.Kafka, &diags))
+
+		if paths := resourcesUpdatePaths(ctx, "config_spec.kafka", pk.Resources, sk.Resources, &diags); len(paths) > 0 {
+			updConf = true
+			request.UpdateMask.Paths = append(request.UpdateMask.Paths, paths...)
+		}
+
+		if !pk.KafkaConfig.Equal(sk.KafkaConfig) {
+			updConf = true
+			if config.Kafka.GetKafkaConfig() == nil {
+				// All settings are removed, reset them to defaults with an empty config
+				config.Kafka.SetKafkaConfig(expandKafkaConfig(ctx, plan.Version.ValueString(), NewKafkaSettingsMapNull(), &diags))
+			}
+
+			attrsState := mdbcommon.GetAttrNamesSetFromMap(sk.KafkaConfig.MapValue, &diags)
+			attrsPlan := mdbcommon.GetAttrNamesSetFromMap(pk.KafkaConfig.MapValue, &diags)
+			maps.Copy(attrsPlan, attrsState)
+
+			attrs := make([]string, 0, len(attrsPlan))
+			for attr := range attrsPlan {
+				attrs = append(attrs, attr)
+			}
+			sort.Strings(attrs)
+			for _, attr := range attrs {
+				request.UpdateMask.Paths = append(
+					request.UpdateMask.Paths,
+					fmt.Sprintf("config_spec.kafka.%s.%s", getKafkaConfigFieldName(plan.Version.ValueString()), attr),
+				)
+			}
+		}
+	}
+
+	if !plan.Zookeeper.Equal(state.Zookeeper) {
+		if paths := resourcesUpdatePaths(
+			ctx, "config_spec.zookeeper",
+			controllerResources(ctx, plan.Zookeeper, &diags), controllerResources(ctx, state.Zookeeper, &diags), &diags,
+		); len(paths) > 0 {
+			updConf = true
+			config.SetZookeeper(expandZookeeper(ctx, plan.Zookeeper, &diags))
+			request.UpdateMask.Paths = append(request.UpdateMask.Paths, paths...)
+		}
+	}
+
+	if !plan.Kraft.Equal(state.Kraft) {
+		if paths := resourcesUpdatePaths(
+			ctx, "config_spec.kraft",
+			controllerResources(ctx, plan.Kraft, &diags), controllerResources(ctx, state.Kraft, &diags), &diags,
+		); len(paths) > 0 {
+			updConf = true
+			config.SetKraft(expandKraft(ctx, plan.Kraft, &diags))
+			request.UpdateMask.Paths = append(request.UpdateMask.Paths, paths...)
+		}
+	}
+
+	if !plan.Access.Equal(state.Access) {
+		updConf = true
+		config.SetAccess(expandAccess(ctx, plan.Access, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.access")
+	}
+
+	if !plan.RestApi.Equal(state.RestApi) {
+		updConf = true
+		config.SetRestApiConfig(expandRestAPI(ctx, plan.RestApi, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.rest_api_config.enabled")
+	}
+
+	if !plan.KafkaUI.Equal(state.KafkaUI) {
+		updConf = true
+		config.SetKafkaUiConfig(expandKafkaUI(ctx, plan.KafkaUI, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.kafka_ui_config.enabled")
+	}
+
+	if !plan.DiskSizeAutoscaling.Equal(state.DiskSizeAutoscaling) {
+		updConf = true
+		config.SetDiskSizeAutoscaling(expandDiskSizeAutoscaling(ctx, plan.DiskSizeAutoscaling, &diags))
+
+		var pd, sd DiskSizeAutoscaling
+		diags.Append(plan.DiskSizeAutoscaling.As(ctx, &pd, datasize.UnhandledOpts)...)
+		diags.Append(state.DiskSizeAutoscaling.As(ctx, &sd, datasize.UnhandledOpts)...)
+		if !pd.DiskSizeLimit.Equal(sd.DiskSizeLimit) {
+			request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.disk_size_autoscaling.disk_size_limit")
+		}
+		if !pd.PlannedUsageThreshold.Equal(sd.PlannedUsageThreshold) {
+			request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.disk_size_autoscaling.planned_usage_threshold")
+		}
+		if !pd.EmergencyUsageThreshold.Equal(sd.EmergencyUsageThreshold) {
+			request.UpdateMask.Paths = append(request.UpdateMask.Paths, "config_spec.disk_size_autoscaling.emergency_usage_threshold")
+		}
+	}
+
+	if updConf {
+		request.SetConfigSpec(config)
+	}
+
+	if !plan.DeletionProtection.Equal(state.DeletionProtection) {
+		request.SetDeletionProtection(plan.DeletionProtection.ValueBool())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "deletion_protection")
+	}
+
+	if !plan.SecurityGroupIds.Equal(state.SecurityGroupIds) {
+		request.SetSecurityGroupIds(mdbcommon.ExpandSecurityGroupIds(ctx, plan.SecurityGroupIds, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "security_group_ids")
+	}
+
+	if !plan.MaintenanceWindow.Equal(state.MaintenanceWindow) {
+		request.SetMaintenanceWindow(mdbcommon.ExpandClusterMaintenanceWindow[
+			kafka.MaintenanceWindow,
+			kafka.WeeklyMaintenanceWindow,
+			kafka.AnytimeMaintenanceWindow,
+			kafka.WeeklyMaintenanceWindow_WeekDay,
+		](ctx, plan.MaintenanceWindow, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "maintenance_window")
+	}
+
+	return request, diags
+}
@@ -0,0 +1,172 @@
+package mdb_kafka_cluster_v2
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+func buildTestCluster() Cluster {
+	return Cluster{
+		Id:                 types.StringValue("test-id"),
+		FolderId:           types.StringValue("test-folder"),
+		NetworkId:          types.StringValue("test-network"),
+		Name:               types.StringValue("test-cluster"),
+		Description:        types.StringValue("test-description"),
+		Environment:        types.StringValue("PRODUCTION"),
+		Labels:             types.MapNull(types.StringType),
+		SubnetIds:          types.ListNull(types.StringType),
+		SecurityGroupIds:   types.SetValueMust(types.StringType, []attr.Value{}),
+		HostGroupIds:       types.SetValueMust(types.StringType, []attr.Value{}),
+		DeletionProtection: types.BoolValue(false),
+		MaintenanceWindow:  types.ObjectNull(mdbcommon.MaintenanceWindowType.AttrTypes),
+		Version:            types.StringValue("3.6"),
+		Zones: types.ListValueMust(types.StringType, []attr.Value{
+			types.StringValue("ru-central1-a"),
+		}),
+		BrokersCount:   types.Int64Value(1),
+		AssignPublicIp: types.BoolValue(false),
+		SchemaRegistry: types.BoolValue(false),
+		Kafka: buildTestKafkaObj(
+			buildTestResourcesObj("s2.micro", "network-ssd", 16),
+			NewKafkaSettingsMapValueMust(map[string]attr.Value{
+				"log_retention_ms": types.Int64Value(3600000),
+			}),
+		),
+		Zookeeper:           types.ObjectNull(ControllerAttrTypes),
+		Kraft:               types.ObjectNull(ControllerAttrTypes),
+		DiskSizeAutoscaling: types.ObjectNull(DiskSizeAutoscalingAttrTypes),
+		Access:              types.ObjectNull(AccessAttrTypes),
+		RestApi:             types.ObjectValueMust(ToggleAttrTypes, map[string]attr.Value{"enabled": types.BoolValue(false)}),
+		KafkaUI:             types.ObjectValueMust(ToggleAttrTypes, map[string]attr.Value{"enabled": types.BoolValue(false)}),
+	}
+}
+
+func TestYandexProvider_MDBKafkaClusterPrepareUpdateRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	state := buildTestCluster()
+
+	cases := []struct {
+		testname      string
+		modify        func(c *Cluster)
+		expectedPaths []string
+	}{
+		{
+			testname:      "CheckNoChanges",
+			modify:        func(c *Cluster) {},
+			expectedPaths: nil,
+		},
+		{
+			testname: "CheckBaseAttributes",
+			modify: func(c *Cluster) {
+				c.Name = types.StringValue("test-cluster-new")
+				c.DeletionProtection = types.BoolValue(true)
+				c.BrokersCount = types.Int64Value(2)
+				c.Zones = types.ListValueMust(types.StringType, []attr.Value{
+					types.StringValue("ru-central1-a"),
+					types.StringValue("ru-central1-b"),
+				})
+			},
+			expectedPaths: []string{
+				"name",
+				"config_spec.zone_id",
+				"config_spec.brokers_count",
+				"deletion_protection",
+			},
+		},
+		{
+			testname: "CheckKafkaResourcesAndConfig",
+			modify: func(c *Cluster) {
+				c.Kafka = buildTestKafkaObj(
+					buildTestResourcesObj("s2.small", "network-ssd", 32),
+					NewKafkaSettingsMapValueMust(map[string]attr.Value{
+						"num_partitions": types.Int64Value(3),
+					}),
+				)
+			},
+			expectedPaths: []string{
+				"config_spec.kafka.resources.resource_preset_id",
+				"config_spec.kafka.resources.disk_size",
+				"config_spec.kafka.kafka_config_3.log_retention_ms",
+				"config_spec.kafka.kafka_config_3.num_partitions",
+			},
+		},
+		{
+			testname: "CheckToggles",
+			modify: func(c *Cluster) {
+				c.RestApi = types.ObjectValueMust(ToggleAttrTypes, map[string]attr.Value{"enabled": types.BoolValue(true)})
+				c.KafkaUI = types.ObjectValueMust(ToggleAttrTypes, map[string]attr.Value{"enabled": types.BoolValue(true)})
+				c.SchemaRegistry = types.BoolValue(true)
+			},
+			expectedPaths: []string{
+				"config_spec.schema_registry",
+				"config_spec.rest_api_config.enabled",
+				"config_spec.kafka_ui_config.enabled",
+			},
+		},
+	}
+
+	for _, c := range cases {
+		plan := buildTestCluster()
+		c.modify(&plan)
+
+		req, diags := prepareUpdateRequest(ctx, &state, &plan)
+		if diags.HasError() {
+			t.Errorf("Unexpected update diagnostics %s test: %v", c.testname, diags.Errors())
+			continue
+		}
+
+		if !reflect.DeepEqual(req.UpdateMask.Paths, c.expectedPaths) {
+			t.Errorf(
+				"Unexpected update mask %s test: expected %v, actual %v",
+				c.testname,
+				c.expectedPaths,
+				req.UpdateMask.Paths,
+			)
+		}
+	}
+}
+
+func TestYandexProvider_MDBKafkaClusterPrepareVersionUpdateRequest(t *testing.T) {
+	t.Parallel()
+
+	state := buildTestCluster()
+	plan := buildTestCluster()
+
+	req, _ := prepareVersionUpdateRequest(&state, &plan)
+	if req != nil {
+		t.Errorf("Unexpected version update request without changes: %v", req)
+	}
+
+	plan.Version = types.StringValue("3.9")
+	req, _ = prepareVersionUpdateRequest(&state, &plan)
+	if req == nil || req.ConfigSpec.Version != "3.9" || !reflect.DeepEqual(req.UpdateMask.Paths, []string{"config_spec.version"}) {
+		t.Errorf("Unexpected version update request: %v", req)
+	}
+}
+
+func TestYandexProvider_MDBKafkaClusterUpgradePlanner(t *testing.T) {
+	t.Parallel()
+
+	upgradePath, err := kafkaUpgradePlanner.UpgradePath("2.8", "3.2")
+	if err != nil || !reflect.DeepEqual(upgradePath, []string{"3.0", "3.1", "3.2"}) {
+		t.Errorf("Unexpected upgrade path: %v, error: %v", upgradePath, err)
+	}
+
+	if _, err := kafkaUpgradePlanner.UpgradePath("3.6", "3.5"); err == nil {
+		t.Errorf("Downgrade of Kafka version should be rejected")
+	}
+
+	settings := NewKafkaSettingsMapValueMust(map[string]attr.Value{
+		"log_retention_ms": types.Int64Value(1000),
+	})
+	if unsupported := kafkaUpgradePlanner.UnsupportedSettings("3.6", settings); len(unsupported) != 0 {
+		t.Errorf("Unexpected unsupported settings of 3.6: %v", unsupported)
+	}
+}
@@ -0,0 +1,326 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/retry"
+)
+
+const defaultMDBPageSize = 1000
+
+var mongodbApi = MongodbAPI{}
+
+type MongodbAPI struct{}
+
+// ==============================================================================
+//                                     HOST
+// ==============================================================================
+
+func (r *MongodbAPI) ListHosts(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) []*mongodb.Host {
+	hosts := []*mongodb.Host{}
+	pageToken := ""
+
+	for {
+		resp, err := sdk.MDB().MongoDB().Cluster().ListHosts(ctx, &mongodb.ListClusterHostsRequest{
+			ClusterId: cid,
+			PageSize:  defaultMDBPageSize,
+			PageToken: pageToken,
+		})
+		if err != nil {
+			diags.AddError(
+				"Failed to List MongoDB Hosts",
+				"Error while requesting API to get MongoDB host:"+err.Error(),
+			)
+			return nil
+		}
+
+		hosts = append(hosts, resp.Hosts...)
+
+		if resp.NextPageToken == "" {
+			break
+		}
+		pageToken = resp.NextPageToken
+	}
+
+	return hosts
+}
+
+func (r *MongodbAPI) CreateHosts(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid string, specs []*mongodb.HostSpec) {
+	for _, spec := range specs {
+		op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
+			return sdk.MDB().MongoDB().Cluster().AddHosts(ctx, &mongodb.AddClusterHostsRequest{
+				ClusterId: cid,
+				HostSpecs: []*mongodb.HostSpec{spec},
+			})
+		})
+		if err != nil {
+			diag.AddError(
+				"Failed to create hosts",
+				fmt.Sprintf("Error while requesting API to create host MongoDB cluster %q: %s", cid, err.Error()),
+			)
+			return
+		}
+
+		if err = op.Wait(ctx); err != nil {
+			diag.AddError(
+				"Failed to create hosts",
+				fmt.Sprintf("Error while waiting for operation %q to create host MongoDB cluster %q: %s", op.Id(), cid, err.Error()),
+			)
+			return
+		}
+	}
+}
+
+func (r *MongodbAPI) UpdateHosts(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid string, specs []*mongodb.UpdateHostSpec) {
+	if len(specs) == 0 {
+		return
+	}
+
+	request := &mongodb.UpdateClusterHostsRequest{
+		ClusterId:       cid,
+		UpdateHostSpecs: specs,
+	}
+	op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
+		log.Printf("[DEBUG] Sending MongoDB cluster update hosts request: %+v", request)
+		return sdk.MDB().MongoDB().Cluster().UpdateHosts(ctx, request)
+	})
+	if err != nil {
+		diag.AddError(
+			"Failed to update hosts",
+			fmt.Sprintf("Error while requesting API to update host MongoDB cluster %q: %s", cid, err.Error()),
+		)
+		return
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diag.AddError(
+			"Failed to update hosts",
+			fmt.Sprintf("Error while waiting for operation %q to update host MongoDB cluster %q: %s", op.Id(), cid, err.Error()),
+		)
+	}
+}
+
+func (r *MongodbAPI) DeleteHosts(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid string, fqdns []string) {
+	for _, fqdn := range fqdns {
+		op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
+			return sdk.MDB().MongoDB().Cluster().DeleteHosts(ctx, &mongodb.DeleteClusterHostsRequest{
+				ClusterId: cid,
+				HostNames: []string{fqdn},
+			})
+		})
+		if err != nil {
+			diag.AddError(
+				"Failed to delete hosts",
+				fmt.Sprintf("Error while requesting API to delete host MongoDB cluster %q: %s", cid, err.Error()),
+			)
+			return
+		}
+
+		if err = op.Wait(ctx); err != nil {
+			diag.AddError(
+				"Failed to delete hosts",
+				fmt.Sprintf("Error while waiting for operation %q to delete host MongoDB cluster %q: %s", op.Id(), cid, err.Error()),
+			)
+			return
+		}
+	}
+}
+
+// ==============================================================================
+//                                     SHARD
+// ==============================================================================
+
+func (r *MongodbAPI) GetShard(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid, shardName string) *mongodb.Shard {
+	shard, err := sdk.MDB().MongoDB().Cluster().GetShard(ctx, &mongodb.GetClusterShardRequest{
+		ClusterId: cid,
+		ShardName: shardName,
+	})
+	if err != nil {
+		diags.AddError(
+			"Failed to read resource",
+			fmt.Sprintf("Error while requesting API to read shard %q of MongoDB cluster %q: %s", shardName, cid, err.Error()),
+		)
+		return nil
+	}
+	return shard
+}
+
+func (r *MongodbAPI) CreateShard(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid, shardName string, hostSpecs []*mongodb.HostSpec) {
+	op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
+		return sdk.MDB().MongoDB().Cluster().AddShard(ctx, &mongodb.AddClusterShardRequest{
+			ClusterId: cid,
+			ShardName: shardName,
+			HostSpecs: hostSpecs,
+		})
+	})
+	if err != nil {
+		diag.AddError(
+			"Failed to create shard",
+			fmt.Sprintf("Error while requesting API to create shard %q in MongoDB cluster %q: %s", shardName, cid, err.Error()),
+		)
+		return
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diag.AddError(
+			"Failed to create shard",
+			fmt.Sprintf("Error while waiting for operation %q to create shard %q in MongoDB cluster %q: %s", op.Id(), shardName, cid, err.Error()),
+		)
+	}
+}
+
+func (r *MongodbAPI) DeleteShard(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, cid, shardName string) {
+	op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
+		return sdk.MDB().MongoDB().Cluster().DeleteShard(ctx, &mongodb.DeleteClusterShardRequest{
+			ClusterId: cid,
+			ShardName: shardName,
+		})
+	})
+	if err != nil {
+		diag.AddError(
+			"Failed to delete shard",
+			fmt.Sprintf("Error while requesting API to delete shard %q in MongoDB cluster %q: %s", shardName, cid, err.Error()),
+		)
+		return
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diag.AddError(
+			"Failed to delete shard",
+			fmt.Sprintf("Error while waiting for operation %q to delete shard %q in MongoDB cluster %q: %s", op.Id(), shardName, cid, err.Error()),
+		)
+	}
+}
+
+// ==============================================================================
+//                                 CLUSTER
+// ==============================================================================
+
+func (r *MongodbAPI) GetCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) *mongodb.Cluster {
+	cluster, err := sdk.MDB().MongoDB().Cluster().Get(ctx, &mongodb.GetClusterRequest{
+		ClusterId: cid,
+	})
+
+	if err != nil {
+		diags.AddError(
+			"Failed to read resource",
+			fmt.Sprintf("Error while requesting API to read MongoDB cluster %q: %s", cid, err.Error()),
+		)
+		return nil
+	}
+	return cluster
+}
+
+func (r *MongodbAPI) DeleteCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) {
+	op, err := sdk.WrapOperation(sdk.MDB().MongoDB().Cluster().Delete(ctx, &mongodb.DeleteClusterRequest{
+		ClusterId: cid,
+	}))
+
+	if err != nil {
+		diags.AddError(
+			"Failed to delete resource",
+			fmt.Sprintf("Error while requesting API to delete MongoDB cluster %q: %s", cid, err.Error()),
+		)
+		return
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to delete resource",
+			fmt.Sprintf("Error while waiting for operation %q to delete MongoDB cluster %q: %s", op.Id(), cid, err.Error()),
+		)
+	}
+}
+
+func (r *MongodbAPI) CreateCluster(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, req *mongodb.CreateClusterRequest) string {
+	op, err := sdk.WrapOperation(sdk.MDB().MongoDB().Cluster().Create(ctx, req))
+	if err != nil {
+		diags.AddError(
+			"Failed to create resource",
+			fmt.Sprintf("Error while requesting API to create MongoDB cluster: %s", err.Error()),
+		)
+		return ""
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		diags.AddError(
+			"Failed to create resource",
+			fmt.Sprintf("Error while unmarshaling for operation %q API response metadata: %s", op.Id(), err.Error()),
+		)
+		return ""
+	}
+
+	md, ok := protoMetadata.(*mongodb.CreateClusterMetadata)
+	if !ok {
+		diags.AddError(
+			"Failed to create resource",
+			fmt.Sprintf("Error while unmarshaling for operation %q API response metadata", op.Id()),
+		)
+		return ""
+	}
+
+	log.Printf("[DEBUG] Creating MongoDB Cluster %q", md.ClusterId)
+
+	if err = op.Wait(ctx); err != nil {
+		diags.AddError(
+			"Failed to create resource",
+			fmt.Sprintf("Error while waiting for operation %q to create MongoDB cluster: %s", op.Id(), err.Error()),
+		)
+		return ""
+	}
+
+	return md.ClusterId
+}
+
+func (r *MongodbAPI) UpdateCluster(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, req *mongodb.UpdateClusterRequest) {
+	if req == nil || len(req.UpdateMask.Paths) == 0 {
+		return
+	}
+
+	op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
+		log.Printf("[DEBUG] Sending MongoDB cluster update request: %+v", req)
+		return sdk.MDB().MongoDB().Cluster().Update(ctx, req)
+	})
+	if err != nil {
+		diag.AddError(
+			"Failed to update resource",
+			fmt.Sprintf("Error while requesting API to update MongoDB cluster: %s", err.Error()),
+		)
+		return
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diag.AddError(
+			"Failed to update resource",
+			fmt.Sprintf("Error while waiting for operation %q to update MongoDB cluster: %s", op.Id(), err.Error()),
+		)
+	}
+}
+
+func (r *MongodbAPI) EnableSharding(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, req *mongodb.EnableClusterShardingRequest) {
+	op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
+		log.Printf("[DEBUG] Sending MongoDB cluster enable sharding request: %+v", req)
+		return sdk.MDB().MongoDB().Cluster().EnableSharding(ctx, req)
+	})
+	if err != nil {
+		diag.AddError(
+			"Failed to update resource",
+			fmt.Sprintf("Error while requesting API to enable sharding MongoDB cluster %q: %s", req.ClusterId, err.Error()),
+		)
+		return
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diag.AddError(
+			"Failed to update resource",
+			fmt.Sprintf("Error while waiting for operation %q to enable sharding MongoDB cluster %q: %s", op.Id(), req.ClusterId, err.Error()),
+		)
+	}
+}
@@ -0,0 +1,245 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	mongo_config "github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1/config"
+	protobuf_adapter "github.com/yandex-cloud/terraform-provider-yandex/pkg/adapters/protobuf"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	utils "github.com/yandex-cloud/terraform-provider-yandex/pkg/wrappers"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func prepareCreateRequest(ctx context.Context, plan *Cluster, providerConfig *provider_config.State) (*mongodb.CreateClusterRequest, diag.Diagnostics) {
+	diags := diag.Diagnostics{}
+
+	hostSpecs, d := mdbcommon.CreateClusterHosts(ctx, mongodbHostService, plan.HostSpecs)
+	diags.Append(d...)
+
+	request := &mongodb.CreateClusterRequest{
+		Name:               plan.Name.ValueString(),
+		Description:        plan.Description.ValueString(),
+		FolderId:           mdbcommon.ExpandFolderId(ctx, plan.FolderId, providerConfig, &diags),
+		NetworkId:          plan.NetworkId.ValueString(),
+		Environment:        mdbcommon.ExpandEnvironment[mongodb.Cluster_Environment](ctx, plan.Environment, &diags),
+		Labels:             mdbcommon.ExpandLabels(ctx, plan.Labels, &diags),
+		ConfigSpec:         expandConfigSpec(ctx, plan, &diags),
+		HostSpecs:          hostSpecs,
+		SecurityGroupIds:   mdbcommon.ExpandSecurityGroupIds(ctx, plan.SecurityGroupIds, &diags),
+		DeletionProtection: plan.DeletionProtection.ValueBool(),
+		MaintenanceWindow: mdbcommon.ExpandClusterMaintenanceWindow[
+			mongodb.MaintenanceWindow,
+			mongodb.WeeklyMaintenanceWindow,
+			mongodb.AnytimeMaintenanceWindow,
+			mongodb.WeeklyMaintenanceWindow_WeekDay,
+		](ctx, plan.MaintenanceWindow, &diags),
+	}
+	return request, diags
+}
+
+func expandConfigSpec(ctx context.Context, plan *Cluster, diags *diag.Diagnostics) *mongodb.ConfigSpec {
+	spec := &mongodb.ConfigSpec{
+		Version:                plan.Version.ValueString(),
+		BackupRetainPeriodDays: mdbcommon.ExpandInt64Wrapper(ctx, plan.BackupRetainPeriodDays, diags),
+		PerformanceDiagnostics: expandPerformanceDiagnostics(ctx, plan.PerformanceDiagnostics, diags),
+		Access:                 expandAccess(ctx, plan.Access, diags),
+		Mongodb: &mongodb.MongodbSpec{
+			Mongod:     expandMongod(ctx, plan.Mongod, diags),
+			Mongocfg:   expandMongocfg(ctx, plan.Mongocfg, diags),
+			Mongos:     expandMongos(ctx, plan.Mongos, diags),
+			Mongoinfra: expandMongoinfra(ctx, plan.Mongoinfra, diags),
+		},
+	}
+	if utils.IsPresent(plan.FeatureCompatibilityVersion) {
+		spec.FeatureCompatibilityVersion = plan.FeatureCompatibilityVersion.ValueString()
+	}
+	if utils.IsPresent(plan.BackupWindowStart) {
+		spec.BackupWindowStart = mdbcommon.ExpandBackupWindow(ctx, plan.BackupWindowStart, diags)
+	}
+	return spec
+}
+
+func expandAccess(ctx context.Context, a types.Object, diags *diag.Diagnostics) *mongodb.Access {
+	if !utils.IsPresent(a) {
+		return nil
+	}
+
+	var access Access
+	diags.Append(a.As(ctx, &access, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+	return &mongodb.Access{
+		DataLens:     access.DataLens.ValueBool(),
+		WebSql:       access.WebSql.ValueBool(),
+		DataTransfer: access.DataTransfer.ValueBool(),
+	}
+}
+
+func expandPerformanceDiagnostics(ctx context.Context, p types.Object, diags *diag.Diagnostics) *mongodb.PerformanceDiagnosticsConfig {
+	if !utils.IsPresent(p) {
+		return nil
+	}
+
+	var pd PerformanceDiagnostics
+	diags.Append(p.As(ctx, &pd, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+	return &mongodb.PerformanceDiagnosticsConfig{
+		ProfilingEnabled: pd.Enabled.ValueBool(),
+	}
+}
+
+func expandDiskSizeAutoscaling(ctx context.Context, d types.Object, diags *diag.Diagnostics) *mongodb.DiskSizeAutoscaling {
+	if !utils.IsPresent(d) {
+		return nil
+	}
+
+	var dsa DiskSizeAutoscaling
+	diags.Append(d.As(ctx, &dsa, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	res := &mongodb.DiskSizeAutoscaling{
+		DiskSizeLimit:           wrapperspb.Int64(datasize.ToBytes(dsa.DiskSizeLimit.ValueInt64())),
+		PlannedUsageThreshold:   mdbcommon.ExpandInt64Wrapper(ctx, dsa.PlannedUsageThreshold, diags),
+		EmergencyUsageThreshold: mdbcommon.ExpandInt64Wrapper(ctx, dsa.EmergencyUsageThreshold, diags),
+	}
+	return res
+}
+
+// expandSettings fills the protobuf config with user defined settings.
+// It returns false if the settings are not specified, the API keeps default values in this case.
+func expandSettings(ctx context.Context, settings mdbcommon.SettingsMapValue, target any, diags *diag.Diagnostics) bool {
+	if !utils.IsPresent(settings) {
+		return false
+	}
+
+	attrs := settings.PrimitiveElements(ctx, diags)
+	if diags.HasError() {
+		return false
+	}
+
+	protobuf_adapter.NewProtobufMapDataAdapter().Fill(ctx, target, attrs, diags)
+	return !diags.HasError()
+}
+
+func expandRole(ctx context.Context, o types.Object, diags *diag.Diagnostics) *Role {
+	if !utils.IsPresent(o) {
+		return nil
+	}
+
+	var role Role
+	diags.Append(o.As(ctx, &role, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+	return &role
+}
+
+func expandMongod(ctx context.Context, o types.Object, diags *diag.Diagnostics) *mongodb.MongodbSpec_Mongod {
+	role := expandRole(ctx, o, diags)
+	if role == nil {
+		return nil
+	}
+
+	res := &mongodb.MongodbSpec_Mongod{
+		Resources:           mdbcommon.ExpandResources[mongodb.Resources](ctx, role.Resources, diags),
+		DiskSizeAutoscaling: expandDiskSizeAutoscaling(ctx, role.DiskSizeAutoscaling, diags),
+	}
+	if cfg := (&mongo_config.MongodConfig{}); expandSettings(ctx, role.Config, cfg, diags) {
+		res.Config = cfg
+	}
+	return res
+}
+
+func expandMongocfg(ctx context.Context, o types.Object, diags *diag.Diagnostics) *mongodb.MongodbSpec_MongoCfg {
+	role := expandRole(ctx, o, diags)
+	if role == nil {
+		return nil
+	}
+
+	res := &mongodb.MongodbSpec_MongoCfg{
+		Resources:           mdbcommon.ExpandResources[mongodb.Resources](ctx, role.Resources, diags),
+		DiskSizeAutoscaling: expandDiskSizeAutoscaling(ctx, role.DiskSizeAutoscaling, diags),
+	}
+	if cfg := (&mongo_config.MongoCfgConfig{}); expandSettings(ctx, role.Config, cfg, diags) {
+		res.Config = cfg
+	}
+	return res
+}
+
+func expandMongos(ctx context.Context, o types.Object, diags *diag.Diagnostics) *mongodb.MongodbSpec_Mongos {
+	role := expandRole(ctx, o, diags)
+	if role == nil {
+		return nil
+	}
+
+	res := &mongodb.MongodbSpec_Mongos{
+		Resources:           mdbcommon.ExpandResources[mongodb.Resources](ctx, role.Resources, diags),
+		DiskSizeAutoscaling: expandDiskSizeAutoscaling(ctx, role.DiskSizeAutoscaling, diags),
+	}
+	if cfg := (&mongo_config.MongosConfig{}); expandSettings(ctx, role.Config, cfg, diags) {
+		res.Config = cfg
+	}
+	return res
+}
+
+func expandMongoinfra(ctx context.Context, o types.Object, diags *diag.Diagnostics) *mongodb.MongodbSpec_MongoInfra {
+	if !utils.IsPresent(o) {
+		return nil
+	}
+
+	var infra MongoInfra
+	diags.Append(o.As(ctx, &infra, datasize.DefaultOpts)...)
+	if diags.HasError() {
+		return nil
+	}
+
+	res := &mongodb.MongodbSpec_MongoInfra{
+		Resources:           mdbcommon.ExpandResources[mongodb.Resources](ctx, infra.Resources, diags),
+		DiskSizeAutoscaling: expandDiskSizeAutoscaling(ctx, infra.DiskSizeAutoscaling, diags),
+	}
+	if cfg := (&mongo_config.MongosConfig{}); expandSettings(ctx, infra.ConfigMongos, cfg, diags) {
+		res.ConfigMongos = cfg
+	}
+	if cfg := (&mongo_config.MongoCfgConfig{}); expandSettings(ctx, infra.ConfigMongocfg, cfg, diags) {
+		res.ConfigMongocfg = cfg
+	}
+	return res
+}
+
+// expandEnableShardingRequest collects specs of the subclusters and hosts which are created
+// when sharding is enabled on a replica set cluster.
+func expandEnableShardingRequest(ctx context.Context, plan *Cluster, infraHosts types.Map, diags *diag.Diagnostics) *mongodb.EnableClusterShardingRequest {
+	hostSpecs, d := mdbcommon.CreateClusterHosts(ctx, mongodbHostService, infraHosts)
+	diags.Append(d...)
+
+	req := &mongodb.EnableClusterShardingRequest{
+		ClusterId: plan.Id.ValueString(),
+		HostSpecs: hostSpecs,
+	}
+	if r := expandRole(ctx, plan.Mongocfg, diags); r != nil {
+		req.Mongocfg = &mongodb.EnableClusterShardingRequest_MongoCfg{
+			Resources: mdbcommon.ExpandResources[mongodb.Resources](ctx, r.Resources, diags),
+		}
+	}
+	if r := expandRole(ctx, plan.Mongos, diags); r != nil {
+		req.Mongos = &mongodb.EnableClusterShardingRequest_Mongos{
+			Resources: mdbcommon.ExpandResources[mongodb.Resources](ctx, r.Resources, diags),
+		}
+	}
+	if infra := expandMongoinfra(ctx, plan.Mongoinfra, diags); infra != nil {
+		req.Mongoinfra = &mongodb.EnableClusterShardingRequest_MongoInfra{
+			Resources: infra.Resources,
+		}
+	}
+	return req
+}
@@ -0,0 +1,201 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	mongo_config "github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1/config"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func buildTestResourcesObj(preset, diskType string, diskSize int64) types.Object {
+	return types.ObjectValueMust(mdbcommon.ResourceType.AttrTypes, map[string]attr.Value{
+		"resource_preset_id": types.StringValue(preset),
+		"disk_type_id":       types.StringValue(diskType),
+		"disk_size":          types.Int64Value(diskSize),
+	})
+}
+
+func buildTestRoleObj(attrTypes map[string]attr.Type, resources types.Object, cfg mdbcommon.SettingsMapValue) types.Object {
+	return types.ObjectValueMust(attrTypes, map[string]attr.Value{
+		"resources":             resources,
+		"disk_size_autoscaling": types.ObjectNull(DiskSizeAutoscalingType.AttrTypes),
+		"config":                cfg,
+	})
+}
+
+func newTestSettings(p mdbcommon.SettingsAttributeInfoProvider, elems map[string]attr.Value) mdbcommon.SettingsMapValue {
+	v, d := mdbcommon.NewSettingsMapValue(elems, p)
+	if d.HasError() {
+		panic(d)
+	}
+	return v
+}
+
+func TestYandexProvider_MDBMongoDBClusterMongodExpand(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	resources := buildTestResourcesObj("s2.micro", "network-ssd", 16)
+	expectedResources := &mongodb.Resources{
+		ResourcePresetId: "s2.micro",
+		DiskTypeId:       "network-ssd",
+		DiskSize:         16 * 1024 * 1024 * 1024,
+	}
+
+	cases := []struct {
+		testname       string
+		reqVal         types.Object
+		expectedConfig bool
+		expectedError  bool
+	}{
+		{
+			testname: "CheckConfig",
+			reqVal: buildTestRoleObj(MongodAttrTypes, resources, newTestSettings(mongodAttrProvider, map[string]attr.Value{
+				"cache_size_gb":     types.Float64Value(1.5),
+				"slow_op_threshold": types.Int64Value(300),
+				"mode":              types.Int64Value(int64(mongo_config.MongodConfig_OperationProfiling_SLOW_OP)),
+			})),
+			expectedConfig: true,
+		},
+		{
+			testname:       "CheckNullConfig",
+			reqVal:         buildTestRoleObj(MongodAttrTypes, resources, mdbcommon.NewSettingsMapNull()),
+			expectedConfig: false,
+		},
+		{
+			testname: "CheckUnknownSetting",
+			reqVal: buildTestRoleObj(MongodAttrTypes, resources, newTestSettings(mongodAttrProvider, map[string]attr.Value{
+				"unknown_setting": types.Int64Value(1),
+			})),
+			expectedError: true,
+		},
+	}
+
+	for _, c := range cases {
+		diags := diag.Diagnostics{}
+		m := expandMongod(ctx, c.reqVal, &diags)
+		if diags.HasError() != c.expectedError {
+			t.Errorf(
+				"Unexpected expand diagnostics status %s test: expected %t, actual %t with errors: %v",
+				c.testname,
+				c.expectedError,
+				diags.HasError(),
+				diags.Errors(),
+			)
+			continue
+		}
+		if c.expectedError {
+			continue
+		}
+
+		if !proto.Equal(m.Resources, expectedResources) {
+			t.Errorf("Unexpected expand resources result %s test: expected %v, actual %v", c.testname, expectedResources, m.Resources)
+		}
+		if (m.Config != nil) != c.expectedConfig {
+			t.Errorf("Unexpected expand config presence %s test: expected %t, actual %v", c.testname, c.expectedConfig, m.Config)
+		}
+		if !c.expectedConfig {
+			continue
+		}
+
+		if v := m.Config.GetStorage().GetWiredTiger().GetEngineConfig().GetCacheSizeGb(); !proto.Equal(v, wrapperspb.Double(1.5)) {
+			t.Errorf("Unexpected cache_size_gb %s test: %v", c.testname, v)
+		}
+		if v := m.Config.GetOperationProfiling().GetSlowOpThreshold(); !proto.Equal(v, wrapperspb.Int64(300)) {
+			t.Errorf("Unexpected slow_op_threshold %s test: %v", c.testname, v)
+		}
+		if v := m.Config.GetOperationProfiling().GetMode(); v != mongo_config.MongodConfig_OperationProfiling_SLOW_OP {
+			t.Errorf("Unexpected mode %s test: %v", c.testname, v)
+		}
+	}
+}
+
+func TestYandexProvider_MDBMongoDBClusterSettingsFlatten(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	userConfig := &mongo_config.MongodConfig{
+		OperationProfiling: &mongo_config.MongodConfig_OperationProfiling{
+			SlowOpThreshold: wrapperspb.Int64(200),
+		},
+	}
+	stateConfig := newTestSettings(mongodAttrProvider, map[string]attr.Value{
+		"slow_op_threshold": types.Int64Value(100),
+	})
+
+	diags := diag.Diagnostics{}
+	fromState := flattenSettings(ctx, stateConfig, userConfig, mongodAttrProvider, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected flatten diagnostics: %v", diags.Errors())
+	}
+	if !fromState.Equal(stateConfig) {
+		t.Errorf("Unexpected flatten result with state: expected %v, actual %v", stateConfig, fromState)
+	}
+
+	fromApi := flattenSettings(ctx, mdbcommon.NewSettingsMapNull(), userConfig, mongodAttrProvider, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected flatten diagnostics: %v", diags.Errors())
+	}
+	expected := newTestSettings(mongodAttrProvider, map[string]attr.Value{
+		"slow_op_threshold": types.Int64Value(200),
+	})
+	if !fromApi.Equal(expected) {
+		t.Errorf("Unexpected flatten result without state: expected %v, actual %v", expected, fromApi)
+	}
+}
+
+func TestYandexProvider_MDBMongoDBClusterEnableShardingExpand(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	resources := buildTestResourcesObj("s2.micro", "network-ssd", 10)
+	plan := &Cluster{
+		Id:       types.StringValue("cid"),
+		Mongocfg: types.ObjectNull(MongocfgAttrTypes),
+		Mongos:   types.ObjectNull(MongosAttrTypes),
+		Mongoinfra: types.ObjectValueMust(MongoinfraAttrTypes, map[string]attr.Value{
+			"resources":             resources,
+			"disk_size_autoscaling": types.ObjectNull(DiskSizeAutoscalingType.AttrTypes),
+			"config_mongos":         mdbcommon.NewSettingsMapNull(),
+			"config_mongocfg":       mdbcommon.NewSettingsMapNull(),
+		}),
+	}
+	infraHosts := types.MapValueMust(hostType, map[string]attr.Value{
+		"infra1": buildTestHostObj(mongodb.Host_MONGOINFRA.String(), "ru-central1-a", ""),
+	})
+
+	diags := diag.Diagnostics{}
+	req := expandEnableShardingRequest(ctx, plan, infraHosts, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected expand diagnostics: %v", diags.Errors())
+	}
+
+	expected := &mongodb.EnableClusterShardingRequest{
+		ClusterId: "cid",
+		Mongoinfra: &mongodb.EnableClusterShardingRequest_MongoInfra{
+			Resources: &mongodb.Resources{
+				ResourcePresetId: "s2.micro",
+				DiskTypeId:       "network-ssd",
+				DiskSize:         10 * 1024 * 1024 * 1024,
+			},
+		},
+		HostSpecs: []*mongodb.HostSpec{
+			{
+				ZoneId:             "ru-central1-a",
+				Type:               mongodb.Host_MONGOINFRA,
+				Hidden:             wrapperspb.Bool(false),
+				SecondaryDelaySecs: wrapperspb.Int64(0),
+			},
+		},
+	}
+	if !proto.Equal(req, expected) {
+		t.Errorf("Unexpected expand result: expected %v, actual %v", expected, req)
+	}
+}
@@ -0,0 +1,204 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	protobuf_adapter "github.com/yandex-cloud/terraform-provider-yandex/pkg/adapters/protobuf"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/timestamp"
+	utils "github.com/yandex-cloud/terraform-provider-yandex/pkg/wrappers"
+)
+
+func flattenAccess(ctx context.Context, a *mongodb.Access, diags *diag.Diagnostics) types.Object {
+	if a == nil {
+		return types.ObjectNull(AccessAttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, AccessAttrTypes, Access{
+		DataLens:     types.BoolValue(a.DataLens),
+		WebSql:       types.BoolValue(a.WebSql),
+		DataTransfer: types.BoolValue(a.DataTransfer),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func flattenPerformanceDiagnostics(ctx context.Context, p *mongodb.PerformanceDiagnosticsConfig, diags *diag.Diagnostics) types.Object {
+	if p == nil {
+		return types.ObjectNull(PerformanceDiagnosticsAttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, PerformanceDiagnosticsAttrTypes, PerformanceDiagnostics{
+		Enabled: types.BoolValue(p.ProfilingEnabled),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func flattenDiskSizeAutoscaling(ctx context.Context, dsa *mongodb.DiskSizeAutoscaling, diags *diag.Diagnostics) types.Object {
+	if dsa == nil {
+		return types.ObjectNull(DiskSizeAutoscalingType.AttrTypes)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, DiskSizeAutoscalingType.AttrTypes, DiskSizeAutoscaling{
+		DiskSizeLimit:           types.Int64Value(datasize.ToGigabytes(dsa.GetDiskSizeLimit().GetValue())),
+		PlannedUsageThreshold:   types.Int64Value(dsa.GetPlannedUsageThreshold().GetValue()),
+		EmergencyUsageThreshold: types.Int64Value(dsa.GetEmergencyUsageThreshold().GetValue()),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+// flattenSettings keeps user defined settings from the state if they are known,
+// the API returns full config with default values which would produce an endless diff otherwise.
+func flattenSettings(
+	ctx context.Context,
+	state mdbcommon.SettingsMapValue,
+	userConfig any,
+	p mdbcommon.SettingsAttributeInfoProvider,
+	diags *diag.Diagnostics,
+) mdbcommon.SettingsMapValue {
+	if utils.IsPresent(state) {
+		return state
+	}
+
+	a := protobuf_adapter.NewProtobufMapDataAdapter()
+	attrs := a.Extract(ctx, userConfig, diags)
+	if diags.HasError() {
+		return mdbcommon.NewSettingsMapNull()
+	}
+
+	attrsPresent := make(map[string]attr.Value)
+	for attr, val := range attrs {
+		if ok := mdbcommon.IsAttrZeroValue(val, diags); !ok {
+			attrsPresent[attr] = val
+		}
+
+		if diags.HasError() {
+			diags.AddError("Flatten MongoDB Config Error", fmt.Sprintf("Can't check zero attribute %s", attr))
+		}
+	}
+
+	mv, d := mdbcommon.NewSettingsMapValue(attrsPresent, p)
+	diags.Append(d...)
+	return mv
+}
+
+func stateRole(ctx context.Context, state types.Object, diags *diag.Diagnostics) Role {
+	var role Role
+	if utils.IsPresent(state) {
+		diags.Append(state.As(ctx, &role, datasize.UnhandledOpts)...)
+	}
+	return role
+}
+
+func flattenRole(
+	ctx context.Context,
+	attrTypes map[string]attr.Type,
+	resources *mongodb.Resources,
+	dsa *mongodb.DiskSizeAutoscaling,
+	config mdbcommon.SettingsMapValue,
+	diags *diag.Diagnostics,
+) types.Object {
+	obj, d := types.ObjectValueFrom(ctx, attrTypes, Role{
+		Resources:           mdbcommon.FlattenResources(ctx, resources, diags),
+		DiskSizeAutoscaling: flattenDiskSizeAutoscaling(ctx, dsa, diags),
+		Config:              config,
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func flattenMongod(ctx context.Context, state types.Object, m *mongodb.Mongodb_Mongod, diags *diag.Diagnostics) types.Object {
+	if m == nil {
+		return types.ObjectNull(MongodAttrTypes)
+	}
+
+	cfg := flattenSettings(ctx, stateRole(ctx, state, diags).Config, m.GetConfig().GetUserConfig(), mongodAttrProvider, diags)
+	return flattenRole(ctx, MongodAttrTypes, m.Resources, m.DiskSizeAutoscaling, cfg, diags)
+}
+
+func flattenMongocfg(ctx context.Context, state types.Object, m *mongodb.Mongodb_MongoCfg, diags *diag.Diagnostics) types.Object {
+	if m == nil {
+		return types.ObjectNull(MongocfgAttrTypes)
+	}
+
+	cfg := flattenSettings(ctx, stateRole(ctx, state, diags).Config, m.GetConfig().GetUserConfig(), mongocfgAttrProvider, diags)
+	return flattenRole(ctx, MongocfgAttrTypes, m.Resources, m.DiskSizeAutoscaling, cfg, diags)
+}
+
+func flattenMongos(ctx context.Context, state types.Object, m *mongodb.Mongodb_Mongos, diags *diag.Diagnostics) types.Object {
+	if m == nil {
+		return types.ObjectNull(MongosAttrTypes)
+	}
+
+	cfg := flattenSettings(ctx, stateRole(ctx, state, diags).Config, m.GetConfig().GetUserConfig(), mongosAttrProvider, diags)
+	return flattenRole(ctx, MongosAttrTypes, m.Resources, m.DiskSizeAutoscaling, cfg, diags)
+}
+
+func flattenMongoinfra(ctx context.Context, state types.Object, m *mongodb.Mongodb_MongoInfra, diags *diag.Diagnostics) types.Object {
+	if m == nil {
+		return types.ObjectNull(MongoinfraAttrTypes)
+	}
+
+	var stateInfra MongoInfra
+	if utils.IsPresent(state) {
+		diags.Append(state.As(ctx, &stateInfra, datasize.UnhandledOpts)...)
+	}
+
+	obj, d := types.ObjectValueFrom(ctx, MongoinfraAttrTypes, MongoInfra{
+		Resources:           mdbcommon.FlattenResources(ctx, m.Resources, diags),
+		DiskSizeAutoscaling: flattenDiskSizeAutoscaling(ctx, m.DiskSizeAutoscaling, diags),
+		ConfigMongos:        flattenSettings(ctx, stateInfra.ConfigMongos, m.GetConfigMongos().GetUserConfig(), mongosAttrProvider, diags),
+		ConfigMongocfg:      flattenSettings(ctx, stateInfra.ConfigMongocfg, m.GetConfigMongocfg().GetUserConfig(), mongocfgAttrProvider, diags),
+	})
+	diags.Append(d...)
+	return obj
+}
+
+func flattenCluster(ctx context.Context, state *Cluster, cluster *mongodb.Cluster, diags *diag.Diagnostics) {
+	state.Id = types.StringValue(cluster.Id)
+	state.FolderId = types.StringValue(cluster.FolderId)
+	state.NetworkId = types.StringValue(cluster.NetworkId)
+	state.Name = types.StringValue(cluster.Name)
+	state.Description = types.StringValue(cluster.Description)
+	state.Environment = types.StringValue(cluster.Environment.String())
+	state.Labels = mdbcommon.FlattenMapString(ctx, cluster.Labels, diags)
+	state.DeletionProtection = types.BoolValue(cluster.GetDeletionProtection())
+	state.MaintenanceWindow = mdbcommon.FlattenMaintenanceWindow[
+		mongodb.MaintenanceWindow,
+		mongodb.WeeklyMaintenanceWindow,
+		mongodb.AnytimeMaintenanceWindow,
+		mongodb.WeeklyMaintenanceWindow_WeekDay,
+	](ctx, cluster.MaintenanceWindow, diags)
+	state.SecurityGroupIds = mdbcommon.FlattenSetString(ctx, cluster.SecurityGroupIds, diags)
+	state.Sharded = types.BoolValue(cluster.Sharded)
+	state.CreatedAt = types.StringValue(timestamp.Get(cluster.GetCreatedAt()))
+	state.Health = types.StringValue(cluster.Health.String())
+	state.Status = types.StringValue(cluster.Status.String())
+
+	cfg := cluster.GetConfig()
+	if cfg == nil {
+		diags.AddError("Failed to flatten config.", "Config of cluster can't be nil. It's error in provider")
+		return
+	}
+
+	state.Version = types.StringValue(cfg.Version)
+	state.FeatureCompatibilityVersion = types.StringValue(cfg.FeatureCompatibilityVersion)
+	state.BackupWindowStart = mdbcommon.FlattenBackupWindowStart(ctx, cfg.BackupWindowStart, diags)
+	state.BackupRetainPeriodDays = mdbcommon.FlattenInt64Wrapper(ctx, cfg.BackupRetainPeriodDays, diags)
+	state.PerformanceDiagnostics = flattenPerformanceDiagnostics(ctx, cfg.PerformanceDiagnostics, diags)
+	state.Access = flattenAccess(ctx, cfg.Access, diags)
+
+	mongodbConfig := cfg.GetMongodbConfig()
+	state.Mongod = flattenMongod(ctx, state.Mongod, mongodbConfig.GetMongod(), diags)
+	state.Mongocfg = flattenMongocfg(ctx, state.Mongocfg, mongodbConfig.GetMongocfg(), diags)
+	state.Mongos = flattenMongos(ctx, state.Mongos, mongodbConfig.GetMongos(), diags)
+	state.Mongoinfra = flattenMongoinfra(ctx, state.Mongoinfra, mongodbConfig.GetMongoinfra(), diags)
+}
@@ -0,0 +1,294 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	ycsdk "github.com/yandex-cloud/go-sdk"
+	utils "github.com/yandex-cloud/terraform-provider-yandex/pkg/wrappers"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+var mongodbHostService = &MongoDBHostService{}
+
+type MongoDBHostService struct {
+}
+
+func (r MongoDBHostService) FullyMatch(planHost Host, stateHost Host) bool {
+	return r.PartialMatch(planHost, stateHost) &&
+		planHost.AssignPublicIp.ValueBool() == stateHost.AssignPublicIp.ValueBool() &&
+		planHost.Hidden.ValueBool() == stateHost.Hidden.ValueBool() &&
+		(planHost.Priority.IsUnknown() || planHost.Priority.ValueFloat64() == stateHost.Priority.ValueFloat64()) &&
+		planHost.SecondaryDelaySecs.ValueInt64() == stateHost.SecondaryDelaySecs.ValueInt64() &&
+		(planHost.Tags.IsUnknown() || planHost.Tags.Equal(stateHost.Tags))
+}
+
+func (r MongoDBHostService) PartialMatch(planHost Host, stateHost Host) bool {
+	return planHost.Type.Equal(stateHost.Type) &&
+		planHost.ZoneId.Equal(stateHost.ZoneId) &&
+		(planHost.FQDN.IsUnknown() || planHost.FQDN.Equal(stateHost.FQDN)) &&
+		(planHost.SubnetId.IsUnknown() || planHost.SubnetId.Equal(stateHost.SubnetId)) &&
+		(planHost.ShardName.IsUnknown() || planHost.ShardName.Equal(stateHost.ShardName))
+}
+
+func (r MongoDBHostService) GetChanges(plan Host, state Host) (*mongodb.UpdateHostSpec, diag.Diagnostics) {
+	var diags diag.Diagnostics
+	if !r.PartialMatch(plan, state) {
+		diags.AddError(
+			"Wrong changes for host",
+			"Attributes type, shard_name, zone_id, subnet_id can't be changed. Try to replace this host to new one",
+		)
+		return nil, diags
+	}
+
+	spec := &mongodb.UpdateHostSpec{
+		HostName:   state.FQDN.ValueString(),
+		UpdateMask: &fieldmaskpb.FieldMask{},
+	}
+	if !plan.AssignPublicIp.Equal(state.AssignPublicIp) {
+		spec.AssignPublicIp = plan.AssignPublicIp.ValueBool()
+		spec.UpdateMask.Paths = append(spec.UpdateMask.Paths, "assign_public_ip")
+	}
+	if !plan.Hidden.Equal(state.Hidden) {
+		spec.Hidden = wrapperspb.Bool(plan.Hidden.ValueBool())
+		spec.UpdateMask.Paths = append(spec.UpdateMask.Paths, "hidden")
+	}
+	if utils.IsPresent(plan.Priority) && !plan.Priority.Equal(state.Priority) {
+		spec.Priority = wrapperspb.Double(plan.Priority.ValueFloat64())
+		spec.UpdateMask.Paths = append(spec.UpdateMask.Paths, "priority")
+	}
+	if !plan.SecondaryDelaySecs.Equal(state.SecondaryDelaySecs) {
+		spec.SecondaryDelaySecs = wrapperspb.Int64(plan.SecondaryDelaySecs.ValueInt64())
+		spec.UpdateMask.Paths = append(spec.UpdateMask.Paths, "secondary_delay_secs")
+	}
+	if !plan.Tags.IsUnknown() && !plan.Tags.Equal(state.Tags) {
+		spec.Tags = expandTags(plan.Tags)
+		spec.UpdateMask.Paths = append(spec.UpdateMask.Paths, "tags")
+	}
+
+	if len(spec.UpdateMask.Paths) == 0 {
+		return nil, diags
+	}
+	return spec, diags
+}
+
+func (r MongoDBHostService) ConvertToProto(h Host) *mongodb.HostSpec {
+	spec := &mongodb.HostSpec{
+		ZoneId:         h.ZoneId.ValueString(),
+		SubnetId:       h.SubnetId.ValueString(),
+		AssignPublicIp: h.AssignPublicIp.ValueBool(),
+		Type:           mongodb.Host_Type(mongodb.Host_Type_value[h.Type.ValueString()]),
+		ShardName:      h.ShardName.ValueString(),
+		Tags:           expandTags(h.Tags),
+	}
+	if utils.IsPresent(h.Hidden) {
+		spec.Hidden = wrapperspb.Bool(h.Hidden.ValueBool())
+	}
+	if utils.IsPresent(h.Priority) {
+		spec.Priority = wrapperspb.Double(h.Priority.ValueFloat64())
+	}
+	if utils.IsPresent(h.SecondaryDelaySecs) {
+		spec.SecondaryDelaySecs = wrapperspb.Int64(h.SecondaryDelaySecs.ValueInt64())
+	}
+	return spec
+}
+
+func (r MongoDBHostService) ConvertFromProto(apiHost *mongodb.Host) Host {
+	params := apiHost.GetHostParameters()
+	return Host{
+		Type:               types.StringValue(apiHost.Type.String()),
+		ZoneId:             types.StringValue(apiHost.ZoneId),
+		SubnetId:           types.StringValue(apiHost.SubnetId),
+		AssignPublicIp:     types.BoolValue(apiHost.AssignPublicIp),
+		ShardName:          types.StringValue(apiHost.ShardName),
+		Hidden:             types.BoolValue(params.GetHidden()),
+		Priority:           types.Float64Value(params.GetPriority()),
+		SecondaryDelaySecs: types.Int64Value(params.GetSecondaryDelaySecs()),
+		Tags:               flattenTags(params.GetTags()),
+		FQDN:               types.StringValue(apiHost.Name),
+	}
+}
+
+func (h Host) GetFQDN() types.String {
+	return h.FQDN
+}
+
+func (h Host) GetShard() string {
+	return h.ShardName.ValueString()
+}
+
+func (h Host) isMongod() bool {
+	return h.Type.ValueString() == mongodb.Host_MONGOD.String()
+}
+
+func expandTags(tags types.Map) map[string]string {
+	if !utils.IsPresent(tags) {
+		return nil
+	}
+
+	res := make(map[string]string, len(tags.Elements()))
+	for k, v := range tags.Elements() {
+		if s, ok := v.(types.String); ok {
+			res[k] = s.ValueString()
+		}
+	}
+	return res
+}
+
+// flattenTags returns null for hosts without tags, the API doesn't distinguish an empty map from an absent one.
+func flattenTags(tags map[string]string) types.Map {
+	if len(tags) == 0 {
+		return types.MapNull(types.StringType)
+	}
+
+	elements := make(map[string]attr.Value, len(tags))
+	for k, v := range tags {
+		elements[k] = types.StringValue(v)
+	}
+	return types.MapValueMust(types.StringType, elements)
+}
+
+// splitHosts separates mongod hosts, which are grouped into shards, from hosts of the other types.
+func splitHosts(ctx context.Context, hosts types.Map, diags *diag.Diagnostics) (types.Map, types.Map) {
+	all := make(map[string]Host)
+	if utils.IsPresent(hosts) {
+		diags.Append(hosts.ElementsAs(ctx, &all, false)...)
+	}
+
+	mongod := make(map[string]Host)
+	infra := make(map[string]Host)
+	for label, h := range all {
+		if h.isMongod() {
+			mongod[label] = h
+		} else {
+			infra[label] = h
+		}
+	}
+
+	mongodHosts, d := types.MapValueFrom(ctx, hostType, mongod)
+	diags.Append(d...)
+	infraHosts, d := types.MapValueFrom(ctx, hostType, infra)
+	diags.Append(d...)
+	return mongodHosts, infraHosts
+}
+
+// managedShards returns names of the shards declared in the cluster hosts.
+// The result is nil if all shards are managed by the cluster: after import and on creation,
+// when shard names are not known yet.
+func managedShards(ctx context.Context, hosts types.Map, diags *diag.Diagnostics) map[string]struct{} {
+	if !utils.IsPresent(hosts) {
+		return nil
+	}
+
+	all := make(map[string]Host)
+	diags.Append(hosts.ElementsAs(ctx, &all, false)...)
+
+	shards := make(map[string]struct{})
+	for _, h := range all {
+		if !h.isMongod() {
+			continue
+		}
+		if !utils.IsPresent(h.ShardName) || h.ShardName.ValueString() == "" {
+			return nil
+		}
+		shards[h.ShardName.ValueString()] = struct{}{}
+	}
+	return shards
+}
+
+// clusterHostsAPI lists only hosts of the shards managed by the cluster resource.
+// Shards added with yandex_mdb_mongodb_shard must not appear in the cluster hosts,
+// otherwise they would be deleted on the next apply of the cluster.
+type clusterHostsAPI struct {
+	*MongodbAPI
+	shards map[string]struct{}
+}
+
+func (r *clusterHostsAPI) ListHosts(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) []*mongodb.Host {
+	hosts := r.MongodbAPI.ListHosts(ctx, sdk, diags, cid)
+	if r.shards == nil {
+		return hosts
+	}
+
+	var res []*mongodb.Host
+	for _, h := range hosts {
+		if _, ok := r.shards[h.ShardName]; ok || h.Type != mongodb.Host_MONGOD {
+			res = append(res, h)
+		}
+	}
+	return res
+}
+
+// shardHostService adapts the cluster host service for hosts of a single shard.
+type shardHostService struct {
+	shardName string
+}
+
+func (s shardHostService) toHost(h ShardHost) Host {
+	return Host{
+		Type:               types.StringValue(mongodb.Host_MONGOD.String()),
+		ZoneId:             h.ZoneId,
+		SubnetId:           h.SubnetId,
+		AssignPublicIp:     h.AssignPublicIp,
+		ShardName:          types.StringValue(s.shardName),
+		Hidden:             h.Hidden,
+		Priority:           h.Priority,
+		SecondaryDelaySecs: h.SecondaryDelaySecs,
+		Tags:               h.Tags,
+		FQDN:               h.FQDN,
+	}
+}
+
+func (s shardHostService) FullyMatch(plan ShardHost, state ShardHost) bool {
+	return mongodbHostService.FullyMatch(s.toHost(plan), s.toHost(state))
+}
+
+func (s shardHostService) PartialMatch(plan ShardHost, state ShardHost) bool {
+	return mongodbHostService.PartialMatch(s.toHost(plan), s.toHost(state))
+}
+
+func (s shardHostService) GetChanges(plan ShardHost, state ShardHost) (*mongodb.UpdateHostSpec, diag.Diagnostics) {
+	return mongodbHostService.GetChanges(s.toHost(plan), s.toHost(state))
+}
+
+func (s shardHostService) ConvertToProto(h ShardHost) *mongodb.HostSpec {
+	return mongodbHostService.ConvertToProto(s.toHost(h))
+}
+
+func (s shardHostService) ConvertFromProto(apiHost *mongodb.Host) ShardHost {
+	h := mongodbHostService.ConvertFromProto(apiHost)
+	return ShardHost{
+		ZoneId:             h.ZoneId,
+		SubnetId:           h.SubnetId,
+		AssignPublicIp:     h.AssignPublicIp,
+		Hidden:             h.Hidden,
+		Priority:           h.Priority,
+		SecondaryDelaySecs: h.SecondaryDelaySecs,
+		Tags:               h.Tags,
+		FQDN:               h.FQDN,
+	}
+}
+
+func (h ShardHost) GetFQDN() types.String {
+	return h.FQDN
+}
+
+// shardHostsAPI lists only hosts of a single shard.
+type shardHostsAPI struct {
+	*MongodbAPI
+	shardName string
+}
+
+func (r *shardHostsAPI) ListHosts(ctx context.Context, sdk *ycsdk.SDK, diags *diag.Diagnostics, cid string) []*mongodb.Host {
+	var res []*mongodb.Host
+	for _, h := range r.MongodbAPI.ListHosts(ctx, sdk, diags, cid) {
+		if h.ShardName == r.shardName && h.Type == mongodb.Host_MONGOD {
+			res = append(res, h)
+		}
+	}
+	return res
+}
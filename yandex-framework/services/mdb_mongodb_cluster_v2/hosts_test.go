@@ -0,0 +1,150 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	"google.golang.org/protobuf/proto"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+	"google.golang.org/protobuf/types/known/wrapperspb"
+)
+
+func buildTestHostObj(typ, zone, shard string) types.Object {
+	shardName := types.StringUnknown()
+	if shard != "" {
+		shardName = types.StringValue(shard)
+	}
+	return types.ObjectValueMust(hostType.AttrTypes, map[string]attr.Value{
+		"type":                 types.StringValue(typ),
+		"zone_id":              types.StringValue(zone),
+		"subnet_id":            types.StringUnknown(),
+		"assign_public_ip":     types.BoolValue(false),
+		"shard_name":           shardName,
+		"hidden":               types.BoolValue(false),
+		"priority":             types.Float64Unknown(),
+		"secondary_delay_secs": types.Int64Value(0),
+		"tags":                 types.MapNull(types.StringType),
+		"fqdn":                 types.StringUnknown(),
+	})
+}
+
+func TestYandexProvider_MDBMongoDBClusterSplitHosts(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	hosts := types.MapValueMust(hostType, map[string]attr.Value{
+		"mongod1": buildTestHostObj(mongodb.Host_MONGOD.String(), "ru-central1-a", "rs01"),
+		"mongod2": buildTestHostObj(mongodb.Host_MONGOD.String(), "ru-central1-b", "rs02"),
+		"infra1":  buildTestHostObj(mongodb.Host_MONGOINFRA.String(), "ru-central1-a", ""),
+	})
+
+	diags := diag.Diagnostics{}
+	mongod, infra := splitHosts(ctx, hosts, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected split diagnostics: %v", diags.Errors())
+	}
+
+	if _, ok := mongod.Elements()["mongod1"]; !ok || len(mongod.Elements()) != 2 {
+		t.Errorf("Unexpected mongod hosts: %v", mongod)
+	}
+	if _, ok := infra.Elements()["infra1"]; !ok || len(infra.Elements()) != 1 {
+		t.Errorf("Unexpected infra hosts: %v", infra)
+	}
+}
+
+func TestYandexProvider_MDBMongoDBClusterManagedShards(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	cases := []struct {
+		testname string
+		hosts    types.Map
+		expected map[string]struct{}
+	}{
+		{
+			testname: "CheckNullHosts",
+			hosts:    types.MapNull(hostType),
+			expected: nil,
+		},
+		{
+			testname: "CheckUnknownShardName",
+			hosts: types.MapValueMust(hostType, map[string]attr.Value{
+				"mongod1": buildTestHostObj(mongodb.Host_MONGOD.String(), "ru-central1-a", "rs01"),
+				"mongod2": buildTestHostObj(mongodb.Host_MONGOD.String(), "ru-central1-b", ""),
+			}),
+			expected: nil,
+		},
+		{
+			testname: "CheckShards",
+			hosts: types.MapValueMust(hostType, map[string]attr.Value{
+				"mongod1": buildTestHostObj(mongodb.Host_MONGOD.String(), "ru-central1-a", "rs01"),
+				"mongod2": buildTestHostObj(mongodb.Host_MONGOD.String(), "ru-central1-b", "rs01"),
+				"infra1":  buildTestHostObj(mongodb.Host_MONGOINFRA.String(), "ru-central1-a", ""),
+			}),
+			expected: map[string]struct{}{"rs01": {}},
+		},
+	}
+
+	for _, c := range cases {
+		diags := diag.Diagnostics{}
+		res := managedShards(ctx, c.hosts, &diags)
+		if diags.HasError() {
+			t.Errorf("Unexpected diagnostics %s test: %v", c.testname, diags.Errors())
+			continue
+		}
+		if !reflect.DeepEqual(res, c.expected) {
+			t.Errorf("Unexpected result %s test: expected %v, actual %v", c.testname, c.expected, res)
+		}
+	}
+}
+
+func TestYandexProvider_MDBMongoDBClusterHostChanges(t *testing.T) {
+	t.Parallel()
+
+	state := Host{
+		Type:               types.StringValue(mongodb.Host_MONGOD.String()),
+		ZoneId:             types.StringValue("ru-central1-a"),
+		SubnetId:           types.StringValue("subnet"),
+		AssignPublicIp:     types.BoolValue(false),
+		ShardName:          types.StringValue("rs01"),
+		Hidden:             types.BoolValue(false),
+		Priority:           types.Float64Value(1),
+		SecondaryDelaySecs: types.Int64Value(0),
+		Tags:               types.MapNull(types.StringType),
+		FQDN:               types.StringValue("host.mdb.yandexcloud.net"),
+	}
+
+	plan := state
+	plan.Hidden = types.BoolValue(true)
+	plan.Priority = types.Float64Value(2)
+
+	spec, diags := mongodbHostService.GetChanges(plan, state)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags.Errors())
+	}
+
+	expected := &mongodb.UpdateHostSpec{
+		HostName:   "host.mdb.yandexcloud.net",
+		Hidden:     wrapperspb.Bool(true),
+		Priority:   wrapperspb.Double(2),
+		UpdateMask: &fieldmaskpb.FieldMask{Paths: []string{"hidden", "priority"}},
+	}
+	if !proto.Equal(spec, expected) {
+		t.Errorf("Unexpected changes: expected %v, actual %v", expected, spec)
+	}
+
+	if spec, _ := mongodbHostService.GetChanges(state, state); spec != nil {
+		t.Errorf("Unexpected changes for the same host: %v", spec)
+	}
+
+	plan = state
+	plan.ZoneId = types.StringValue("ru-central1-b")
+	if _, diags := mongodbHostService.GetChanges(plan, state); !diags.HasError() {
+		t.Errorf("Expected error on zone change")
+	}
+}
@@ -0,0 +1,165 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+type Cluster struct {
+	Id                          types.String `tfsdk:"id"`
+	FolderId                    types.String `tfsdk:"folder_id"`
+	NetworkId                   types.String `tfsdk:"network_id"`
+	Name                        types.String `tfsdk:"name"`
+	Description                 types.String `tfsdk:"description"`
+	Environment                 types.String `tfsdk:"environment"`
+	Labels                      types.Map    `tfsdk:"labels"`
+	SecurityGroupIds            types.Set    `tfsdk:"security_group_ids"`
+	DeletionProtection          types.Bool   `tfsdk:"deletion_protection"`
+	MaintenanceWindow           types.Object `tfsdk:"maintenance_window"`
+	Version                     types.String `tfsdk:"version"`
+	FeatureCompatibilityVersion types.String `tfsdk:"feature_compatibility_version"`
+	BackupWindowStart           types.Object `tfsdk:"backup_window_start"`
+	BackupRetainPeriodDays      types.Int64  `tfsdk:"backup_retain_period_days"`
+	PerformanceDiagnostics      types.Object `tfsdk:"performance_diagnostics"`
+	Access                      types.Object `tfsdk:"access"`
+	Mongod                      types.Object `tfsdk:"mongod"`
+	Mongocfg                    types.Object `tfsdk:"mongocfg"`
+	Mongos                      types.Object `tfsdk:"mongos"`
+	Mongoinfra                  types.Object `tfsdk:"mongoinfra"`
+	HostSpecs                   types.Map    `tfsdk:"hosts"`
+	Sharded                     types.Bool   `tfsdk:"sharded"`
+	CreatedAt                   types.String `tfsdk:"created_at"`
+	Health                      types.String `tfsdk:"health"`
+	Status                      types.String `tfsdk:"status"`
+}
+
+// Role describes mongod, mongocfg and mongos subclusters, they differ only by the type of config.
+type Role struct {
+	Resources           types.Object               `tfsdk:"resources"`
+	DiskSizeAutoscaling types.Object               `tfsdk:"disk_size_autoscaling"`
+	Config              mdbcommon.SettingsMapValue `tfsdk:"config"`
+}
+
+func roleAttrTypes(configType attr.Type) map[string]attr.Type {
+	return map[string]attr.Type{
+		"resources":             mdbcommon.ResourceType,
+		"disk_size_autoscaling": DiskSizeAutoscalingType,
+		"config":                configType,
+	}
+}
+
+var (
+	MongodAttrTypes   = roleAttrTypes(NewMongodSettingsMapType())
+	MongocfgAttrTypes = roleAttrTypes(NewMongocfgSettingsMapType())
+	MongosAttrTypes   = roleAttrTypes(NewMongosSettingsMapType())
+)
+
+// MongoInfra hosts run both mongos and mongocfg, so the subcluster has configs of both.
+type MongoInfra struct {
+	Resources           types.Object               `tfsdk:"resources"`
+	DiskSizeAutoscaling types.Object               `tfsdk:"disk_size_autoscaling"`
+	ConfigMongos        mdbcommon.SettingsMapValue `tfsdk:"config_mongos"`
+	ConfigMongocfg      mdbcommon.SettingsMapValue `tfsdk:"config_mongocfg"`
+}
+
+var MongoinfraAttrTypes = map[string]attr.Type{
+	"resources":             mdbcommon.ResourceType,
+	"disk_size_autoscaling": DiskSizeAutoscalingType,
+	"config_mongos":         NewMongosSettingsMapType(),
+	"config_mongocfg":       NewMongocfgSettingsMapType(),
+}
+
+type DiskSizeAutoscaling struct {
+	DiskSizeLimit           types.Int64 `tfsdk:"disk_size_limit"`
+	PlannedUsageThreshold   types.Int64 `tfsdk:"planned_usage_threshold"`
+	EmergencyUsageThreshold types.Int64 `tfsdk:"emergency_usage_threshold"`
+}
+
+var DiskSizeAutoscalingType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"disk_size_limit":           types.Int64Type,
+		"planned_usage_threshold":   types.Int64Type,
+		"emergency_usage_threshold": types.Int64Type,
+	},
+}
+
+type Access struct {
+	DataLens     types.Bool `tfsdk:"data_lens"`
+	WebSql       types.Bool `tfsdk:"web_sql"`
+	DataTransfer types.Bool `tfsdk:"data_transfer"`
+}
+
+var AccessAttrTypes = map[string]attr.Type{
+	"data_lens":     types.BoolType,
+	"web_sql":       types.BoolType,
+	"data_transfer": types.BoolType,
+}
+
+type PerformanceDiagnostics struct {
+	Enabled types.Bool `tfsdk:"enabled"`
+}
+
+var PerformanceDiagnosticsAttrTypes = map[string]attr.Type{
+	"enabled": types.BoolType,
+}
+
+type Host struct {
+	Type               types.String  `tfsdk:"type"`
+	ZoneId             types.String  `tfsdk:"zone_id"`
+	SubnetId           types.String  `tfsdk:"subnet_id"`
+	AssignPublicIp     types.Bool    `tfsdk:"assign_public_ip"`
+	ShardName          types.String  `tfsdk:"shard_name"`
+	Hidden             types.Bool    `tfsdk:"hidden"`
+	Priority           types.Float64 `tfsdk:"priority"`
+	SecondaryDelaySecs types.Int64   `tfsdk:"secondary_delay_secs"`
+	Tags               types.Map     `tfsdk:"tags"`
+	FQDN               types.String  `tfsdk:"fqdn"`
+}
+
+var hostType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"type":                 types.StringType,
+		"zone_id":              types.StringType,
+		"subnet_id":            types.StringType,
+		"assign_public_ip":     types.BoolType,
+		"shard_name":           types.StringType,
+		"hidden":               types.BoolType,
+		"priority":             types.Float64Type,
+		"secondary_delay_secs": types.Int64Type,
+		"tags":                 types.MapType{ElemType: types.StringType},
+		"fqdn":                 types.StringType,
+	},
+}
+
+type Shard struct {
+	Id        types.String `tfsdk:"id"`
+	ClusterId types.String `tfsdk:"cluster_id"`
+	Name      types.String `tfsdk:"name"`
+	HostSpecs types.Map    `tfsdk:"hosts"`
+}
+
+// ShardHost is a mongod host of a shard, its type and shard name are defined by the shard itself.
+type ShardHost struct {
+	ZoneId             types.String  `tfsdk:"zone_id"`
+	SubnetId           types.String  `tfsdk:"subnet_id"`
+	AssignPublicIp     types.Bool    `tfsdk:"assign_public_ip"`
+	Hidden             types.Bool    `tfsdk:"hidden"`
+	Priority           types.Float64 `tfsdk:"priority"`
+	SecondaryDelaySecs types.Int64   `tfsdk:"secondary_delay_secs"`
+	Tags               types.Map     `tfsdk:"tags"`
+	FQDN               types.String  `tfsdk:"fqdn"`
+}
+
+var shardHostType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"zone_id":              types.StringType,
+		"subnet_id":            types.StringType,
+		"assign_public_ip":     types.BoolType,
+		"hidden":               types.BoolType,
+		"priority":             types.Float64Type,
+		"secondary_delay_secs": types.Int64Type,
+		"tags":                 types.MapType{ElemType: types.StringType},
+		"fqdn":                 types.StringType,
+	},
+}
@@ -0,0 +1,74 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	mongo_config "github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1/config"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+// Configs of mongod, mongocfg and mongos are different protobuf messages with their own enums,
+// so each of them has its own attribute info provider.
+type settingsAttributeInfoProvider struct {
+	enumNames  map[string]map[int32]string
+	enumValues map[string]map[string]int32
+}
+
+func (p *settingsAttributeInfoProvider) GetSettingsEnumNames() map[string]map[int32]string {
+	return p.enumNames
+}
+
+func (p *settingsAttributeInfoProvider) GetSettingsEnumValues() map[string]map[string]int32 {
+	return p.enumValues
+}
+
+func (p *settingsAttributeInfoProvider) GetSetAttributes() map[string]struct{} {
+	return listAttributes
+}
+
+var listAttributes = map[string]struct{}{
+	"compressors": {},
+}
+
+var mongodAttrProvider = &settingsAttributeInfoProvider{
+	enumNames: map[string]map[int32]string{
+		"mode":                mongo_config.MongodConfig_OperationProfiling_Mode_name,
+		"block_compressor":    mongo_config.MongodConfig_Storage_WiredTiger_CollectionConfig_Compressor_name,
+		"compressors.element": mongo_config.MongodConfig_Network_Compression_Compressor_name,
+	},
+	enumValues: map[string]map[string]int32{
+		"mode":                mongo_config.MongodConfig_OperationProfiling_Mode_value,
+		"block_compressor":    mongo_config.MongodConfig_Storage_WiredTiger_CollectionConfig_Compressor_value,
+		"compressors.element": mongo_config.MongodConfig_Network_Compression_Compressor_value,
+	},
+}
+
+var mongocfgAttrProvider = &settingsAttributeInfoProvider{
+	enumNames: map[string]map[int32]string{
+		"mode":                mongo_config.MongoCfgConfig_OperationProfiling_Mode_name,
+		"compressors.element": mongo_config.MongoCfgConfig_Network_Compression_Compressor_name,
+	},
+	enumValues: map[string]map[string]int32{
+		"mode":                mongo_config.MongoCfgConfig_OperationProfiling_Mode_value,
+		"compressors.element": mongo_config.MongoCfgConfig_Network_Compression_Compressor_value,
+	},
+}
+
+var mongosAttrProvider = &settingsAttributeInfoProvider{
+	enumNames: map[string]map[int32]string{
+		"compressors.element": mongo_config.MongosConfig_Network_Compression_Compressor_name,
+	},
+	enumValues: map[string]map[string]int32{
+		"compressors.element": mongo_config.MongosConfig_Network_Compression_Compressor_value,
+	},
+}
+
+func NewMongodSettingsMapType() mdbcommon.SettingsMapType {
+	return mdbcommon.NewSettingsMapType(mongodAttrProvider)
+}
+
+func NewMongocfgSettingsMapType() mdbcommon.SettingsMapType {
+	return mdbcommon.NewSettingsMapType(mongocfgAttrProvider)
+}
+
+func NewMongosSettingsMapType() mdbcommon.SettingsMapType {
+	return mdbcommon.NewSettingsMapType(mongosAttrProvider)
+}
@@ -0,0 +1,731 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/booldefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/boolplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/float64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64default"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/int64planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/mapplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/objectplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/setplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringdefault"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	utils "github.com/yandex-cloud/terraform-provider-yandex/pkg/wrappers"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+)
+
+type clusterResource struct {
+	providerConfig *provider_config.Config
+}
+
+var (
+	_ resource.ResourceWithConfigure   = &clusterResource{}
+	_ resource.ResourceWithImportState = &clusterResource{}
+	_ resource.ResourceWithModifyPlan  = &clusterResource{}
+)
+
+func NewMongoDBClusterResourceV2() resource.Resource {
+	return &clusterResource{}
+}
+
+func (r *clusterResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_mongodb_cluster_v2"
+}
+
+func (r *clusterResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func resourcesSchema(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: description,
+		Required:    true,
+		Attributes: map[string]schema.Attribute{
+			"resource_preset_id": schema.StringAttribute{
+				Description: "The ID of the preset for computational resources available to a host (CPU, memory etc.). For more information, see [the official documentation](https://yandex.cloud/docs/managed-mongodb/concepts/instance-types).",
+				Required:    true,
+			},
+			"disk_type_id": schema.StringAttribute{
+				Description: "Type of the storage of hosts. For more information see [the official documentation](https://yandex.cloud/docs/managed-mongodb/concepts/storage).",
+				Required:    true,
+			},
+			"disk_size": schema.Int64Attribute{
+				Description: "Volume of the storage available to a host, in gigabytes.",
+				Required:    true,
+			},
+		},
+	}
+}
+
+func diskSizeAutoscalingSchema() schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		Description: "Disk autoscaling settings of the subcluster.",
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Object{
+			objectplanmodifier.UseStateForUnknown(),
+		},
+		Attributes: map[string]schema.Attribute{
+			"disk_size_limit": schema.Int64Attribute{
+				Description: "Maximum possible size of disk in gigabytes.",
+				Required:    true,
+			},
+			"planned_usage_threshold": schema.Int64Attribute{
+				Description: "Percent of disk utilization. During maintenance disk will autoscale, if this threshold reached. Value is between 0 and 100. Default value is 0 (autoscaling disabled). Must be not greater than 'emergency_usage_threshold' value.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+			"emergency_usage_threshold": schema.Int64Attribute{
+				Description: "Percent of disk utilization. Disk will autoscale immediately, if this threshold reached. Value is between 0 and 100. Default value is 0 (autoscaling disabled). Must be not less then 'planned_usage_threshold' value.",
+				Optional:    true,
+				Computed:    true,
+				Default:     int64default.StaticInt64(0),
+				Validators: []validator.Int64{
+					int64validator.Between(0, 100),
+				},
+			},
+		},
+	}
+}
+
+func settingsSchema(settingsType mdbcommon.SettingsMapType, description string) schema.MapAttribute {
+	return schema.MapAttribute{
+		CustomType:  settingsType,
+		Description: description,
+		Optional:    true,
+		Computed:    true,
+		PlanModifiers: []planmodifier.Map{
+			mapplanmodifier.UseStateForUnknown(),
+		},
+	}
+}
+
+func roleSchema(description string, required bool, settingsType mdbcommon.SettingsMapType, settingsDescription string) schema.SingleNestedAttribute {
+	s := schema.SingleNestedAttribute{
+		Description: description,
+		Required:    required,
+		Optional:    !required,
+		Attributes: map[string]schema.Attribute{
+			"resources":             resourcesSchema("Resources allocated to hosts of the subcluster."),
+			"disk_size_autoscaling": diskSizeAutoscalingSchema(),
+			"config":                settingsSchema(settingsType, settingsDescription),
+		},
+	}
+	return s
+}
+
+const (
+	mongodConfigDescription   = "User-defined settings of mongod. The keys are names of the leaf fields of `MongodConfig` message, e.g. `cache_size_gb` or `slow_op_threshold`. For more information, see [the official documentation](https://yandex.cloud/docs/managed-mongodb/concepts/settings-list)."
+	mongocfgConfigDescription = "User-defined settings of mongocfg. The keys are names of the leaf fields of `MongoCfgConfig` message. For more information, see [the official documentation](https://yandex.cloud/docs/managed-mongodb/concepts/settings-list)."
+	mongosConfigDescription   = "User-defined settings of mongos. The keys are names of the leaf fields of `MongosConfig` message. For more information, see [the official documentation](https://yandex.cloud/docs/managed-mongodb/concepts/settings-list)."
+)
+
+func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a MongoDB cluster within the Yandex Cloud. For more information, see [the official documentation](https://yandex.cloud/docs/managed-mongodb/concepts).\n\n" +
+			"Unlike `yandex_mdb_mongodb_cluster`, the resource has no inline databases and users: use `yandex_mdb_mongodb_database` and `yandex_mdb_mongodb_user` to manage them. " +
+			"Shards of a sharded cluster can be declared in `hosts` or managed separately with `yandex_mdb_mongodb_shard`. " +
+			"Hosts of shards which are not declared in `hosts` are not tracked by the cluster.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				Description: common.ResourceDescriptions["id"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"name": schema.StringAttribute{
+				Description: "Name of the MongoDB cluster. Provided by the client when the cluster is created.",
+				Required:    true,
+			},
+			"description": schema.StringAttribute{
+				Description: "Description of the MongoDB cluster.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(""),
+			},
+			"folder_id": schema.StringAttribute{
+				Description: common.ResourceDescriptions["folder_id"],
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"network_id": schema.StringAttribute{
+				Description: common.ResourceDescriptions["network_id"],
+				Required:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"environment": schema.StringAttribute{
+				Description: "Deployment environment of the MongoDB cluster. Can be either `PRESTABLE` or `PRODUCTION`. The default is `PRODUCTION`.",
+				Optional:    true,
+				Computed:    true,
+				Default:     stringdefault.StaticString(mongodb.Cluster_PRODUCTION.String()),
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+				Validators: []validator.String{
+					stringvalidator.OneOf(mongodb.Cluster_PRODUCTION.String(), mongodb.Cluster_PRESTABLE.String()),
+				},
+			},
+			"labels": schema.MapAttribute{
+				Description: common.ResourceDescriptions["labels"],
+				Optional:    true,
+				ElementType: types.StringType,
+			},
+			"security_group_ids": schema.SetAttribute{
+				Description: common.ResourceDescriptions["security_group_ids"],
+				Optional:    true,
+				Computed:    true,
+				ElementType: types.StringType,
+				PlanModifiers: []planmodifier.Set{
+					setplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"deletion_protection": schema.BoolAttribute{
+				Description: common.ResourceDescriptions["deletion_protection"],
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Bool{
+					boolplanmodifier.UseStateForUnknown(),
+				},
+			},
+			// Optional nested attribute maintenance_window required all optional nested attributes
+			// But if the block is specified explicitly, then the type attribute is required
+			"maintenance_window": schema.SingleNestedAttribute{
+				Description: "Maintenance policy of the MongoDB cluster.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Validators: []validator.Object{
+					NewMaintenanceWindowStructValidator(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"type": schema.StringAttribute{
+						Description: "Type of maintenance window. Can be either ANYTIME or WEEKLY. A day and hour of window need to be specified with weekly window.",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf("ANYTIME", "WEEKLY"),
+						},
+					},
+					"day": schema.StringAttribute{
+						Description: "Day of the week (in DDD format). Allowed values: \"MON\", \"TUE\", \"WED\", \"THU\", \"FRI\", \"SAT\",\"SUN\"",
+						Optional:    true,
+						Validators: []validator.String{
+							stringvalidator.OneOf(
+								"MON", "TUE",
+								"WED", "THU",
+								"FRI", "SAT",
+								"SUN",
+							),
+						},
+					},
+					"hour": schema.Int64Attribute{
+						Description: "Hour of the day in UTC (in HH format). Allowed value is between 1 and 24.",
+						Optional:    true,
+						Validators: []validator.Int64{
+							int64validator.Between(1, 24),
+						},
+					},
+				},
+			},
+			"version": schema.StringAttribute{
+				Description: "Version of the MongoDB server software.",
+				Required:    true,
+			},
+			"feature_compatibility_version": schema.StringAttribute{
+				Description: "Feature compatibility version of the MongoDB cluster. The default is the same as `version`.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"backup_window_start": schema.SingleNestedAttribute{
+				Description: "Time to start the daily backup, in the UTC timezone.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"hours": schema.Int64Attribute{
+						Description: "The hour at which backup will be started (UTC).",
+						Computed:    true,
+						Optional:    true,
+						Default:     int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 23),
+						},
+					},
+					"minutes": schema.Int64Attribute{
+						Description: "The minute at which backup will be started (UTC).",
+						Computed:    true,
+						Optional:    true,
+						Default:     int64default.StaticInt64(0),
+						Validators: []validator.Int64{
+							int64validator.Between(0, 59),
+						},
+					},
+				},
+			},
+			"backup_retain_period_days": schema.Int64Attribute{
+				Description: "The period in days during which backups are stored.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Int64{
+					int64planmodifier.UseStateForUnknown(),
+				},
+			},
+			"performance_diagnostics": schema.SingleNestedAttribute{
+				Description: "Performance diagnostics to the MongoDB cluster.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"enabled": schema.BoolAttribute{
+						Description: "Enable or disable performance diagnostics.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+				},
+			},
+			"access": schema.SingleNestedAttribute{
+				Description: "Access policy to the MongoDB cluster.",
+				Optional:    true,
+				Computed:    true,
+				PlanModifiers: []planmodifier.Object{
+					objectplanmodifier.UseStateForUnknown(),
+				},
+				Attributes: map[string]schema.Attribute{
+					"data_lens": schema.BoolAttribute{
+						Description: "Allow access for Yandex DataLens.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+					"web_sql": schema.BoolAttribute{
+						Description: "Allow access for SQL queries in the management console.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+					"data_transfer": schema.BoolAttribute{
+						Description: "Allow access for DataTransfer.",
+						Optional:    true,
+						Computed:    true,
+						Default:     booldefault.StaticBool(false),
+					},
+				},
+			},
+			"mongod":   roleSchema("Configuration of the mongod subcluster.", true, NewMongodSettingsMapType(), mongodConfigDescription),
+			"mongocfg": roleSchema("Configuration of the mongocfg subcluster of a sharded cluster.", false, NewMongocfgSettingsMapType(), mongocfgConfigDescription),
+			"mongos":   roleSchema("Configuration of the mongos subcluster of a sharded cluster.", false, NewMongosSettingsMapType(), mongosConfigDescription),
+			"mongoinfra": schema.SingleNestedAttribute{
+				Description: "Configuration of the mongoinfra subcluster of a sharded cluster. Mongoinfra hosts run both mongos and mongocfg.",
+				Optional:    true,
+				Attributes: map[string]schema.Attribute{
+					"resources":             resourcesSchema("Resources allocated to hosts of the subcluster."),
+					"disk_size_autoscaling": diskSizeAutoscalingSchema(),
+					"config_mongos":         settingsSchema(NewMongosSettingsMapType(), mongosConfigDescription),
+					"config_mongocfg":       settingsSchema(NewMongocfgSettingsMapType(), mongocfgConfigDescription),
+				},
+			},
+			"hosts": schema.MapNestedAttribute{
+				Description: "A host configuration of the MongoDB cluster as label:host_info pairs. Hosts of types other than `MONGOD` make the cluster sharded.",
+				Required:    true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: hostAttributes(true),
+				},
+			},
+			"sharded": schema.BoolAttribute{
+				Description: "Whether the cluster is sharded. It becomes `true` once hosts of types other than `MONGOD` are added.",
+				Computed:    true,
+			},
+			"created_at": schema.StringAttribute{
+				Description: common.ResourceDescriptions["created_at"],
+				Computed:    true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"health": schema.StringAttribute{
+				Description: "Aggregated health of the cluster. For more information see `health` field of JSON representation in [the official documentation](https://yandex.cloud/docs/managed-mongodb/api-ref/Cluster/).",
+				Computed:    true,
+			},
+			"status": schema.StringAttribute{
+				Description: "Status of the cluster. For more information see `status` field of JSON representation in [the official documentation](https://yandex.cloud/docs/managed-mongodb/api-ref/Cluster/).",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// hostAttributes describes a host of the cluster, hosts of a shard have neither type nor shard name.
+func hostAttributes(withTypeAndShard bool) map[string]schema.Attribute {
+	attrs := map[string]schema.Attribute{
+		"zone_id": schema.StringAttribute{
+			Description: "The availability zone where the host is located.",
+			Required:    true,
+		},
+		"subnet_id": schema.StringAttribute{
+			Description: "ID of the subnet where the host is located.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+		"assign_public_ip": schema.BoolAttribute{
+			Description: "Assign a public IP address to the host.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+		"hidden": schema.BoolAttribute{
+			Description: "Whether the host is hidden from the clients of the replica set.",
+			Optional:    true,
+			Computed:    true,
+			Default:     booldefault.StaticBool(false),
+		},
+		"priority": schema.Float64Attribute{
+			Description: "Priority of the host to be elected as primary in the replica set.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.Float64{
+				float64planmodifier.UseStateForUnknown(),
+			},
+		},
+		"secondary_delay_secs": schema.Int64Attribute{
+			Description: "The number of seconds by which the host lags behind the primary.",
+			Optional:    true,
+			Computed:    true,
+			Default:     int64default.StaticInt64(0),
+		},
+		"tags": schema.MapAttribute{
+			Description: "Host tags.",
+			Optional:    true,
+			ElementType: types.StringType,
+		},
+		"fqdn": schema.StringAttribute{
+			Description: "The fully qualified domain name of the host.",
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		},
+	}
+
+	if withTypeAndShard {
+		attrs["type"] = schema.StringAttribute{
+			Description: "Type of the host. Can be `MONGOD`, `MONGOCFG`, `MONGOS` or `MONGOINFRA`. The default is `MONGOD`.",
+			Optional:    true,
+			Computed:    true,
+			Default:     stringdefault.StaticString(mongodb.Host_MONGOD.String()),
+			Validators: []validator.String{
+				stringvalidator.OneOf(
+					mongodb.Host_MONGOD.String(),
+					mongodb.Host_MONGOCFG.String(),
+					mongodb.Host_MONGOS.String(),
+					mongodb.Host_MONGOINFRA.String(),
+				),
+			},
+		}
+		attrs["shard_name"] = schema.StringAttribute{
+			Description: "The name of the shard to which the `MONGOD` host belongs. Only for sharded clusters, the API assigns the name of the only shard otherwise.",
+			Optional:    true,
+			Computed:    true,
+			PlanModifiers: []planmodifier.String{
+				stringplanmodifier.UseStateForUnknown(),
+			},
+		}
+	}
+	return attrs
+}
+
+func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() {
+		return
+	}
+
+	var plan Cluster
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	var state *Cluster
+	if !req.State.Raw.IsNull() {
+		state = &Cluster{}
+		resp.Diagnostics.Append(req.State.Get(ctx, state)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	if utils.IsPresent(plan.HostSpecs) {
+		_, infraHosts := splitHosts(ctx, plan.HostSpecs, &resp.Diagnostics)
+		plan.Sharded = types.BoolValue(len(infraHosts.Elements()) > 0 || (state != nil && state.Sharded.ValueBool()))
+	}
+
+	if state != nil {
+		// remove changes on disk_size from plan if enabled autoscaling
+		plan.Mongod = fixDiskSizeOnAutoscaling(ctx, plan.Mongod, state.Mongod, &resp.Diagnostics)
+		plan.Mongocfg = fixDiskSizeOnAutoscaling(ctx, plan.Mongocfg, state.Mongocfg, &resp.Diagnostics)
+		plan.Mongos = fixDiskSizeOnAutoscaling(ctx, plan.Mongos, state.Mongos, &resp.Diagnostics)
+		plan.Mongoinfra = fixDiskSizeOnAutoscaling(ctx, plan.Mongoinfra, state.Mongoinfra, &resp.Diagnostics)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	resp.Diagnostics.Append(resp.Plan.Set(ctx, plan)...)
+}
+
+func fixDiskSizeOnAutoscaling(ctx context.Context, plan, state types.Object, diags *diag.Diagnostics) types.Object {
+	if !utils.IsPresent(plan) || !utils.IsPresent(state) {
+		return plan
+	}
+
+	planAttrs := plan.Attributes()
+	stateAttrs := state.Attributes()
+
+	var dsa DiskSizeAutoscaling
+	if s, ok := stateAttrs["disk_size_autoscaling"].(types.Object); ok && utils.IsPresent(s) {
+		diags.Append(s.As(ctx, &dsa, datasize.UnhandledOpts)...)
+	}
+	autoscalingOn := dsa.DiskSizeLimit.ValueInt64() > 0
+
+	pr, _ := planAttrs["resources"].(types.Object)
+	sr, _ := stateAttrs["resources"].(types.Object)
+	if !utils.IsPresent(pr) || !utils.IsPresent(sr) {
+		return plan
+	}
+
+	newAttrs := make(map[string]attr.Value, len(planAttrs))
+	for k, v := range planAttrs {
+		newAttrs[k] = v
+	}
+	newAttrs["resources"] = mdbcommon.FixDiskSizeOnAutoscalingChanges(ctx, pr, sr, autoscalingOn, diags)
+
+	obj, d := types.ObjectValue(plan.AttributeTypes(ctx), newAttrs)
+	diags.Append(d...)
+	return obj
+}
+
+func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Cluster
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refreshResourceState(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	d := resp.State.Set(ctx, &state)
+	resp.Diagnostics.Append(d...)
+}
+
+func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Cluster
+	diags := req.Plan.Get(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Creating MongoDB Cluster")
+
+	request, diags := prepareCreateRequest(ctx, &plan, &r.providerConfig.ProviderState)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := mongodbApi.CreateCluster(ctx, r.providerConfig.SDK, &resp.Diagnostics, request)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(cid)
+
+	r.refreshResourceState(ctx, &plan, &resp.Diagnostics)
+	diags = resp.State.Set(ctx, &plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan Cluster
+	var state Cluster
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	tflog.Debug(ctx, "Updating MongoDB Cluster", map[string]interface{}{"id": plan.Id.ValueString()})
+
+	updateRequest, d := prepareUpdateRequest(ctx, &state, &plan)
+	resp.Diagnostics.Append(d...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mongodbApi.UpdateCluster(ctx, r.providerConfig.SDK, &resp.Diagnostics, updateRequest)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	planMongod, planInfra := splitHosts(ctx, plan.HostSpecs, &resp.Diagnostics)
+	stateMongod, stateInfra := splitHosts(ctx, state.HostSpecs, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	infraHostsCreated := false
+	if !state.Sharded.ValueBool() && len(planInfra.Elements()) > 0 {
+		tflog.Debug(ctx, "Enabling sharding on MongoDB Cluster", map[string]interface{}{"id": plan.Id.ValueString()})
+
+		enableShardingRequest := expandEnableShardingRequest(ctx, &plan, planInfra, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		mongodbApi.EnableSharding(ctx, r.providerConfig.SDK, &resp.Diagnostics, enableShardingRequest)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+		infraHostsCreated = true
+	}
+
+	mdbcommon.UpdateClusterHostsWithShards[Host, *mongodb.Host, *mongodb.HostSpec, mongodb.UpdateHostSpec](
+		ctx,
+		r.providerConfig.SDK,
+		&resp.Diagnostics,
+		mongodbHostService,
+		&mongodbApi,
+		plan.Id.ValueString(),
+		planMongod,
+		stateMongod,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if !infraHostsCreated {
+		mdbcommon.UpdateClusterHosts[Host, *mongodb.Host, *mongodb.HostSpec, mongodb.UpdateHostSpec](
+			ctx,
+			r.providerConfig.SDK,
+			&resp.Diagnostics,
+			mongodbHostService,
+			&mongodbApi,
+			plan.Id.ValueString(),
+			planInfra,
+			stateInfra,
+		)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	r.refreshResourceState(ctx, &plan, &resp.Diagnostics)
+	diags := resp.State.Set(ctx, plan)
+	resp.Diagnostics.Append(diags...)
+}
+
+func (r *clusterResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Cluster
+	diags := req.State.Get(ctx, &state)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mongodbApi.DeleteCluster(ctx, r.providerConfig.SDK, &resp.Diagnostics, state.Id.ValueString())
+}
+
+func (r *clusterResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	resp.Diagnostics.AddWarning(
+		"Hosts of all shards are imported",
+		"Remove hosts of the shards managed by yandex_mdb_mongodb_shard from the imported state, otherwise they will be deleted on the next apply.",
+	)
+	resource.ImportStatePassthroughID(ctx, path.Root("id"), req, resp)
+}
+
+func (r *clusterResource) refreshResourceState(ctx context.Context, state *Cluster, respDiagnostics *diag.Diagnostics) {
+	cid := state.Id.ValueString()
+	cluster := mongodbApi.GetCluster(ctx, r.providerConfig.SDK, respDiagnostics, cid)
+	if respDiagnostics.HasError() {
+		return
+	}
+
+	hostsApi := &clusterHostsAPI{
+		MongodbAPI: &mongodbApi,
+		shards:     managedShards(ctx, state.HostSpecs, respDiagnostics),
+	}
+	entityIdToApiHosts := mdbcommon.ReadHosts(ctx, r.providerConfig.SDK, respDiagnostics, mongodbHostService, hostsApi, state.HostSpecs, cid)
+	if respDiagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+	state.HostSpecs, diags = types.MapValueFrom(ctx, hostType, entityIdToApiHosts)
+	respDiagnostics.Append(diags...)
+	if respDiagnostics.HasError() {
+		return
+	}
+
+	flattenCluster(ctx, state, cluster, respDiagnostics)
+}
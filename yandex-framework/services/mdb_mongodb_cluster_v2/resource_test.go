@@ -0,0 +1,362 @@
+package mdb_mongodb_cluster_v2_test
+
+import (
+	"context"
+	"fmt"
+	"strings"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/knownvalue"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+	"github.com/hashicorp/terraform-plugin-testing/statecheck"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/hashicorp/terraform-plugin-testing/tfjsonpath"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+const (
+	defaultMDBPageSize                   = 1000
+	mgResource                           = "yandex_mdb_mongodb_cluster_v2.foo"
+	mgShardResource                      = "yandex_mdb_mongodb_shard.bar"
+	yandexMDBMongoDBClusterDeleteTimeout = 60 * time.Minute
+)
+
+const mgVPCDependencies = `
+resource "yandex_vpc_network" "mdb-mongodb-test-net" {}
+
+resource "yandex_vpc_subnet" "mdb-mongodb-test-subnet-a" {
+  zone           = "ru-central1-a"
+  network_id     = yandex_vpc_network.mdb-mongodb-test-net.id
+  v4_cidr_blocks = ["10.1.0.0/24"]
+}
+
+resource "yandex_vpc_subnet" "mdb-mongodb-test-subnet-b" {
+  zone           = "ru-central1-b"
+  network_id     = yandex_vpc_network.mdb-mongodb-test-net.id
+  v4_cidr_blocks = ["10.2.0.0/24"]
+}
+`
+
+func init() {
+	resource.AddTestSweepers("yandex_mdb_mongodb_cluster_v2", &resource.Sweeper{
+		Name: "yandex_mdb_mongodb_cluster_v2",
+		F:    testSweepMDBMongoDBCluster,
+	})
+}
+
+// TestMain - add sweepers flag to the go test command
+// important for sweepers run.
+func TestMain(m *testing.M) {
+	resource.TestMain(m)
+}
+
+func testSweepMDBMongoDBCluster(_ string) error {
+	conf, err := test.ConfigForSweepers()
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	resp, err := conf.SDK.MDB().MongoDB().Cluster().List(context.Background(), &mongodb.ListClustersRequest{
+		FolderId: conf.ProviderState.FolderID.ValueString(),
+		PageSize: defaultMDBPageSize,
+	})
+	if err != nil {
+		return fmt.Errorf("error getting MongoDB clusters: %s", err)
+	}
+
+	result := &multierror.Error{}
+	for _, c := range resp.Clusters {
+		if !sweepMDBMongoDBCluster(conf, c.Id) {
+			result = multierror.Append(result, fmt.Errorf("failed to sweep MongoDB cluster %q", c.Id))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func sweepMDBMongoDBCluster(conf *config.Config, id string) bool {
+	return test.SweepWithRetry(sweepMDBMongoDBClusterOnce, conf, "MongoDB cluster", id)
+}
+
+func sweepMDBMongoDBClusterOnce(conf *config.Config, id string) error {
+	ctx, cancel := context.WithTimeout(context.Background(), yandexMDBMongoDBClusterDeleteTimeout)
+	defer cancel()
+
+	mask := field_mask.FieldMask{Paths: []string{"deletion_protection"}}
+
+	op, err := conf.SDK.MDB().MongoDB().Cluster().Update(ctx, &mongodb.UpdateClusterRequest{
+		ClusterId:          id,
+		DeletionProtection: false,
+		UpdateMask:         &mask,
+	})
+	err = test.HandleSweepOperation(ctx, conf, op, err)
+	if err != nil && !strings.EqualFold(test.ErrorMessage(err), "no changes detected") {
+		return err
+	}
+
+	op, err = conf.SDK.MDB().MongoDB().Cluster().Delete(ctx, &mongodb.DeleteClusterRequest{
+		ClusterId: id,
+	})
+	return test.HandleSweepOperation(ctx, conf, op, err)
+}
+
+func mdbMongoDBClusterImportStep(name string) resource.TestStep {
+	return resource.TestStep{
+		ResourceName:      name,
+		ImportState:       true,
+		ImportStateVerify: true,
+		ImportStateVerifyIgnore: []string{
+			"health", // volatile value
+			"hosts",  // labels of hosts are not restored on import
+		},
+	}
+}
+
+// Test that a MongoDB Cluster can be created, updated and destroyed
+// together with a database and a user
+func TestAccMDBMongoDBCluster_basic(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-mongodb-cluster-basic")
+	folderID := test.GetExampleFolderID()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBMongoDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBMongoDBClusterBasic(clusterName, "MongoDB Cluster Terraform Test", 10, `slow_op_threshold = 200`),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(mgResource, tfjsonpath.New("name"), knownvalue.StringExact(clusterName)),
+					statecheck.ExpectKnownValue(mgResource, tfjsonpath.New("folder_id"), knownvalue.StringExact(folderID)),
+					statecheck.ExpectKnownValue(mgResource, tfjsonpath.New("environment"), knownvalue.StringExact("PRESTABLE")),
+					statecheck.ExpectKnownValue(mgResource, tfjsonpath.New("sharded"), knownvalue.Bool(false)),
+					statecheck.ExpectKnownValue(mgResource, tfjsonpath.New("mongod").AtMapKey("resources").AtMapKey("disk_size"), knownvalue.Int64Exact(10)),
+					statecheck.ExpectKnownValue(mgResource, tfjsonpath.New("mongod").AtMapKey("config"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"slow_op_threshold": knownvalue.StringExact("200"),
+					})),
+					statecheck.ExpectKnownValue(mgResource, tfjsonpath.New("hosts"), knownvalue.MapSizeExact(1)),
+					statecheck.ExpectKnownValue("yandex_mdb_mongodb_database.foo", tfjsonpath.New("name"), knownvalue.StringExact("testdb")),
+					statecheck.ExpectKnownValue("yandex_mdb_mongodb_user.foo", tfjsonpath.New("name"), knownvalue.StringExact("alice")),
+				},
+			},
+			mdbMongoDBClusterImportStep(mgResource),
+			{
+				Config: testAccMDBMongoDBClusterBasic(clusterName, "MongoDB Cluster Terraform Test Updated", 12, `slow_op_threshold = 300`),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(mgResource, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(mgResource, tfjsonpath.New("description"), knownvalue.StringExact("MongoDB Cluster Terraform Test Updated")),
+					statecheck.ExpectKnownValue(mgResource, tfjsonpath.New("mongod").AtMapKey("resources").AtMapKey("disk_size"), knownvalue.Int64Exact(12)),
+					statecheck.ExpectKnownValue(mgResource, tfjsonpath.New("mongod").AtMapKey("config"), knownvalue.MapExact(map[string]knownvalue.Check{
+						"slow_op_threshold": knownvalue.StringExact("300"),
+					})),
+				},
+			},
+		},
+	})
+}
+
+// Test that sharding can be enabled on a MongoDB Cluster
+// and a shard can be added with a separate resource
+func TestAccMDBMongoDBCluster_sharded(t *testing.T) {
+	t.Parallel()
+
+	clusterName := acctest.RandomWithPrefix("tf-mongodb-cluster-sharded")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckMDBMongoDBClusterDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccMDBMongoDBClusterBasic(clusterName, "", 10, ``),
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(mgResource, tfjsonpath.New("sharded"), knownvalue.Bool(false)),
+				},
+			},
+			{
+				Config: testAccMDBMongoDBClusterSharded(clusterName),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(mgResource, plancheck.ResourceActionUpdate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(mgResource, tfjsonpath.New("sharded"), knownvalue.Bool(true)),
+					statecheck.ExpectKnownValue(mgResource, tfjsonpath.New("hosts"), knownvalue.MapSizeExact(3)),
+				},
+			},
+			{
+				Config: testAccMDBMongoDBClusterSharded(clusterName) + testAccMDBMongoDBShard(),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction(mgResource, plancheck.ResourceActionNoop),
+						plancheck.ExpectResourceAction(mgShardResource, plancheck.ResourceActionCreate),
+					},
+				},
+				ConfigStateChecks: []statecheck.StateCheck{
+					statecheck.ExpectKnownValue(mgShardResource, tfjsonpath.New("name"), knownvalue.StringExact("rs02")),
+					statecheck.ExpectKnownValue(mgShardResource, tfjsonpath.New("hosts"), knownvalue.MapSizeExact(1)),
+					// hosts of the shard resource don't appear in the cluster
+					statecheck.ExpectKnownValue(mgResource, tfjsonpath.New("hosts"), knownvalue.MapSizeExact(3)),
+				},
+			},
+			{
+				ResourceName:            mgShardResource,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"hosts"},
+			},
+		},
+	})
+}
+
+func testAccCheckMDBMongoDBClusterDestroy(s *terraform.State) error {
+	conf := test.AccProvider.(*provider.Provider).GetConfig()
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_mdb_mongodb_cluster_v2" {
+			continue
+		}
+
+		_, err := conf.SDK.MDB().MongoDB().Cluster().Get(context.Background(), &mongodb.GetClusterRequest{
+			ClusterId: rs.Primary.ID,
+		})
+
+		if err == nil {
+			return fmt.Errorf("MongoDB Cluster still exists")
+		}
+	}
+
+	return nil
+}
+
+const mgMongodResources = `
+  mongod = {
+    resources = {
+      resource_preset_id = "s2.micro"
+      disk_type_id       = "network-ssd"
+      disk_size          = %d
+    }
+    config = {
+      %s
+    }
+  }
+`
+
+func testAccMDBMongoDBClusterBasic(name, description string, diskSize int, mongodConfig string) string {
+	return fmt.Sprintf(mgVPCDependencies+`
+resource "yandex_mdb_mongodb_cluster_v2" "foo" {
+  name        = "%s"
+  description = "%s"
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.mdb-mongodb-test-net.id
+
+  version = "6.0"
+`+mgMongodResources+`
+  hosts = {
+    "mongod1" = {
+      zone_id   = "ru-central1-a"
+      subnet_id = yandex_vpc_subnet.mdb-mongodb-test-subnet-a.id
+    }
+  }
+}
+
+resource "yandex_mdb_mongodb_database" "foo" {
+  cluster_id = yandex_mdb_mongodb_cluster_v2.foo.id
+  name       = "testdb"
+}
+
+resource "yandex_mdb_mongodb_user" "foo" {
+  cluster_id = yandex_mdb_mongodb_cluster_v2.foo.id
+  name       = "alice"
+  password   = "mysecurepassword"
+  permission {
+    database_name = yandex_mdb_mongodb_database.foo.name
+    roles         = ["readWrite"]
+  }
+}
+`, name, description, diskSize, mongodConfig)
+}
+
+func testAccMDBMongoDBClusterSharded(name string) string {
+	return fmt.Sprintf(mgVPCDependencies+`
+resource "yandex_mdb_mongodb_cluster_v2" "foo" {
+  name        = "%s"
+  description = ""
+  environment = "PRESTABLE"
+  network_id  = yandex_vpc_network.mdb-mongodb-test-net.id
+
+  version = "6.0"
+`+mgMongodResources+`
+  mongoinfra = {
+    resources = {
+      resource_preset_id = "s2.micro"
+      disk_type_id       = "network-ssd"
+      disk_size          = 10
+    }
+  }
+
+  hosts = {
+    "mongod1" = {
+      zone_id   = "ru-central1-a"
+      subnet_id = yandex_vpc_subnet.mdb-mongodb-test-subnet-a.id
+    }
+    "infra1" = {
+      type      = "MONGOINFRA"
+      zone_id   = "ru-central1-a"
+      subnet_id = yandex_vpc_subnet.mdb-mongodb-test-subnet-a.id
+    }
+    "infra2" = {
+      type      = "MONGOINFRA"
+      zone_id   = "ru-central1-b"
+      subnet_id = yandex_vpc_subnet.mdb-mongodb-test-subnet-b.id
+    }
+  }
+}
+
+resource "yandex_mdb_mongodb_database" "foo" {
+  cluster_id = yandex_mdb_mongodb_cluster_v2.foo.id
+  name       = "testdb"
+}
+
+resource "yandex_mdb_mongodb_user" "foo" {
+  cluster_id = yandex_mdb_mongodb_cluster_v2.foo.id
+  name       = "alice"
+  password   = "mysecurepassword"
+  permission {
+    database_name = yandex_mdb_mongodb_database.foo.name
+    roles         = ["readWrite"]
+  }
+}
+`, name, 10, "")
+}
+
+func testAccMDBMongoDBShard() string {
+	return `
+resource "yandex_mdb_mongodb_shard" "bar" {
+  cluster_id = yandex_mdb_mongodb_cluster_v2.foo.id
+  name       = "rs02"
+
+  hosts = {
+    "mongod1" = {
+      zone_id   = "ru-central1-b"
+      subnet_id = yandex_vpc_subnet.mdb-mongodb-test-subnet-b.id
+    }
+  }
+}
+`
+}
@@ -0,0 +1,240 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/mapvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/resource"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/planmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/resource/schema/stringplanmodifier"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/resourceid"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	"google.golang.org/grpc/codes"
+)
+
+type shardResource struct {
+	providerConfig *provider_config.Config
+}
+
+var (
+	_ resource.ResourceWithConfigure   = &shardResource{}
+	_ resource.ResourceWithImportState = &shardResource{}
+)
+
+func NewMongoDBShardResource() resource.Resource {
+	return &shardResource{}
+}
+
+func (r *shardResource) Metadata(_ context.Context, req resource.MetadataRequest, resp *resource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_mdb_mongodb_shard"
+}
+
+func (r *shardResource) Configure(_ context.Context,
+	req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	r.providerConfig = providerConfig
+}
+
+func (r *shardResource) Schema(_ context.Context, _ resource.SchemaRequest, resp *resource.SchemaResponse) {
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Manages a shard of a sharded MongoDB cluster within the Yandex Cloud. For more information, see [the official documentation](https://yandex.cloud/docs/managed-mongodb/concepts/sharding).\n\n" +
+			"~> Hosts of the shard must not be declared in `hosts` of `yandex_mdb_mongodb_cluster_v2`.",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: common.ResourceDescriptions["id"],
+				Computed:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.UseStateForUnknown(),
+				},
+			},
+			"cluster_id": schema.StringAttribute{
+				MarkdownDescription: "The ID of MongoDB Cluster.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"name": schema.StringAttribute{
+				MarkdownDescription: "The name of the shard.",
+				Required:            true,
+				PlanModifiers: []planmodifier.String{
+					stringplanmodifier.RequiresReplace(),
+				},
+			},
+			"hosts": schema.MapNestedAttribute{
+				MarkdownDescription: "A host configuration of the shard as label:host_info pairs. All hosts of the shard are of `MONGOD` type.",
+				Required:            true,
+				Validators: []validator.Map{
+					mapvalidator.SizeAtLeast(1),
+				},
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: hostAttributes(false),
+				},
+			},
+		},
+	}
+}
+
+func (r *shardResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
+	var state Shard
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := state.ClusterId.ValueString()
+	shardName := state.Name.ValueString()
+	_, err := r.providerConfig.SDK.MDB().MongoDB().Cluster().GetShard(ctx, &mongodb.GetClusterShardRequest{
+		ClusterId: cid,
+		ShardName: shardName,
+	})
+	if err != nil {
+		f := resp.Diagnostics.AddError
+		if validate.IsStatusWithCode(err, codes.NotFound) {
+			resp.State.RemoveResource(ctx)
+			f = resp.Diagnostics.AddWarning
+		}
+
+		f(
+			"Failed to Read resource",
+			"Error while requesting API to get MongoDB shard:"+err.Error(),
+		)
+		return
+	}
+
+	r.refreshResourceState(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *shardResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
+	var plan Shard
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	cid := plan.ClusterId.ValueString()
+	shardName := plan.Name.ValueString()
+	tflog.Debug(ctx, "Creating MongoDB shard", map[string]interface{}{"cluster_id": cid, "name": shardName})
+
+	hostSpecs, diags := mdbcommon.CreateClusterHosts(ctx, shardHostService{shardName: shardName}, plan.HostSpecs)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mongodbApi.CreateShard(ctx, r.providerConfig.SDK, &resp.Diagnostics, cid, shardName, hostSpecs)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.Id = types.StringValue(resourceid.Construct(cid, shardName))
+	r.refreshResourceState(ctx, &plan, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *shardResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
+	var plan, state Shard
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	shardName := plan.Name.ValueString()
+	mdbcommon.UpdateClusterHosts[ShardHost, *mongodb.Host, *mongodb.HostSpec, mongodb.UpdateHostSpec](
+		ctx,
+		r.providerConfig.SDK,
+		&resp.Diagnostics,
+		shardHostService{shardName: shardName},
+		&shardHostsAPI{MongodbAPI: &mongodbApi, shardName: shardName},
+		plan.ClusterId.ValueString(),
+		plan.HostSpecs,
+		state.HostSpecs,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	r.refreshResourceState(ctx, &plan, &resp.Diagnostics)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+func (r *shardResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
+	var state Shard
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	mongodbApi.DeleteShard(ctx, r.providerConfig.SDK, &resp.Diagnostics, state.ClusterId.ValueString(), state.Name.ValueString())
+}
+
+func (r *shardResource) ImportState(ctx context.Context, req resource.ImportStateRequest, resp *resource.ImportStateResponse) {
+	clusterId, shardName, err := resourceid.Deconstruct(req.ID)
+	if err != nil {
+		resp.Diagnostics.AddError(
+			"Unexpected Import Identifier",
+			err.Error(),
+		)
+		return
+	}
+
+	shard := mongodbApi.GetShard(ctx, r.providerConfig.SDK, &resp.Diagnostics, clusterId, shardName)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	state := Shard{
+		Id:        types.StringValue(resourceid.Construct(shard.ClusterId, shard.Name)),
+		ClusterId: types.StringValue(shard.ClusterId),
+		Name:      types.StringValue(shard.Name),
+		HostSpecs: types.MapNull(shardHostType),
+	}
+	r.refreshResourceState(ctx, &state, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+func (r *shardResource) refreshResourceState(ctx context.Context, state *Shard, respDiagnostics *diag.Diagnostics) {
+	cid := state.ClusterId.ValueString()
+	shardName := state.Name.ValueString()
+
+	hostsApi := &shardHostsAPI{MongodbAPI: &mongodbApi, shardName: shardName}
+	entityIdToApiHosts := mdbcommon.ReadHosts(ctx, r.providerConfig.SDK, respDiagnostics, shardHostService{shardName: shardName}, hostsApi, state.HostSpecs, cid)
+	if respDiagnostics.HasError() {
+		return
+	}
+
+	var diags diag.Diagnostics
+	state.HostSpecs, diags = types.MapValueFrom(ctx, shardHostType, entityIdToApiHosts)
+	respDiagnostics.Append(diags...)
+	state.Id = types.StringValue(resourceid.Construct(cid, shardName))
+}
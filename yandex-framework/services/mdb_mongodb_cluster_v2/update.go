@@ -0,0 +1,150 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"context"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/mongodb/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/datasize"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+	utils "github.com/yandex-cloud/terraform-provider-yandex/pkg/wrappers"
+	"google.golang.org/genproto/protobuf/field_mask"
+)
+
+// roleUpdatePaths returns update mask paths of the changed attributes of a subcluster.
+// Config is replaced as a whole, so settings removed from the map are reset to defaults.
+func roleUpdatePaths(ctx context.Context, prefix string, plan, state types.Object, configAttrs []string, diags *diag.Diagnostics) []string {
+	if plan.Equal(state) || !utils.IsPresent(plan) {
+		return nil
+	}
+
+	planAttrs := plan.Attributes()
+	stateAttrs := map[string]attr.Value{}
+	if utils.IsPresent(state) {
+		stateAttrs = state.Attributes()
+	}
+
+	var paths []string
+	if pr, ok := planAttrs["resources"].(types.Object); ok {
+		sr, _ := stateAttrs["resources"].(types.Object)
+		paths = append(paths, resourcesUpdatePaths(ctx, prefix, pr, sr, diags)...)
+	}
+	if pd, ok := planAttrs["disk_size_autoscaling"]; ok && utils.IsPresent(pd) && !pd.Equal(stateAttrs["disk_size_autoscaling"]) {
+		paths = append(paths, prefix+".disk_size_autoscaling")
+	}
+	for _, name := range configAttrs {
+		pc, ok := planAttrs[name]
+		if !ok || pc.IsUnknown() {
+			continue
+		}
+		sc, ok := stateAttrs[name]
+		if pc.IsNull() && (!ok || sc.IsNull()) {
+			continue
+		}
+		if !pc.Equal(sc) {
+			paths = append(paths, prefix+"."+name)
+		}
+	}
+	return paths
+}
+
+func resourcesUpdatePaths(ctx context.Context, prefix string, plan, state types.Object, diags *diag.Diagnostics) []string {
+	var pr, sr mdbcommon.Resource
+	diags.Append(plan.As(ctx, &pr, datasize.UnhandledOpts)...)
+	if utils.IsPresent(state) {
+		diags.Append(state.As(ctx, &sr, datasize.UnhandledOpts)...)
+	}
+
+	var paths []string
+	if !pr.ResourcePresetId.Equal(sr.ResourcePresetId) {
+		paths = append(paths, prefix+".resources.resource_preset_id")
+	}
+	if !pr.DiskTypeId.Equal(sr.DiskTypeId) {
+		paths = append(paths, prefix+".resources.disk_type_id")
+	}
+	if !pr.DiskSize.Equal(sr.DiskSize) {
+		paths = append(paths, prefix+".resources.disk_size")
+	}
+	return paths
+}
+
+func prepareUpdateRequest(ctx context.Context, state, plan *Cluster) (*mongodb.UpdateClusterRequest, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	request := &mongodb.UpdateClusterRequest{
+		ClusterId:  state.Id.ValueString(),
+		UpdateMask: &field_mask.FieldMask{},
+	}
+
+	if !plan.Name.Equal(state.Name) {
+		request.SetName(plan.Name.ValueString())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "name")
+	}
+
+	if !plan.Description.Equal(state.Description) {
+		request.SetDescription(plan.Description.ValueString())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "description")
+	}
+
+	if !plan.Labels.Equal(state.Labels) {
+		request.SetLabels(mdbcommon.ExpandLabels(ctx, plan.Labels, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "labels")
+	}
+
+	if !plan.SecurityGroupIds.Equal(state.SecurityGroupIds) {
+		request.SetSecurityGroupIds(mdbcommon.ExpandSecurityGroupIds(ctx, plan.SecurityGroupIds, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "security_group_ids")
+	}
+
+	if !plan.DeletionProtection.Equal(state.DeletionProtection) {
+		request.SetDeletionProtection(plan.DeletionProtection.ValueBool())
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "deletion_protection")
+	}
+
+	if !plan.MaintenanceWindow.Equal(state.MaintenanceWindow) {
+		request.SetMaintenanceWindow(mdbcommon.ExpandClusterMaintenanceWindow[
+			mongodb.MaintenanceWindow,
+			mongodb.WeeklyMaintenanceWindow,
+			mongodb.AnytimeMaintenanceWindow,
+			mongodb.WeeklyMaintenanceWindow_WeekDay,
+		](ctx, plan.MaintenanceWindow, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, "maintenance_window")
+	}
+
+	configPaths := []string{}
+	if !plan.Version.Equal(state.Version) {
+		configPaths = append(configPaths, "config_spec.version")
+	}
+	if utils.IsPresent(plan.FeatureCompatibilityVersion) && !plan.FeatureCompatibilityVersion.Equal(state.FeatureCompatibilityVersion) {
+		configPaths = append(configPaths, "config_spec.feature_compatibility_version")
+	}
+	if utils.IsPresent(plan.BackupWindowStart) && !plan.BackupWindowStart.Equal(state.BackupWindowStart) {
+		configPaths = append(configPaths, "config_spec.backup_window_start")
+	}
+	if utils.IsPresent(plan.BackupRetainPeriodDays) && !plan.BackupRetainPeriodDays.Equal(state.BackupRetainPeriodDays) {
+		configPaths = append(configPaths, "config_spec.backup_retain_period_days")
+	}
+	if utils.IsPresent(plan.PerformanceDiagnostics) && !plan.PerformanceDiagnostics.Equal(state.PerformanceDiagnostics) {
+		configPaths = append(configPaths, "config_spec.performance_diagnostics")
+	}
+	if utils.IsPresent(plan.Access) && !plan.Access.Equal(state.Access) {
+		configPaths = append(configPaths, "config_spec.access")
+	}
+
+	configPaths = append(configPaths, roleUpdatePaths(ctx, "config_spec.mongodb.mongod", plan.Mongod, state.Mongod, []string{"config"}, &diags)...)
+	// Subclusters of a sharded cluster are created with sharding enabling, until then they can't be updated
+	if state.Sharded.ValueBool() {
+		configPaths = append(configPaths, roleUpdatePaths(ctx, "config_spec.mongodb.mongocfg", plan.Mongocfg, state.Mongocfg, []string{"config"}, &diags)...)
+		configPaths = append(configPaths, roleUpdatePaths(ctx, "config_spec.mongodb.mongos", plan.Mongos, state.Mongos, []string{"config"}, &diags)...)
+		configPaths = append(configPaths, roleUpdatePaths(ctx, "config_spec.mongodb.mongoinfra", plan.Mongoinfra, state.Mongoinfra, []string{"config_mongos", "config_mongocfg"}, &diags)...)
+	}
+
+	if len(configPaths) > 0 {
+		request.SetConfigSpec(expandConfigSpec(ctx, plan, &diags))
+		request.UpdateMask.Paths = append(request.UpdateMask.Paths, configPaths...)
+	}
+
+	return request, diags
+}
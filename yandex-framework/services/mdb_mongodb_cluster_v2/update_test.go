@@ -0,0 +1,111 @@
+package mdb_mongodb_cluster_v2
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
+)
+
+func buildTestCluster() Cluster {
+	resources := buildTestResourcesObj("s2.micro", "network-ssd", 10)
+	return Cluster{
+		Id:                          types.StringValue("cid"),
+		Name:                        types.StringValue("test-cluster"),
+		Description:                 types.StringValue(""),
+		Labels:                      types.MapNull(types.StringType),
+		SecurityGroupIds:            types.SetNull(types.StringType),
+		DeletionProtection:          types.BoolValue(false),
+		MaintenanceWindow:           types.ObjectNull(mdbcommon.MaintenanceWindowType.AttrTypes),
+		Version:                     types.StringValue("6.0"),
+		FeatureCompatibilityVersion: types.StringValue("6.0"),
+		BackupWindowStart:           types.ObjectNull(mdbcommon.BackupWindowType.AttrTypes),
+		BackupRetainPeriodDays:      types.Int64Value(7),
+		PerformanceDiagnostics:      types.ObjectNull(PerformanceDiagnosticsAttrTypes),
+		Access:                      types.ObjectNull(AccessAttrTypes),
+		Mongod:                      buildTestRoleObj(MongodAttrTypes, resources, mdbcommon.NewSettingsMapNull()),
+		Mongocfg:                    types.ObjectNull(MongocfgAttrTypes),
+		Mongos:                      types.ObjectNull(MongosAttrTypes),
+		Mongoinfra:                  types.ObjectNull(MongoinfraAttrTypes),
+		Sharded:                     types.BoolValue(false),
+	}
+}
+
+func TestYandexProvider_MDBMongoDBClusterPrepareUpdateRequest(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	state := buildTestCluster()
+
+	plan := buildTestCluster()
+	plan.Name = types.StringValue("test-cluster-new")
+	plan.Version = types.StringValue("7.0")
+	plan.Mongod = buildTestRoleObj(
+		MongodAttrTypes,
+		buildTestResourcesObj("s2.small", "network-ssd", 20),
+		newTestSettings(mongodAttrProvider, map[string]attr.Value{
+			"slow_op_threshold": types.Int64Value(300),
+		}),
+	)
+
+	req, diags := prepareUpdateRequest(ctx, &state, &plan)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags.Errors())
+	}
+
+	expectedPaths := []string{
+		"name",
+		"config_spec.version",
+		"config_spec.mongodb.mongod.resources.resource_preset_id",
+		"config_spec.mongodb.mongod.resources.disk_size",
+		"config_spec.mongodb.mongod.config",
+	}
+	if !reflect.DeepEqual(req.UpdateMask.Paths, expectedPaths) {
+		t.Errorf("Unexpected update mask: expected %v, actual %v", expectedPaths, req.UpdateMask.Paths)
+	}
+	if req.GetConfigSpec().GetVersion() != "7.0" {
+		t.Errorf("Unexpected version in config spec: %v", req.GetConfigSpec())
+	}
+	if v := req.GetConfigSpec().GetMongodb().GetMongod().GetConfig().GetOperationProfiling().GetSlowOpThreshold().GetValue(); v != 300 {
+		t.Errorf("Unexpected slow_op_threshold in config spec: %d", v)
+	}
+}
+
+func TestYandexProvider_MDBMongoDBClusterPrepareUpdateRequestNotSharded(t *testing.T) {
+	t.Parallel()
+	ctx := context.Background()
+
+	state := buildTestCluster()
+
+	plan := buildTestCluster()
+	plan.Mongos = buildTestRoleObj(MongosAttrTypes, buildTestResourcesObj("s2.micro", "network-ssd", 10), mdbcommon.NewSettingsMapNull())
+
+	req, diags := prepareUpdateRequest(ctx, &state, &plan)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags.Errors())
+	}
+
+	// mongos is created with sharding enabling, it isn't updated before
+	if len(req.UpdateMask.Paths) != 0 {
+		t.Errorf("Unexpected update mask: %v", req.UpdateMask.Paths)
+	}
+
+	state.Sharded = types.BoolValue(true)
+	plan.Sharded = types.BoolValue(true)
+	req, diags = prepareUpdateRequest(ctx, &state, &plan)
+	if diags.HasError() {
+		t.Fatalf("Unexpected diagnostics: %v", diags.Errors())
+	}
+
+	expectedPaths := []string{
+		"config_spec.mongodb.mongos.resources.resource_preset_id",
+		"config_spec.mongodb.mongos.resources.disk_type_id",
+		"config_spec.mongodb.mongos.resources.disk_size",
+	}
+	if !reflect.DeepEqual(req.UpdateMask.Paths, expectedPaths) {
+		t.Errorf("Unexpected update mask: expected %v, actual %v", expectedPaths, req.UpdateMask.Paths)
+	}
+}
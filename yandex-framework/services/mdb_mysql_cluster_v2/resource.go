@@ -155,7 +155,7 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				},
 			},
 			"version": schema.StringAttribute{
-				Description: "Version of the MySQL cluster.",
+				Description: "Version of the MySQL cluster. Only upgrades of `5.7` to `8.0` are supported.",
 				Required:    true,
 				Validators: []validator.String{
 					stringvalidator.OneOf(
@@ -269,6 +269,9 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				PlanModifiers: []planmodifier.Map{
 					mapplanmodifier.UseStateForUnknown(),
 				},
+				Validators: []validator.Map{
+					mdbcommon.SettingsMapValidator(msAttrProvider),
+				},
 			},
 			"security_group_ids": schema.SetAttribute{
 
@@ -363,6 +366,26 @@ func (r *clusterResource) Read(ctx context.Context, req resource.ReadRequest, re
 	resp.Diagnostics.Append(d...)
 }
 
+func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPlanRequest, resp *resource.ModifyPlanResponse) {
+	if req.Plan.Raw.IsNull() || req.State.Raw.IsNull() {
+		return
+	}
+
+	var plan Cluster
+	var state Cluster
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	msUpgradePlanner.ValidateUpgrade(
+		state.Version, plan.Version, path.Root("version"),
+		plan.MySQLConfig, path.Root("mysql_config"),
+		&resp.Diagnostics,
+	)
+}
+
 func (r *clusterResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
 	var plan Cluster
 	diags := req.Plan.Get(ctx, &plan)
@@ -421,9 +444,14 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	mysqlApi.UpdateCluster(ctx, r.providerConfig.SDK, &resp.Diagnostics, updateVersionRequest)
-	if resp.Diagnostics.HasError() {
-		return
+	if updateVersionRequest != nil {
+		msUpgradePlanner.Upgrade(ctx, state.Version.ValueString(), updateVersionRequest.ConfigSpec.Version, func(version string, diags *diag.Diagnostics) {
+			updateVersionRequest.ConfigSpec.Version = version
+			mysqlApi.UpdateCluster(ctx, r.providerConfig.SDK, diags, updateVersionRequest)
+		}, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
 	}
 
 	updateRequest, d := prepareUpdateRequest(ctx, &state, &plan)
@@ -12,6 +12,14 @@ import (
 	"google.golang.org/genproto/protobuf/field_mask"
 )
 
+// msUpgradePlanner allows only upgrades of 5.7 to 8.0, downgrades are not supported.
+var msUpgradePlanner = &mdbcommon.VersionUpgradePlanner{
+	Engine:      "MySQL",
+	Rules:       mdbcommon.SequentialUpgradeRules([]string{"5.7", "8.0"}),
+	ConfigSpec:  &mysql.ConfigSpec{},
+	ConfigField: getMySQLConfigFieldName,
+}
+
 func prepareVersionUpdateRequest(state, plan *Cluster) (*mysql.UpdateClusterRequest, diag.Diagnostics) {
 
 	var diags diag.Diagnostics
@@ -128,3 +128,27 @@ func TestYandexProvider_MDBMySQLClusterPrepateUpdateVersionRequest(t *testing.T)
 		t.Fatalf("Unexpected update request:\nexpected %s\nactual %s", req, expectedUpdateReq)
 	}
 }
+
+func TestYandexProvider_MDBMySQLClusterUpgradePlanner(t *testing.T) {
+	t.Parallel()
+
+	if _, err := msUpgradePlanner.UpgradePath("8.0", "5.7"); err == nil {
+		t.Errorf("Downgrade of MySQL version should be rejected")
+	}
+
+	upgradePath, err := msUpgradePlanner.UpgradePath("5.7", "8.0")
+	if err != nil || !reflect.DeepEqual(upgradePath, []string{"8.0"}) {
+		t.Errorf("Unexpected upgrade path: %v, error: %v", upgradePath, err)
+	}
+
+	settings := NewMsSettingsMapValueMust(map[string]attr.Value{
+		"max_connections":       types.Int64Value(100),
+		"show_compatibility_56": types.BoolValue(true),
+	})
+	if unsupported := msUpgradePlanner.UnsupportedSettings("5.7", settings); len(unsupported) != 0 {
+		t.Errorf("Unexpected unsupported settings of 5.7: %v", unsupported)
+	}
+	if unsupported := msUpgradePlanner.UnsupportedSettings("8.0", settings); !reflect.DeepEqual(unsupported, []string{"show_compatibility_56"}) {
+		t.Errorf("Unexpected unsupported settings of 8.0: %v", unsupported)
+	}
+}
@@ -215,7 +215,7 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 				Description: "Configuration of the PostgreSQL cluster.",
 				Attributes: map[string]schema.Attribute{
 					"version": schema.StringAttribute{
-						Description: "Version of the PostgreSQL cluster.",
+						Description: "Version of the PostgreSQL cluster. Major versions are upgraded one by one, e.g. `14` is upgraded to `16` through `15`.",
 						Required:    true,
 						Validators: []validator.String{
 							stringvalidator.OneOf(
@@ -493,6 +493,15 @@ func (r *clusterResource) ModifyPlan(ctx context.Context, req resource.ModifyPla
 		return
 	}
 
+	pgUpgradePlanner.ValidateUpgrade(
+		cfgState.Version, cfgPlan.Version, path.Root("config").AtName("version"),
+		cfgPlan.PostgtgreSQLConfig, path.Root("config").AtName("postgresql_config"),
+		&resp.Diagnostics,
+	)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
 	autoscalingOn := utils.IsPresent(attr.Value(cfgState.DiskSizeAutoscaling))
 
 	// remove changes on disk_size from plan if enabled autoscaling
@@ -565,7 +574,21 @@ func (r *clusterResource) Update(ctx context.Context, req resource.UpdateRequest
 		return
 	}
 
-	postgresqlApi.UpdateCluster(ctx, r.providerConfig.SDK, &resp.Diagnostics, updateVersionRequest)
+	if updateVersionRequest != nil {
+		var cfgState Config
+		resp.Diagnostics.Append(state.Config.As(ctx, &cfgState, datasize.DefaultOpts)...)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+
+		pgUpgradePlanner.Upgrade(ctx, cfgState.Version.ValueString(), updateVersionRequest.ConfigSpec.Version, func(version string, diags *diag.Diagnostics) {
+			updateVersionRequest.ConfigSpec.Version = version
+			postgresqlApi.UpdateCluster(ctx, r.providerConfig.SDK, diags, updateVersionRequest)
+		}, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
 
 	updateRequest, d := prepareUpdateRequest(ctx, &state, &plan)
 	resp.Diagnostics.Append(d...)
@@ -16,6 +16,17 @@ import (
 	"google.golang.org/protobuf/types/known/wrapperspb"
 )
 
+// pgUpgradePlanner upgrades major versions one by one, the edition of PostgreSQL can't be changed.
+var pgUpgradePlanner = &mdbcommon.VersionUpgradePlanner{
+	Engine: "PostgreSQL",
+	Rules: mdbcommon.SequentialUpgradeRules(
+		[]string{"10", "11", "12", "13", "14", "15", "16", "17"},
+		[]string{"10-1c", "11-1c", "12-1c", "13-1c", "14-1c", "15-1c", "16-1c", "17-1c"},
+	),
+	ConfigSpec:  &postgresql.ConfigSpec{},
+	ConfigField: getPostgreSQLConfigFieldName,
+}
+
 func prepareVersionUpdateRequest(state, plan *Cluster) (*postgresql.UpdateClusterRequest, diag.Diagnostics) {
 
 	const versionAttr = "version"
@@ -91,3 +91,27 @@ func TestYandexProvider_MDBPostgresClusterPrepateUpdateRequestBasic(t *testing.T
 		t.Fatalf("Unexpected update request:\nexpected %s\nactual %s", req, expectedUpdateReq)
 	}
 }
+
+func TestYandexProvider_MDBPostgresClusterUpgradePlanner(t *testing.T) {
+	t.Parallel()
+
+	upgradePath, err := pgUpgradePlanner.UpgradePath("13-1c", "15-1c")
+	if err != nil || !reflect.DeepEqual(upgradePath, []string{"14-1c", "15-1c"}) {
+		t.Errorf("Unexpected upgrade path: %v, error: %v", upgradePath, err)
+	}
+
+	if _, err := pgUpgradePlanner.UpgradePath("14", "15-1c"); err == nil {
+		t.Errorf("Change of PostgreSQL edition should be rejected")
+	}
+
+	settings := NewPgSettingsMapValueMust(map[string]attr.Value{
+		"max_connections":             types.Int64Value(100),
+		"operator_precedence_warning": types.BoolValue(true),
+	})
+	if unsupported := pgUpgradePlanner.UnsupportedSettings("13", settings); len(unsupported) != 0 {
+		t.Errorf("Unexpected unsupported settings of 13: %v", unsupported)
+	}
+	if unsupported := pgUpgradePlanner.UnsupportedSettings("14", settings); !reflect.DeepEqual(unsupported, []string{"operator_precedence_warning"}) {
+		t.Errorf("Unexpected unsupported settings of 14: %v", unsupported)
+	}
+}
@@ -2,6 +2,7 @@ package mdb_redis_cluster_v2
 
 import (
 	"fmt"
+	"regexp"
 	"strconv"
 	"strings"
 
@@ -39,6 +40,9 @@ func parseRedisMaxmemoryPolicy(s string) (config.RedisConfig_MaxmemoryPolicy, er
 	return config.RedisConfig_MaxmemoryPolicy(v), nil
 }
 
+// limitRegexp matches client output buffer limits in the "hard soft secs" format
+var limitRegexp = regexp.MustCompile(`^\d+ \d+ \d+$`)
+
 func limitToStr(hard, soft, secs *wrappers.Int64Value) string {
 	if hard == nil && soft == nil && secs == nil {
 		return ""
@@ -20,6 +20,7 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-framework/types/basetypes"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/redis/v1"
+	config "github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/redis/v1/config"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
 	"github.com/yandex-cloud/terraform-provider-yandex/common/defaultschema"
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
@@ -257,6 +258,7 @@ func (r *redisClusterResource) Schema(ctx context.Context,
 							stringplanmodifier.UseStateForUnknown(),
 						},
 						MarkdownDescription: "Redis key eviction policy for a dataset that reaches maximum memory, available to the host.",
+						Validators:          []validator.String{stringvalidator.OneOf(getEnumValueMapKeys(config.RedisConfig_MaxmemoryPolicy_value)...)},
 					},
 					"notify_keyspace_events": schema.StringAttribute{
 						Optional: true,
@@ -302,6 +304,9 @@ func (r *redisClusterResource) Schema(ctx context.Context,
 						Optional:            true,
 						Computed:            true,
 						MarkdownDescription: "Redis connection output buffers limits for clients.",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(limitRegexp, "must be space-separated 3-values string of integers"),
+						},
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
@@ -310,6 +315,9 @@ func (r *redisClusterResource) Schema(ctx context.Context,
 						Optional:            true,
 						Computed:            true,
 						MarkdownDescription: "Redis connection output buffers limits for pubsub operations.",
+						Validators: []validator.String{
+							stringvalidator.RegexMatches(limitRegexp, "must be space-separated 3-values string of integers"),
+						},
 						PlanModifiers: []planmodifier.String{
 							stringplanmodifier.UseStateForUnknown(),
 						},
@@ -280,6 +280,9 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 							"common": schema.MapAttribute{
 								CustomType:          mdbcommon.NewSettingsMapType(attrProvider),
 								MarkdownDescription: "General settings for all types of hosts.",
+								Validators: []validator.Map{
+									mdbcommon.SettingsMapValidator(commonAttrProvider),
+								},
 								PlanModifiers: []planmodifier.Map{
 									mapplanmodifier.UseStateForUnknown(),
 								},
@@ -294,6 +297,9 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 									"config": schema.MapAttribute{
 										CustomType:          mdbcommon.NewSettingsMapType(attrProvider),
 										MarkdownDescription: "Router settings.",
+										Validators: []validator.Map{
+											mdbcommon.SettingsMapValidator(routerAttrProvider),
+										},
 										PlanModifiers: []planmodifier.Map{
 											mapplanmodifier.UseStateForUnknown(),
 										},
@@ -328,6 +334,9 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 									"config": schema.MapAttribute{
 										CustomType:          mdbcommon.NewSettingsMapType(attrProvider),
 										MarkdownDescription: "Coordinator settings.",
+										Validators: []validator.Map{
+											mdbcommon.SettingsMapValidator(coordinatorAttrProvider),
+										},
 										PlanModifiers: []planmodifier.Map{
 											mapplanmodifier.UseStateForUnknown(),
 										},
@@ -362,6 +371,9 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 									"router": schema.MapAttribute{
 										CustomType:          mdbcommon.NewSettingsMapType(attrProvider),
 										MarkdownDescription: "Router settings.",
+										Validators: []validator.Map{
+											mdbcommon.SettingsMapValidator(routerAttrProvider),
+										},
 										PlanModifiers: []planmodifier.Map{
 											mapplanmodifier.UseStateForUnknown(),
 										},
@@ -372,6 +384,9 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 									"coordinator": schema.MapAttribute{
 										CustomType:          mdbcommon.NewSettingsMapType(attrProvider),
 										MarkdownDescription: "Coordinator settings.",
+										Validators: []validator.Map{
+											mdbcommon.SettingsMapValidator(coordinatorAttrProvider),
+										},
 										PlanModifiers: []planmodifier.Map{
 											mapplanmodifier.UseStateForUnknown(),
 										},
@@ -402,6 +417,9 @@ func (r *clusterResource) Schema(_ context.Context, _ resource.SchemaRequest, re
 							"balancer": schema.MapAttribute{
 								CustomType:          mdbcommon.NewSettingsMapType(attrProvider),
 								MarkdownDescription: "Balancer specific configuration.",
+								Validators: []validator.Map{
+									mdbcommon.SettingsMapValidator(balancerAttrProvider),
+								},
 								PlanModifiers: []planmodifier.Map{
 									mapplanmodifier.UseStateForUnknown(),
 								},
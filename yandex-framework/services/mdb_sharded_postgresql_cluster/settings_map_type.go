@@ -10,35 +10,22 @@ import (
 	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
 )
 
-type SettingsAttributeInfoProvider struct{}
-
-func (p *SettingsAttributeInfoProvider) GetSettingsEnumNames() map[string]map[int32]string {
-	return settingsEnumNames
-}
-
-func (p *SettingsAttributeInfoProvider) GetSettingsEnumValues() map[string]map[string]int32 {
-	return settingsEnumValues
-}
-
-func (p *SettingsAttributeInfoProvider) GetSetAttributes() map[string]struct{} {
-	return listAttributes
-}
-
-var settingsEnumNames = map[string]map[int32]string{
-	"log_level":              config.LogLevel_name,
-	"default_route_behavior": config.RouterSettings_DefaultRouteBehavior_name,
-}
-
-var settingsEnumValues = map[string]map[string]int32{
-	"log_level":              config.LogLevel_value,
-	"default_route_behavior": config.RouterSettings_DefaultRouteBehavior_value,
-}
-
-var listAttributes = map[string]struct{}{
-	"default_route_behavior": {},
-}
+// Settings maps are generated from the messages they are expanded to.
+var (
+	// commonAttrProvider contains the own fields of the spec, the components are configured with their maps.
+	commonAttrProvider      = mdbcommon.NewShallowProtoSettingsAttributeInfoProvider(&config.SpqrSpec{})
+	routerAttrProvider      = mdbcommon.NewProtoSettingsAttributeInfoProvider(&config.RouterSettings{})
+	coordinatorAttrProvider = mdbcommon.NewProtoSettingsAttributeInfoProvider(&config.CoordinatorSettings{})
+	balancerAttrProvider    = mdbcommon.NewProtoSettingsAttributeInfoProvider(&config.BalancerSettings{})
+)
 
-var attrProvider = &SettingsAttributeInfoProvider{}
+// attrProvider knows the settings of all the maps.
+var attrProvider = mdbcommon.NewShallowProtoSettingsAttributeInfoProvider(
+	&config.SpqrSpec{},
+	&config.RouterSettings{},
+	&config.CoordinatorSettings{},
+	&config.BalancerSettings{},
+)
 
 func NewSettingsMapType() mdbcommon.SettingsMapType {
 	return mdbcommon.NewSettingsMapType(attrProvider)
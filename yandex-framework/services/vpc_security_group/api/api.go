@@ -156,3 +156,91 @@ func UpdateSecurityGroupRules(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Di
 
 	return meta
 }
+
+func CreateSecurityGroup(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, req *vpc.CreateSecurityGroupRequest) string {
+	tflog.Debug(ctx, "Creating VPC SecurityGroup", map[string]interface{}{"name": req.Name})
+	op, err := sdk.WrapOperation(sdk.VPC().SecurityGroup().Create(ctx, req))
+	if err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while requesting API to create Security Group: "+err.Error(),
+		)
+		return ""
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while getting Security Group create operation metadata: "+err.Error(),
+		)
+		return ""
+	}
+
+	md, ok := protoMetadata.(*vpc.CreateSecurityGroupMetadata)
+	if !ok {
+		diag.AddError(
+			"Failed to Create resource",
+			"Could not get Security Group ID from create operation metadata",
+		)
+		return ""
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diag.AddError(
+			"Failed to Create resource",
+			"Error while waiting for operation to create Security Group: "+err.Error(),
+		)
+	}
+
+	return md.GetSecurityGroupId()
+}
+
+func UpdateSecurityGroup(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, req *vpc.UpdateSecurityGroupRequest) {
+	tflog.Debug(ctx, "Updating VPC SecurityGroup", map[string]interface{}{"id": req.SecurityGroupId})
+	op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
+		return sdk.VPC().SecurityGroup().Update(ctx, req)
+	})
+
+	if err != nil {
+		diag.AddError(
+			"Failed to Update resource",
+			"Error while requesting API to update Security Group: "+err.Error(),
+		)
+		return
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diag.AddError(
+			"Failed to Update resource",
+			"Error while waiting for operation to update Security Group: "+err.Error(),
+		)
+	}
+}
+
+// ReplaceSecurityGroupRules adds and deletes several rules of the security group with one operation.
+func ReplaceSecurityGroupRules(ctx context.Context, sdk *ycsdk.SDK, diag *diag.Diagnostics, sgID string, addRules []*vpc.SecurityGroupRuleSpec, deleteRuleIDs []string) {
+	tflog.Debug(ctx, "Replacing VPC SecurityGroup rules", map[string]interface{}{"id": sgID, "added": len(addRules), "deleted": deleteRuleIDs})
+	op, err := retry.ConflictingOperation(ctx, sdk, func() (*operation.Operation, error) {
+		return sdk.VPC().SecurityGroup().UpdateRules(ctx, &vpc.UpdateSecurityGroupRulesRequest{
+			SecurityGroupId:   sgID,
+			AdditionRuleSpecs: addRules,
+			DeletionRuleIds:   deleteRuleIDs,
+		})
+	})
+
+	if err != nil {
+		diag.AddError(
+			"Failed to Update SecurityGroup Rules",
+			"Error while requesting API to update SecurityGroup rules: "+err.Error(),
+		)
+		return
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		diag.AddError(
+			"Failed to Update SecurityGroup Rules",
+			"Error while waiting for operation to update SecurityGroup rules: "+err.Error(),
+		)
+	}
+}
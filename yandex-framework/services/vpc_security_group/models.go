@@ -2,6 +2,8 @@ package vpc_security_group
 
 import (
 	"context"
+	"fmt"
+	"strings"
 
 	"github.com/hashicorp/terraform-plugin-framework-timeouts/resource/timeouts"
 	"github.com/hashicorp/terraform-plugin-framework/attr"
@@ -23,6 +25,8 @@ type securityGroupModel struct {
 	Status      types.String   `tfsdk:"status"`
 	Ingress     types.Set      `tfsdk:"ingress"`
 	Egress      types.Set      `tfsdk:"egress"`
+
+	RulesManagement types.String `tfsdk:"rules_management"`
 }
 
 type securityGroupDataSourceModel struct {
@@ -52,7 +56,9 @@ func securityGroupToState(ctx context.Context, sg *vpc.SecurityGroup, state *sec
 	state.NetworkID = types.StringValue(sg.GetNetworkId())
 	state.Status = types.StringValue(sg.GetStatus().String())
 	state.CreatedAt = types.StringValue(timestamp.Get(sg.GetCreatedAt()))
-	state.Name = types.StringValue(sg.GetName())
+	if !state.Name.IsNull() || sg.GetName() != "" {
+		state.Name = types.StringValue(sg.GetName())
+	}
 	if state.Description.IsUnknown() || sg.GetDescription() != "" {
 		state.Description = types.StringValue(sg.GetDescription())
 	}
@@ -65,7 +71,14 @@ func securityGroupToState(ctx context.Context, sg *vpc.SecurityGroup, state *sec
 		state.Labels = labels
 	}
 
-	var ingress, egress, diags = flattenRules(ctx, sg.GetRules())
+	// Rules are managed by the yandex_vpc_security_group_rule resources
+	if isExternalRulesManagement(state.RulesManagement) {
+		state.Ingress = types.SetValueMust(ruleType, nil)
+		state.Egress = types.SetValueMust(ruleType, nil)
+		return nil
+	}
+
+	var ingress, egress, diags = flattenRules(ctx, sg.GetRules(), state.Ingress, state.Egress)
 	if diags.HasError() {
 		return diags
 	}
@@ -75,13 +88,43 @@ func securityGroupToState(ctx context.Context, sg *vpc.SecurityGroup, state *sec
 	return diags
 }
 
-func flattenRules(ctx context.Context, rules []*vpc.SecurityGroupRule) (types.Set, types.Set, diag.Diagnostics) {
+// flattenRules converts the rules to the state. The rules of the prior state or plan are used to keep
+// the spelling of the protocol and the empty descriptions as they are configured.
+func flattenRules(ctx context.Context, rules []*vpc.SecurityGroupRule, priorIngress, priorEgress types.Set) (types.Set, types.Set, diag.Diagnostics) {
 	var ingressValues []attr.Value
 	var egressValues []attr.Value
 
 	var diags diag.Diagnostics
 
+	prior := make(map[vpc.SecurityGroupRule_Direction][]ruleModel)
+	prior[vpc.SecurityGroupRule_INGRESS], diags = ruleModels(ctx, priorIngress)
+	if diags.HasError() {
+		return types.Set{}, types.Set{}, diags
+	}
+	prior[vpc.SecurityGroupRule_EGRESS], diags = ruleModels(ctx, priorEgress)
+	if diags.HasError() {
+		return types.Set{}, types.Set{}, diags
+	}
+	used := map[vpc.SecurityGroupRule_Direction][]bool{
+		vpc.SecurityGroupRule_INGRESS: make([]bool, len(prior[vpc.SecurityGroupRule_INGRESS])),
+		vpc.SecurityGroupRule_EGRESS:  make([]bool, len(prior[vpc.SecurityGroupRule_EGRESS])),
+	}
+
 	for _, rule := range rules {
+		protocol := types.StringValue(rule.ProtocolName)
+		description := types.StringNull()
+		if rule.Description != "" {
+			description = types.StringValue(rule.Description)
+		}
+		if m := matchPriorRule(ctx, rule, prior[rule.GetDirection()], used[rule.GetDirection()]); m != nil {
+			if !m.Protocol.IsUnknown() && strings.EqualFold(m.Protocol.ValueString(), rule.ProtocolName) {
+				protocol = m.Protocol
+			}
+			if !m.Description.IsNull() && !m.Description.IsUnknown() {
+				description = types.StringValue(rule.Description)
+			}
+		}
+
 		labels, diagnostics := types.MapValueFrom(ctx, types.StringType, rule.Labels)
 		diags.Append(diagnostics...)
 		if diags.HasError() {
@@ -105,9 +148,9 @@ func flattenRules(ctx context.Context, rules []*vpc.SecurityGroupRule) (types.Se
 
 		ruleValue, diagnostics := types.ObjectValue(ruleType.AttrTypes, map[string]attr.Value{
 			"id":                types.StringValue(rule.Id),
-			"description":       types.StringValue(rule.Description),
+			"description":       description,
 			"labels":            labels,
-			"protocol":          types.StringValue(rule.ProtocolName),
+			"protocol":          protocol,
 			"port":              types.Int64Value(port),
 			"from_port":         types.Int64Value(fromPort),
 			"to_port":           types.Int64Value(toPort),
@@ -158,6 +201,24 @@ func FlattenRulePorts(g *vpc.SecurityGroupRule) (port, fromPort, toPort int64) {
 	return
 }
 
+func ExpandRulePorts(port, fromPort, toPort int64) (*vpc.PortRange, error) {
+	if port == -1 && fromPort == -1 && toPort == -1 {
+		return nil, nil
+	}
+
+	if port != -1 {
+		if fromPort != -1 || toPort != -1 {
+			return nil, fmt.Errorf("cannot set from_port/to_port with port")
+		}
+		fromPort = port
+		toPort = port
+	} else if fromPort == -1 || toPort == -1 {
+		return nil, fmt.Errorf("port or from_port + to_port must be defined")
+	}
+
+	return &vpc.PortRange{FromPort: fromPort, ToPort: toPort}, nil
+}
+
 func NullableStringSliceToList(ctx context.Context, s []string) (types.List, diag.Diagnostics) {
 	if s == nil {
 		return types.ListNull(types.StringType), diag.Diagnostics{}
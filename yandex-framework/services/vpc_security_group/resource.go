@@ -2,6 +2,7 @@ package vpc_security_group
 
 import (
 	"context"
+	"encoding/json"
 	"fmt"
 	"time"
 
@@ -22,11 +23,15 @@ import (
 	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
 	"github.com/hashicorp/terraform-plugin-framework/types"
 	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
 	ycsdk "github.com/yandex-cloud/go-sdk"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
 	"github.com/yandex-cloud/terraform-provider-yandex/common/defaultschema"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/validate"
 	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
 	sg_api "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/vpc_security_group/api"
+	"google.golang.org/genproto/protobuf/field_mask"
 )
 
 const YandexVPCSecurityGroupDefaultTimeout = 3 * time.Minute
@@ -53,6 +58,13 @@ var (
 				stringplanmodifier.UseStateForUnknown(),
 			},
 		},
+		"rules_management": schema.StringAttribute{
+			MarkdownDescription: "How the rules of the Security Group are managed. With `inline` (default) the `ingress` and `egress` blocks are authoritative, any other rules are deleted. With `external` the rules are managed by `yandex_vpc_security_group_rule` resources, `ingress` and `egress` blocks can't be used and the rules are not tracked by this resource.",
+			Optional:            true,
+			Validators: []validator.String{
+				stringvalidator.OneOf(rulesManagementInline, rulesManagementExternal),
+			},
+		},
 	}
 
 	ruleResourceAttributes = map[string]schema.Attribute{
@@ -203,11 +215,15 @@ var (
 		},
 	}
 
-	_ resource.Resource                = &securityGroupResource{}
-	_ resource.ResourceWithConfigure   = &securityGroupResource{}
-	_ resource.ResourceWithImportState = &securityGroupResource{}
+	_ resource.Resource                   = &securityGroupResource{}
+	_ resource.ResourceWithConfigure      = &securityGroupResource{}
+	_ resource.ResourceWithImportState    = &securityGroupResource{}
+	_ resource.ResourceWithValidateConfig = &securityGroupResource{}
+	_ resource.ResourceWithMoveState      = &securityGroupResource{}
 )
 
+const legacyResourceTypeName = "yandex_vpc_security_group"
+
 type securityGroupResource struct {
 	providerConfig *provider_config.Config
 }
@@ -222,7 +238,7 @@ func NewResource() resource.Resource {
 func (g *securityGroupResource) Schema(ctx context.Context, req resource.SchemaRequest, resp *resource.SchemaResponse) {
 	tflog.Debug(ctx, "Initializing VPC SecurityGroup schema")
 	resp.Schema = schema.Schema{
-		MarkdownDescription: "Manages `Security Group` within the Yandex Cloud. For more information, see [Documentation](https://yandex.cloud/docs/vpc/concepts/security-groups).\n\n~> Either one `port` argument or both `from_port` and `to_port` arguments can be specified.\n\n~> If `port` or `from_port`/`to_port` aren't specified or set by -1, ANY port will be sent.\n\n~> Can't use specified port if protocol is one of `ICMP` or `IPV6_ICMP`.\n\n~> One of arguments `v4_cidr_blocks`/`v6_cidr_blocks` or `predefined_target` or `security_group_id` must be specified.\n\n",
+		MarkdownDescription: "Manages `Security Group` within the Yandex Cloud. For more information, see [Documentation](https://yandex.cloud/docs/vpc/concepts/security-groups).\n\n~> Either one `port` argument or both `from_port` and `to_port` arguments can be specified.\n\n~> If `port` or `from_port`/`to_port` aren't specified or set by -1, ANY port will be sent.\n\n~> Can't use specified port if protocol is one of `ICMP` or `IPV6_ICMP`.\n\n~> One of arguments `v4_cidr_blocks`/`v6_cidr_blocks` or `predefined_target` or `security_group_id` must be specified.\n\n~> Rules added by `yandex_vpc_security_group_rule` resources are deleted by this resource unless `rules_management` is set to `external`.\n\n",
 		Attributes:          groupResourceAttributes,
 		Blocks: map[string]schema.Block{
 			"ingress": schema.SetNestedBlock{
@@ -252,8 +268,56 @@ func (g *securityGroupResource) ImportState(ctx context.Context, req resource.Im
 }
 
 func (g *securityGroupResource) Create(ctx context.Context, req resource.CreateRequest, resp *resource.CreateResponse) {
-	//TODO implement me
-	panic("implement me")
+	var plan securityGroupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	createTimeout, diags := plan.Timeouts.Create(ctx, YandexVPCSecurityGroupDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, createTimeout)
+	defer cancel()
+
+	folderID, d := validate.FolderID(plan.FolderID, &g.providerConfig.ProviderState)
+	resp.Diagnostics.Append(d)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	labels := make(map[string]string, len(plan.Labels.Elements()))
+	resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &labels, false)...)
+
+	var rules []plannedRule
+	if !isExternalRulesManagement(plan.RulesManagement) {
+		rules, diags = expandRules(ctx, plan.Ingress, plan.Egress)
+		resp.Diagnostics.Append(diags...)
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	plan.ID = types.StringValue(sg_api.CreateSecurityGroup(ctx, g.providerConfig.SDK, &resp.Diagnostics, &vpc.CreateSecurityGroupRequest{
+		FolderId:    folderID,
+		Name:        plan.Name.ValueString(),
+		Description: plan.Description.ValueString(),
+		Labels:      labels,
+		NetworkId:   plan.NetworkID.ValueString(),
+		RuleSpecs:   ruleSpecs(rules),
+	}))
+	if plan.ID.ValueString() == "" {
+		return
+	}
+	// Save the ID even if waiting has failed, so the security group isn't lost
+	resp.Diagnostics.Append(resp.State.SetAttribute(ctx, path.Root("id"), plan.ID)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	updateState(ctx, g.providerConfig.SDK, &plan, &resp.Diagnostics, false)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
 }
 
 func (g *securityGroupResource) Read(ctx context.Context, req resource.ReadRequest, resp *resource.ReadResponse) {
@@ -263,13 +327,108 @@ func (g *securityGroupResource) Read(ctx context.Context, req resource.ReadReque
 		return
 	}
 
-	updateState(ctx, g.providerConfig.SDK, &state, &resp.Diagnostics, false)
+	sg := sg_api.ReadSecurityGroup(ctx, g.providerConfig.SDK, &resp.Diagnostics, state.ID.ValueString())
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	if sg == nil {
+		tflog.Warn(ctx, "VPC SecurityGroup not found, removing it from state", map[string]interface{}{"id": state.ID.ValueString()})
+		resp.State.RemoveResource(ctx)
+		return
+	}
+
+	resp.Diagnostics.Append(securityGroupToState(ctx, sg, &state)...)
 	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
 }
 
 func (g *securityGroupResource) Update(ctx context.Context, req resource.UpdateRequest, resp *resource.UpdateResponse) {
-	//TODO implement me
-	panic("implement me")
+	var plan, state securityGroupModel
+	resp.Diagnostics.Append(req.Plan.Get(ctx, &plan)...)
+	resp.Diagnostics.Append(req.State.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	updateTimeout, diags := plan.Timeouts.Update(ctx, YandexVPCSecurityGroupDefaultTimeout)
+	resp.Diagnostics.Append(diags...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+	ctx, cancel := context.WithTimeout(ctx, updateTimeout)
+	defer cancel()
+
+	sgID := state.ID.ValueString()
+	updateReq := &vpc.UpdateSecurityGroupRequest{
+		SecurityGroupId: sgID,
+		UpdateMask:      &field_mask.FieldMask{},
+	}
+	if !plan.Name.Equal(state.Name) {
+		updateReq.Name = plan.Name.ValueString()
+		updateReq.UpdateMask.Paths = append(updateReq.UpdateMask.Paths, "name")
+	}
+	if !plan.Description.Equal(state.Description) {
+		updateReq.Description = plan.Description.ValueString()
+		updateReq.UpdateMask.Paths = append(updateReq.UpdateMask.Paths, "description")
+	}
+	if !plan.Labels.Equal(state.Labels) {
+		labels := make(map[string]string, len(plan.Labels.Elements()))
+		resp.Diagnostics.Append(plan.Labels.ElementsAs(ctx, &labels, false)...)
+		updateReq.Labels = labels
+		updateReq.UpdateMask.Paths = append(updateReq.UpdateMask.Paths, "labels")
+	}
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if len(updateReq.UpdateMask.Paths) > 0 {
+		sg_api.UpdateSecurityGroup(ctx, g.providerConfig.SDK, &resp.Diagnostics, updateReq)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	// Rules of the external mode are left as is, also when switching from the inline mode
+	if !isExternalRulesManagement(plan.RulesManagement) {
+		g.updateRules(ctx, sgID, &plan, &resp.Diagnostics)
+		if resp.Diagnostics.HasError() {
+			return
+		}
+	}
+
+	plan.ID = state.ID
+	updateState(ctx, g.providerConfig.SDK, &plan, &resp.Diagnostics, false)
+	resp.Diagnostics.Append(resp.State.Set(ctx, &plan)...)
+}
+
+// updateRules makes the rules of the security group match the inline rules of the plan.
+func (g *securityGroupResource) updateRules(ctx context.Context, sgID string, plan *securityGroupModel, diags *diag.Diagnostics) {
+	planned, d := expandRules(ctx, plan.Ingress, plan.Egress)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	// yandex_vpc_security_group_rule resources modify the rules under the same lock
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(sgID)
+	defer mutexKV.Unlock(sgID)
+
+	sg := sg_api.ReadSecurityGroup(ctx, g.providerConfig.SDK, diags, sgID)
+	if diags.HasError() {
+		return
+	}
+	if sg == nil {
+		diags.AddError(
+			"Failed to get SecurityGroup",
+			fmt.Sprintf("SecurityGroup with id %s not found", sgID))
+		return
+	}
+
+	add, del := securityGroupRulesDelta(sg.GetRules(), planned)
+	if len(add) == 0 && len(del) == 0 {
+		return
+	}
+
+	sg_api.ReplaceSecurityGroupRules(ctx, g.providerConfig.SDK, diags, sgID, add, del)
 }
 
 func (g *securityGroupResource) Delete(ctx context.Context, req resource.DeleteRequest, resp *resource.DeleteResponse) {
@@ -289,6 +448,78 @@ func (g *securityGroupResource) Delete(ctx context.Context, req resource.DeleteR
 	sg_api.DeleteSecurityGroup(ctx, g.providerConfig.SDK, &resp.Diagnostics, state.ID.ValueString())
 }
 
+// ValidateConfig checks that inline rules aren't used with external rules management and detects
+// duplicate and overlapping inline rules at plan time.
+func (g *securityGroupResource) ValidateConfig(ctx context.Context, req resource.ValidateConfigRequest, resp *resource.ValidateConfigResponse) {
+	var config securityGroupModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &config)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	if isExternalRulesManagement(config.RulesManagement) {
+		for attribute, rules := range map[string]types.Set{"ingress": config.Ingress, "egress": config.Egress} {
+			if !rules.IsNull() && (rules.IsUnknown() || len(rules.Elements()) > 0) {
+				resp.Diagnostics.AddAttributeError(
+					path.Root(attribute),
+					"Inline rules with external rules management",
+					fmt.Sprintf("`%s` blocks can't be used with `rules_management = \"external\"`, use yandex_vpc_security_group_rule resources instead.", attribute),
+				)
+			}
+		}
+		return
+	}
+
+	validateRuleConflicts(ctx, "ingress", vpc.SecurityGroupRule_INGRESS, config.Ingress, &resp.Diagnostics)
+	validateRuleConflicts(ctx, "egress", vpc.SecurityGroupRule_EGRESS, config.Egress, &resp.Diagnostics)
+}
+
+// MoveState allows to move the state of the SDKv2-based yandex_vpc_security_group, e.g. from the provider
+// installed under another source address, with a `moved` block. Only the security group ID is taken from
+// the source state, all other attributes are refreshed from the API.
+func (g *securityGroupResource) MoveState(ctx context.Context) []resource.StateMover {
+	return []resource.StateMover{
+		{
+			StateMover: func(ctx context.Context, req resource.MoveStateRequest, resp *resource.MoveStateResponse) {
+				if req.SourceTypeName != legacyResourceTypeName {
+					return
+				}
+
+				sgID, diags := securityGroupIDFromRawState(req.SourceRawState.JSON)
+				resp.Diagnostics.Append(diags...)
+				if resp.Diagnostics.HasError() {
+					return
+				}
+
+				resp.Diagnostics.Append(resp.TargetState.SetAttribute(ctx, path.Root("id"), sgID)...)
+			},
+		},
+	}
+}
+
+func securityGroupIDFromRawState(rawState []byte) (string, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	var src struct {
+		Id string `json:"id"`
+	}
+	if err := json.Unmarshal(rawState, &src); err != nil {
+		diags.AddError(
+			"Failed to move resource state",
+			fmt.Sprintf("Error while unmarshaling %s state: %s", legacyResourceTypeName, err.Error()),
+		)
+		return "", diags
+	}
+
+	if src.Id == "" {
+		diags.AddError(
+			"Failed to move resource state",
+			fmt.Sprintf("Source %s state has no security group id", legacyResourceTypeName),
+		)
+	}
+	return src.Id, diags
+}
+
 func (g *securityGroupResource) Configure(ctx context.Context, req resource.ConfigureRequest, resp *resource.ConfigureResponse) {
 	if req.ProviderData == nil {
 		return
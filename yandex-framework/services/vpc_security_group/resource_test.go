@@ -78,9 +78,9 @@ func sweepVPCSecurityGroupOnce(conf *provider_config.Config, id string) error {
 	return test.HandleSweepOperation(ctx, conf, op, err)
 }
 
+// TestAccVPCSecurityGroup_UpgradeFromSDKv2 checks that the state of the SDKv2-based resource of the same type
+// is read by the framework resource without any changes.
 func TestAccVPCSecurityGroup_UpgradeFromSDKv2(t *testing.T) {
-	t.Skip()
-
 	networkName := acctest.RandomWithPrefix("vpc-sg-upgrade-provider")
 	sg1Name := acctest.RandomWithPrefix("vpc-sg-upgrade-provider")
 
@@ -89,7 +89,8 @@ func TestAccVPCSecurityGroup_UpgradeFromSDKv2(t *testing.T) {
 			{
 				ExternalProviders: map[string]resource.ExternalProvider{
 					"yandex": {
-						VersionConstraint: "0.129.0",
+						// the last version with the SDKv2-based resource
+						VersionConstraint: "0.148.0",
 						Source:            "yandex-cloud/yandex",
 					},
 				},
@@ -125,8 +126,6 @@ func TestAccVPCSecurityGroup_UpgradeFromSDKv2(t *testing.T) {
 }
 
 func TestAccVPCSecurityGroup_basic(t *testing.T) {
-	t.Skip()
-
 	var securityGroup vpc.SecurityGroup
 
 	networkName := acctest.RandomWithPrefix("vpc-sg-basic")
@@ -162,8 +161,6 @@ func TestAccVPCSecurityGroup_basic(t *testing.T) {
 }
 
 func TestAccVPCSecurityGroup_update(t *testing.T) {
-	t.Skip()
-
 	var securityGroup vpc.SecurityGroup
 	var securityGroup2 vpc.SecurityGroup
 
@@ -225,6 +222,58 @@ func TestAccVPCSecurityGroup_update(t *testing.T) {
 	})
 }
 
+func TestAccVPCSecurityGroup_externalRules(t *testing.T) {
+	var securityGroup vpc.SecurityGroup
+
+	networkName := acctest.RandomWithPrefix("vpc-sg-external")
+	sgName := acctest.RandomWithPrefix("vpc-sg-external")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		CheckDestroy:             testAccCheckVPCSecurityGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCSecurityGroupExternalRules(networkName, sgName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCSecurityGroupExists("yandex_vpc_security_group.sg1", &securityGroup),
+					testAccCheckVPCSecurityGroupRulesCount(&securityGroup, 1),
+					// the rule of yandex_vpc_security_group_rule is not tracked by the security group
+					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "rules_management", "external"),
+					resource.TestCheckResourceAttr("yandex_vpc_security_group.sg1", "ingress.#", "0"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccVPCSecurityGroup_duplicateRules(t *testing.T) {
+	networkName := acctest.RandomWithPrefix("vpc-sg-duplicate")
+	sgName := acctest.RandomWithPrefix("vpc-sg-duplicate")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				// duplicate rules are only warned about
+				Config:             testAccVPCSecurityGroupDuplicateRules(networkName, sgName),
+				PlanOnly:           true,
+				ExpectNonEmptyPlan: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVPCSecurityGroupRulesCount(securityGroup *vpc.SecurityGroup, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(securityGroup.Rules) != count {
+			return fmt.Errorf("security group should have %d rules, got %d", count, len(securityGroup.Rules))
+		}
+		return nil
+	}
+}
+
 func testAccCheckVPCSecurityGroupExists(name string, securityGroup *vpc.SecurityGroup) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[name]
@@ -273,7 +322,7 @@ resource "yandex_vpc_security_group" "sg1" {
 
   ingress {
     description    = "rule1 description"
-    protocol       = "tcp"
+    protocol       = "TCP"
     v4_cidr_blocks = ["10.0.1.0/24", "10.0.2.0/24"]
     port           = 8080
   }
@@ -328,7 +377,7 @@ resource "yandex_vpc_security_group" "sg1" {
 
   ingress {
     description    = "rule1 description"
-    protocol       = "icmp"
+    protocol       = "ICMP"
     v4_cidr_blocks = ["10.0.1.0/24", "10.0.2.0/24"]
     port = -1
   }
@@ -343,6 +392,58 @@ resource "yandex_vpc_security_group" "sg1" {
 `, networkName, sg1Name, test.GetExampleFolderID())
 }
 
+func testAccVPCSecurityGroupExternalRules(networkName, sgName string) string {
+	return fmt.Sprintf(`
+resource "yandex_vpc_network" "foo" {
+  name = "%s"
+}
+
+resource "yandex_vpc_security_group" "sg1" {
+  name       = "%s"
+  network_id = "${yandex_vpc_network.foo.id}"
+  folder_id  = "%s"
+
+  rules_management = "external"
+}
+
+resource "yandex_vpc_security_group_rule" "rule1" {
+  security_group_binding = yandex_vpc_security_group.sg1.id
+  direction              = "ingress"
+  protocol               = "TCP"
+  port                   = 443
+  v4_cidr_blocks         = ["10.0.1.0/24"]
+}
+`, networkName, sgName, test.GetExampleFolderID())
+}
+
+func testAccVPCSecurityGroupDuplicateRules(networkName, sgName string) string {
+	return fmt.Sprintf(`
+resource "yandex_vpc_network" "foo" {
+  name = "%s"
+}
+
+resource "yandex_vpc_security_group" "sg1" {
+  name       = "%s"
+  network_id = "${yandex_vpc_network.foo.id}"
+  folder_id  = "%s"
+
+  ingress {
+    description    = "https"
+    protocol       = "TCP"
+    v4_cidr_blocks = ["10.0.1.0/24"]
+    port           = 443
+  }
+
+  ingress {
+    description    = "https again"
+    protocol       = "TCP"
+    v4_cidr_blocks = ["10.0.1.0/24"]
+    port           = 443
+  }
+}
+`, networkName, sgName, test.GetExampleFolderID())
+}
+
 func testAccCheckVPCSecurityGroupDestroy(s *terraform.State) error {
 	config := test.AccProvider.(*yandex_framework.Provider).GetConfig()
 
@@ -0,0 +1,442 @@
+package vpc_security_group
+
+import (
+	"context"
+	"fmt"
+	"net"
+	"reflect"
+	"sort"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+)
+
+const (
+	rulesManagementInline   = "inline"
+	rulesManagementExternal = "external"
+)
+
+type ruleModel struct {
+	ID               types.String `tfsdk:"id"`
+	Description      types.String `tfsdk:"description"`
+	Labels           types.Map    `tfsdk:"labels"`
+	Protocol         types.String `tfsdk:"protocol"`
+	Port             types.Int64  `tfsdk:"port"`
+	FromPort         types.Int64  `tfsdk:"from_port"`
+	ToPort           types.Int64  `tfsdk:"to_port"`
+	V4CidrBlocks     types.List   `tfsdk:"v4_cidr_blocks"`
+	V6CidrBlocks     types.List   `tfsdk:"v6_cidr_blocks"`
+	SecurityGroupID  types.String `tfsdk:"security_group_id"`
+	PredefinedTarget types.String `tfsdk:"predefined_target"`
+}
+
+// plannedRule is an inline rule of the plan, id is empty for the rules which are not created yet.
+type plannedRule struct {
+	id   string
+	spec *vpc.SecurityGroupRuleSpec
+}
+
+func isExternalRulesManagement(mode types.String) bool {
+	return mode.ValueString() == rulesManagementExternal
+}
+
+func ruleModels(ctx context.Context, rules types.Set) ([]ruleModel, diag.Diagnostics) {
+	if rules.IsNull() || rules.IsUnknown() {
+		return nil, nil
+	}
+
+	var models []ruleModel
+	diags := rules.ElementsAs(ctx, &models, false)
+	return models, diags
+}
+
+func int64OrDefault(v types.Int64) int64 {
+	if v.IsNull() || v.IsUnknown() {
+		return -1
+	}
+	return v.ValueInt64()
+}
+
+func knownStrings(ctx context.Context, list types.List) ([]string, diag.Diagnostics) {
+	if list.IsNull() || list.IsUnknown() {
+		return nil, nil
+	}
+
+	var result []string
+	diags := list.ElementsAs(ctx, &result, false)
+	return result, diags
+}
+
+// ruleModelHasUnknowns reports whether the traffic matched by the rule isn't known yet.
+func ruleModelHasUnknowns(m ruleModel) bool {
+	return m.Protocol.IsUnknown() || m.Port.IsUnknown() || m.FromPort.IsUnknown() || m.ToPort.IsUnknown() ||
+		m.V4CidrBlocks.IsUnknown() || m.V6CidrBlocks.IsUnknown() ||
+		m.SecurityGroupID.IsUnknown() || m.PredefinedTarget.IsUnknown()
+}
+
+func expandRule(ctx context.Context, direction vpc.SecurityGroupRule_Direction, m ruleModel) (*vpc.SecurityGroupRuleSpec, diag.Diagnostics) {
+	var diags diag.Diagnostics
+
+	ports, err := ExpandRulePorts(int64OrDefault(m.Port), int64OrDefault(m.FromPort), int64OrDefault(m.ToPort))
+	if err != nil {
+		diags.AddError(
+			"Failed to construct PortRange",
+			fmt.Sprintf("Error while constructing PortRange: %s", err.Error()),
+		)
+		return nil, diags
+	}
+
+	spec := &vpc.SecurityGroupRuleSpec{
+		Description: m.Description.ValueString(),
+		Direction:   direction,
+		Ports:       ports,
+		Protocol: &vpc.SecurityGroupRuleSpec_ProtocolName{
+			ProtocolName: m.Protocol.ValueString(),
+		},
+	}
+
+	if !m.Labels.IsNull() && !m.Labels.IsUnknown() {
+		labels := make(map[string]string, len(m.Labels.Elements()))
+		diags.Append(m.Labels.ElementsAs(ctx, &labels, false)...)
+		spec.SetLabels(labels)
+	}
+
+	if v := m.SecurityGroupID.ValueString(); !m.SecurityGroupID.IsUnknown() && v != "" {
+		spec.SetSecurityGroupId(v)
+	}
+	if v := m.PredefinedTarget.ValueString(); !m.PredefinedTarget.IsUnknown() && v != "" {
+		spec.SetPredefinedTarget(v)
+	}
+
+	v4, d := knownStrings(ctx, m.V4CidrBlocks)
+	diags.Append(d...)
+	v6, d := knownStrings(ctx, m.V6CidrBlocks)
+	diags.Append(d...)
+	if len(v4) > 0 || len(v6) > 0 {
+		spec.SetCidrBlocks(&vpc.CidrBlocks{
+			V4CidrBlocks: v4,
+			V6CidrBlocks: v6,
+		})
+	}
+
+	if diags.HasError() {
+		return nil, diags
+	}
+	return spec, diags
+}
+
+func expandRules(ctx context.Context, ingress, egress types.Set) ([]plannedRule, diag.Diagnostics) {
+	var (
+		result []plannedRule
+		diags  diag.Diagnostics
+	)
+
+	for _, dir := range []struct {
+		direction vpc.SecurityGroupRule_Direction
+		rules     types.Set
+	}{
+		{vpc.SecurityGroupRule_INGRESS, ingress},
+		{vpc.SecurityGroupRule_EGRESS, egress},
+	} {
+		direction := dir.direction
+		models, d := ruleModels(ctx, dir.rules)
+		diags.Append(d...)
+		for _, m := range models {
+			spec, d := expandRule(ctx, direction, m)
+			diags.Append(d...)
+			if d.HasError() {
+				continue
+			}
+
+			id := ""
+			if !m.ID.IsUnknown() {
+				id = m.ID.ValueString()
+			}
+			result = append(result, plannedRule{id: id, spec: spec})
+		}
+	}
+
+	return result, diags
+}
+
+func ruleSpecs(rules []plannedRule) []*vpc.SecurityGroupRuleSpec {
+	specs := make([]*vpc.SecurityGroupRuleSpec, 0, len(rules))
+	for _, r := range rules {
+		specs = append(specs, r.spec)
+	}
+	return specs
+}
+
+// ruleMatchesSpec reports whether the existing rule is the same as the planned one. Labels are
+// compared only if they are set in the plan, as they are computed.
+func ruleMatchesSpec(rule *vpc.SecurityGroupRule, spec *vpc.SecurityGroupRuleSpec) bool {
+	if rule.GetDirection() != spec.GetDirection() ||
+		!strings.EqualFold(rule.GetProtocolName(), spec.GetProtocolName()) ||
+		rule.GetDescription() != spec.GetDescription() ||
+		rule.GetSecurityGroupId() != spec.GetSecurityGroupId() ||
+		rule.GetPredefinedTarget() != spec.GetPredefinedTarget() {
+		return false
+	}
+
+	if rule.GetPorts().GetFromPort() != spec.GetPorts().GetFromPort() ||
+		rule.GetPorts().GetToPort() != spec.GetPorts().GetToPort() {
+		return false
+	}
+
+	if !equalStrings(rule.GetCidrBlocks().GetV4CidrBlocks(), spec.GetCidrBlocks().GetV4CidrBlocks()) ||
+		!equalStrings(rule.GetCidrBlocks().GetV6CidrBlocks(), spec.GetCidrBlocks().GetV6CidrBlocks()) {
+		return false
+	}
+
+	if spec.GetLabels() != nil && !(len(rule.GetLabels()) == 0 && len(spec.GetLabels()) == 0) &&
+		!reflect.DeepEqual(rule.GetLabels(), spec.GetLabels()) {
+		return false
+	}
+
+	return true
+}
+
+func equalStrings(a, b []string) bool {
+	if len(a) != len(b) {
+		return false
+	}
+	for i := range a {
+		if a[i] != b[i] {
+			return false
+		}
+	}
+	return true
+}
+
+// securityGroupRulesDelta returns the rules to add and the IDs of the rules to delete to make the
+// security group rules match the planned ones. Planned rules without ID are matched with the existing
+// rules by their content, so the unchanged rules are not recreated.
+func securityGroupRulesDelta(current []*vpc.SecurityGroupRule, planned []plannedRule) ([]*vpc.SecurityGroupRuleSpec, []string) {
+	kept := make(map[string]bool)
+	var unmatched []plannedRule
+
+	for _, p := range planned {
+		matched := false
+		if p.id != "" {
+			for _, rule := range current {
+				if rule.GetId() == p.id && !kept[rule.GetId()] && ruleMatchesSpec(rule, p.spec) {
+					kept[rule.GetId()] = true
+					matched = true
+					break
+				}
+			}
+		}
+		if !matched {
+			unmatched = append(unmatched, p)
+		}
+	}
+
+	var add []*vpc.SecurityGroupRuleSpec
+	for _, p := range unmatched {
+		matched := false
+		for _, rule := range current {
+			if !kept[rule.GetId()] && ruleMatchesSpec(rule, p.spec) {
+				kept[rule.GetId()] = true
+				matched = true
+				break
+			}
+		}
+		if !matched {
+			add = append(add, p.spec)
+		}
+	}
+
+	var del []string
+	for _, rule := range current {
+		if !kept[rule.GetId()] {
+			del = append(del, rule.GetId())
+		}
+	}
+
+	return add, del
+}
+
+// matchPriorRule finds the rule of the prior state or plan describing the existing rule, first by ID
+// and then by content. The found rule is marked as used.
+func matchPriorRule(ctx context.Context, rule *vpc.SecurityGroupRule, prior []ruleModel, used []bool) *ruleModel {
+	for i, m := range prior {
+		if !used[i] && !m.ID.IsUnknown() && m.ID.ValueString() != "" && m.ID.ValueString() == rule.GetId() {
+			used[i] = true
+			return &prior[i]
+		}
+	}
+
+	for i, m := range prior {
+		if used[i] || (!m.ID.IsUnknown() && m.ID.ValueString() != "") {
+			continue
+		}
+		spec, diags := expandRule(ctx, rule.GetDirection(), m)
+		if diags.HasError() {
+			continue
+		}
+		if ruleMatchesSpec(rule, spec) {
+			used[i] = true
+			return &prior[i]
+		}
+	}
+
+	return nil
+}
+
+// findRuleConflicts returns the pairs of indexes of the rules matching the same traffic. The rules of
+// duplicates differ in description or labels only, overlapping rules match a part of the same traffic.
+func findRuleConflicts(specs []*vpc.SecurityGroupRuleSpec) (duplicates [][2]int, overlaps [][2]int) {
+	for i := 0; i < len(specs); i++ {
+		for j := i + 1; j < len(specs); j++ {
+			a, b := specs[i], specs[j]
+			if a.GetDirection() != b.GetDirection() {
+				continue
+			}
+
+			switch {
+			case sameRuleTraffic(a, b):
+				duplicates = append(duplicates, [2]int{i, j})
+			case protocolsOverlap(a.GetProtocolName(), b.GetProtocolName()) && portsOverlap(a.GetPorts(), b.GetPorts()) && targetsOverlap(a, b):
+				overlaps = append(overlaps, [2]int{i, j})
+			}
+		}
+	}
+	return duplicates, overlaps
+}
+
+func normalizeRuleProtocol(protocol string) string {
+	if protocol == "" {
+		return "ANY"
+	}
+	return strings.ToUpper(protocol)
+}
+
+func sameRuleTraffic(a, b *vpc.SecurityGroupRuleSpec) bool {
+	return normalizeRuleProtocol(a.GetProtocolName()) == normalizeRuleProtocol(b.GetProtocolName()) &&
+		a.GetPorts().GetFromPort() == b.GetPorts().GetFromPort() &&
+		a.GetPorts().GetToPort() == b.GetPorts().GetToPort() &&
+		equalStrings(sortedStrings(a.GetCidrBlocks().GetV4CidrBlocks()), sortedStrings(b.GetCidrBlocks().GetV4CidrBlocks())) &&
+		equalStrings(sortedStrings(a.GetCidrBlocks().GetV6CidrBlocks()), sortedStrings(b.GetCidrBlocks().GetV6CidrBlocks())) &&
+		a.GetSecurityGroupId() == b.GetSecurityGroupId() &&
+		a.GetPredefinedTarget() == b.GetPredefinedTarget()
+}
+
+func sortedStrings(s []string) []string {
+	result := append([]string(nil), s...)
+	sort.Strings(result)
+	return result
+}
+
+func protocolsOverlap(a, b string) bool {
+	a, b = normalizeRuleProtocol(a), normalizeRuleProtocol(b)
+	return a == "ANY" || b == "ANY" || a == b
+}
+
+// portsOverlap checks the port ranges, a rule without ports matches any port.
+func portsOverlap(a, b *vpc.PortRange) bool {
+	if a == nil || b == nil {
+		return true
+	}
+	return a.GetFromPort() <= b.GetToPort() && b.GetFromPort() <= a.GetToPort()
+}
+
+func targetsOverlap(a, b *vpc.SecurityGroupRuleSpec) bool {
+	switch {
+	case a.GetCidrBlocks() != nil && b.GetCidrBlocks() != nil:
+		return anyCidrsOverlap(a.GetCidrBlocks().GetV4CidrBlocks(), b.GetCidrBlocks().GetV4CidrBlocks()) ||
+			anyCidrsOverlap(a.GetCidrBlocks().GetV6CidrBlocks(), b.GetCidrBlocks().GetV6CidrBlocks())
+	case a.GetSecurityGroupId() != "" && b.GetSecurityGroupId() != "":
+		return a.GetSecurityGroupId() == b.GetSecurityGroupId()
+	case a.GetPredefinedTarget() != "" && b.GetPredefinedTarget() != "":
+		return a.GetPredefinedTarget() == b.GetPredefinedTarget()
+	}
+	return false
+}
+
+func anyCidrsOverlap(a, b []string) bool {
+	for _, x := range a {
+		for _, y := range b {
+			if cidrsOverlap(x, y) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+func cidrsOverlap(a, b string) bool {
+	_, netA, errA := net.ParseCIDR(a)
+	_, netB, errB := net.ParseCIDR(b)
+	if errA != nil || errB != nil {
+		return a == b
+	}
+	return netA.Contains(netB.IP) || netB.Contains(netA.IP)
+}
+
+func describeRuleSpec(spec *vpc.SecurityGroupRuleSpec) string {
+	parts := []string{"protocol " + normalizeRuleProtocol(spec.GetProtocolName())}
+
+	if ports := spec.GetPorts(); ports == nil {
+		parts = append(parts, "any port")
+	} else if ports.GetFromPort() == ports.GetToPort() {
+		parts = append(parts, fmt.Sprintf("port %d", ports.GetFromPort()))
+	} else {
+		parts = append(parts, fmt.Sprintf("ports %d-%d", ports.GetFromPort(), ports.GetToPort()))
+	}
+
+	switch {
+	case spec.GetSecurityGroupId() != "":
+		parts = append(parts, "security group "+spec.GetSecurityGroupId())
+	case spec.GetPredefinedTarget() != "":
+		parts = append(parts, "target "+spec.GetPredefinedTarget())
+	default:
+		cidrs := append(append([]string(nil), spec.GetCidrBlocks().GetV4CidrBlocks()...), spec.GetCidrBlocks().GetV6CidrBlocks()...)
+		parts = append(parts, "CIDR blocks "+strings.Join(cidrs, ", "))
+	}
+
+	return strings.Join(parts, ", ")
+}
+
+// validateRuleConflicts reports duplicate and overlapping rules as warnings. The rules with unknown values
+// are skipped.
+func validateRuleConflicts(ctx context.Context, attribute string, direction vpc.SecurityGroupRule_Direction, rules types.Set, diags *diag.Diagnostics) {
+	models, d := ruleModels(ctx, rules)
+	diags.Append(d...)
+	if diags.HasError() {
+		return
+	}
+
+	var specs []*vpc.SecurityGroupRuleSpec
+	for _, m := range models {
+		if ruleModelHasUnknowns(m) {
+			continue
+		}
+		spec, d := expandRule(ctx, direction, m)
+		if d.HasError() {
+			// invalid rules are reported by the attribute validators
+			continue
+		}
+		specs = append(specs, spec)
+	}
+
+	duplicates, overlaps := findRuleConflicts(specs)
+	for _, pair := range duplicates {
+		diags.AddAttributeWarning(
+			path.Root(attribute),
+			"Duplicate Security Group rule",
+			fmt.Sprintf("Two %s rules match the same traffic (%s), they differ only in description or labels.",
+				attribute, describeRuleSpec(specs[pair[0]])),
+		)
+	}
+	for _, pair := range overlaps {
+		diags.AddAttributeWarning(
+			path.Root(attribute),
+			"Overlapping Security Group rules",
+			fmt.Sprintf("The %s rule (%s) overlaps with the rule (%s).",
+				attribute, describeRuleSpec(specs[pair[0]]), describeRuleSpec(specs[pair[1]])),
+		)
+	}
+}
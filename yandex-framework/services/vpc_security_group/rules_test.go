@@ -0,0 +1,192 @@
+package vpc_security_group
+
+import (
+	"context"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+)
+
+func testRuleSpec(protocol string, from, to int64, cidrs ...string) *vpc.SecurityGroupRuleSpec {
+	spec := &vpc.SecurityGroupRuleSpec{
+		Direction: vpc.SecurityGroupRule_INGRESS,
+		Protocol:  &vpc.SecurityGroupRuleSpec_ProtocolName{ProtocolName: protocol},
+	}
+	if from >= 0 {
+		spec.Ports = &vpc.PortRange{FromPort: from, ToPort: to}
+	}
+	if len(cidrs) > 0 {
+		spec.SetCidrBlocks(&vpc.CidrBlocks{V4CidrBlocks: cidrs})
+	}
+	return spec
+}
+
+func testRule(id string, spec *vpc.SecurityGroupRuleSpec) *vpc.SecurityGroupRule {
+	return &vpc.SecurityGroupRule{
+		Id:           id,
+		Direction:    spec.GetDirection(),
+		Description:  spec.GetDescription(),
+		Ports:        spec.GetPorts(),
+		ProtocolName: spec.GetProtocolName(),
+		Target:       &vpc.SecurityGroupRule_CidrBlocks{CidrBlocks: spec.GetCidrBlocks()},
+	}
+}
+
+func TestSecurityGroupRulesDelta(t *testing.T) {
+	http := testRuleSpec("TCP", 80, 80, "10.0.0.0/8")
+	https := testRuleSpec("TCP", 443, 443, "10.0.0.0/8")
+	ssh := testRuleSpec("TCP", 22, 22, "10.1.0.0/16")
+
+	current := []*vpc.SecurityGroupRule{
+		testRule("r1", http),
+		testRule("r2", https),
+		// added by a yandex_vpc_security_group_rule resource
+		testRule("r3", ssh),
+	}
+
+	planned := []plannedRule{
+		{id: "r1", spec: http},
+		// known rule without ID in the plan, e.g. after changing the protocol spelling
+		{spec: testRuleSpec("tcp", 443, 443, "10.0.0.0/8")},
+		{spec: testRuleSpec("UDP", 53, 53, "10.0.0.0/8")},
+	}
+
+	add, del := securityGroupRulesDelta(current, planned)
+	if len(add) != 1 || add[0].GetProtocolName() != "UDP" {
+		t.Errorf("Unexpected rules to add: %v", add)
+	}
+	if !reflect.DeepEqual(del, []string{"r3"}) {
+		t.Errorf("Unexpected rules to delete: %v", del)
+	}
+}
+
+func TestFindRuleConflicts(t *testing.T) {
+	described := testRuleSpec("TCP", 80, 80, "10.0.0.0/8")
+	described.Description = "http"
+
+	specs := []*vpc.SecurityGroupRuleSpec{
+		testRuleSpec("TCP", 80, 80, "10.0.0.0/8"),
+		described,
+		testRuleSpec("ANY", 70, 90, "10.1.0.0/16"),
+		testRuleSpec("UDP", 80, 80, "10.0.0.0/8"),
+		testRuleSpec("TCP", 443, 443, "192.168.0.0/16"),
+	}
+
+	duplicates, overlaps := findRuleConflicts(specs)
+	if !reflect.DeepEqual(duplicates, [][2]int{{0, 1}}) {
+		t.Errorf("Unexpected duplicates: %v", duplicates)
+	}
+	if !reflect.DeepEqual(overlaps, [][2]int{{0, 2}, {1, 2}, {2, 3}}) {
+		t.Errorf("Unexpected overlaps: %v", overlaps)
+	}
+}
+
+func TestValidateRuleConflictsWarnsAboutDuplicates(t *testing.T) {
+	ctx := context.Background()
+
+	rule := func(description string) attr.Value {
+		return types.ObjectValueMust(ruleType.AttrTypes, map[string]attr.Value{
+			"id":                types.StringUnknown(),
+			"description":       types.StringValue(description),
+			"labels":            types.MapNull(types.StringType),
+			"protocol":          types.StringValue("TCP"),
+			"port":              types.Int64Value(443),
+			"from_port":         types.Int64Null(),
+			"to_port":           types.Int64Null(),
+			"v4_cidr_blocks":    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10.0.1.0/24")}),
+			"v6_cidr_blocks":    types.ListNull(types.StringType),
+			"security_group_id": types.StringNull(),
+			"predefined_target": types.StringNull(),
+		})
+	}
+	rules, diags := types.SetValue(ruleType, []attr.Value{rule("https"), rule("https again")})
+	if diags.HasError() {
+		t.Fatalf("Failed to build rules: %v", diags)
+	}
+
+	validateRuleConflicts(ctx, "ingress", vpc.SecurityGroupRule_INGRESS, rules, &diags)
+	if diags.HasError() {
+		t.Fatalf("Unexpected errors: %v", diags)
+	}
+	if diags.WarningsCount() != 1 || diags.Warnings()[0].Summary() != "Duplicate Security Group rule" {
+		t.Errorf("Unexpected warnings: %v", diags.Warnings())
+	}
+}
+
+func TestFlattenRulesKeepsConfiguredValues(t *testing.T) {
+	ctx := context.Background()
+
+	prior, diags := types.SetValue(ruleType, []attr.Value{
+		types.ObjectValueMust(ruleType.AttrTypes, map[string]attr.Value{
+			"id":                types.StringUnknown(),
+			"description":       types.StringNull(),
+			"labels":            types.MapUnknown(types.StringType),
+			"protocol":          types.StringValue("tcp"),
+			"port":              types.Int64Value(80),
+			"from_port":         types.Int64Value(-1),
+			"to_port":           types.Int64Value(-1),
+			"v4_cidr_blocks":    types.ListValueMust(types.StringType, []attr.Value{types.StringValue("10.0.0.0/8")}),
+			"v6_cidr_blocks":    types.ListUnknown(types.StringType),
+			"security_group_id": types.StringUnknown(),
+			"predefined_target": types.StringUnknown(),
+		}),
+	})
+	if diags.HasError() {
+		t.Fatalf("Failed to build prior rules: %v", diags)
+	}
+
+	ingress, _, diags := flattenRules(ctx, []*vpc.SecurityGroupRule{
+		testRule("r1", testRuleSpec("TCP", 80, 80, "10.0.0.0/8")),
+	}, prior, types.SetNull(ruleType))
+	if diags.HasError() {
+		t.Fatalf("Failed to flatten rules: %v", diags)
+	}
+
+	models, diags := ruleModels(ctx, ingress)
+	if diags.HasError() || len(models) != 1 {
+		t.Fatalf("Unexpected flattened rules: %v, %v", models, diags)
+	}
+	if models[0].Protocol.ValueString() != "tcp" || !models[0].Description.IsNull() || models[0].ID.ValueString() != "r1" {
+		t.Errorf("Unexpected flattened rule: %+v", models[0])
+	}
+}
+
+func TestSecurityGroupIDFromRawState(t *testing.T) {
+	cases := []struct {
+		name          string
+		rawState      string
+		expectedID    string
+		expectedError bool
+	}{
+		{
+			name:       "legacy state",
+			rawState:   `{"id":"sg-id","name":"sg","network_id":"net-id","ingress":[{"protocol":"TCP","port":443}]}`,
+			expectedID: "sg-id",
+		},
+		{
+			name:          "state without id",
+			rawState:      `{"name":"sg"}`,
+			expectedError: true,
+		},
+		{
+			name:          "broken state",
+			rawState:      `{`,
+			expectedError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			id, diags := securityGroupIDFromRawState([]byte(tc.rawState))
+			if diags.HasError() != tc.expectedError {
+				t.Fatalf("Unexpected diagnostics: %v", diags)
+			}
+			if id != tc.expectedID {
+				t.Errorf("Unexpected security group id: expected %q, got %q", tc.expectedID, id)
+			}
+		})
+	}
+}
@@ -0,0 +1,307 @@
+package vpc_security_group_evaluation
+
+import (
+	"context"
+	"fmt"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-framework-validators/int64validator"
+	"github.com/hashicorp/terraform-plugin-framework-validators/stringvalidator"
+	"github.com/hashicorp/terraform-plugin-framework/datasource"
+	"github.com/hashicorp/terraform-plugin-framework/datasource/schema"
+	"github.com/hashicorp/terraform-plugin-framework/diag"
+	"github.com/hashicorp/terraform-plugin-framework/path"
+	"github.com/hashicorp/terraform-plugin-framework/schema/validator"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/hashicorp/terraform-plugin-log/tflog"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	provider_config "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider/config"
+	sg_api "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/services/vpc_security_group/api"
+)
+
+var (
+	_ datasource.DataSource              = &securityGroupEvaluationDataSource{}
+	_ datasource.DataSourceWithConfigure = &securityGroupEvaluationDataSource{}
+)
+
+type securityGroupEvaluationDataSource struct {
+	providerConfig *provider_config.Config
+}
+
+func NewDataSource() datasource.DataSource {
+	return &securityGroupEvaluationDataSource{}
+}
+
+func (d *securityGroupEvaluationDataSource) Metadata(ctx context.Context, req datasource.MetadataRequest, resp *datasource.MetadataResponse) {
+	resp.TypeName = req.ProviderTypeName + "_vpc_security_group_evaluation"
+}
+
+func endpointAttribute(description string) schema.SingleNestedAttribute {
+	return schema.SingleNestedAttribute{
+		MarkdownDescription: description,
+		Required:            true,
+		Attributes: map[string]schema.Attribute{
+			"instance_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the compute instance. The address and the security groups of its first network interface are used.",
+				Optional:            true,
+				Validators: []validator.String{
+					stringvalidator.ConflictsWith(path.Expressions{
+						path.MatchRelative().AtParent().AtName("security_group_id"),
+						path.MatchRelative().AtParent().AtName("subnet_id"),
+						path.MatchRelative().AtParent().AtName("ip_address"),
+					}...),
+					stringvalidator.AtLeastOneOf(path.Expressions{
+						path.MatchRelative().AtParent().AtName("security_group_id"),
+						path.MatchRelative().AtParent().AtName("subnet_id"),
+						path.MatchRelative().AtParent().AtName("ip_address"),
+					}...),
+				},
+			},
+			"security_group_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the security group applied to the endpoint.",
+				Optional:            true,
+			},
+			"subnet_id": schema.StringAttribute{
+				MarkdownDescription: "ID of the subnet of the endpoint. Its CIDR blocks are used as the addresses unless `ip_address` is set, the default security group of its network is used unless `security_group_id` is set.",
+				Optional:            true,
+			},
+			"ip_address": schema.StringAttribute{
+				MarkdownDescription: "IP address of the endpoint. An address without `security_group_id` and `subnet_id` is considered to be outside of the cloud, its traffic isn't filtered.",
+				Optional:            true,
+			},
+		},
+	}
+}
+
+func (d *securityGroupEvaluationDataSource) Schema(ctx context.Context, req datasource.SchemaRequest, resp *datasource.SchemaResponse) {
+	tflog.Debug(ctx, "Initializing VPC SecurityGroupEvaluation schema")
+	resp.Schema = schema.Schema{
+		MarkdownDescription: "Evaluates the security group rules for the traffic from the source to the destination. The traffic is allowed if an egress rule of the source security groups and an ingress rule of the destination security groups match it. Only the read APIs are used. For more information, see [Documentation](https://yandex.cloud/docs/vpc/concepts/security-groups).\n\n~> The rules matching the endpoints by CIDR blocks are only evaluated if the endpoint addresses are known, i.e. `instance_id`, `subnet_id` or `ip_address` is set.\n\n",
+		Attributes: map[string]schema.Attribute{
+			"id": schema.StringAttribute{
+				MarkdownDescription: "The evaluation identifier.",
+				Computed:            true,
+			},
+			"source":      endpointAttribute("The source of the traffic. One of `instance_id`, `security_group_id`, `subnet_id` or `ip_address` must be set, the last three can be combined."),
+			"destination": endpointAttribute("The destination of the traffic. One of `instance_id`, `security_group_id`, `subnet_id` or `ip_address` must be set, the last three can be combined."),
+			"protocol": schema.StringAttribute{
+				MarkdownDescription: "Network protocol of the traffic. Can be one of `TCP`, `UDP`, `ICMP` or `IPV6_ICMP`.",
+				Required:            true,
+				Validators: []validator.String{
+					stringvalidator.OneOfCaseInsensitive("TCP", "UDP", "ICMP", "IPV6_ICMP"),
+				},
+			},
+			"port": schema.Int64Attribute{
+				MarkdownDescription: "Destination port of the traffic. Without the port only the rules for all ports match.",
+				Optional:            true,
+				Validators: []validator.Int64{
+					int64validator.Between(0, 65535),
+				},
+			},
+			"allowed": schema.BoolAttribute{
+				MarkdownDescription: "Whether the traffic is allowed.",
+				Computed:            true,
+			},
+			"egress_allowed": schema.BoolAttribute{
+				MarkdownDescription: "Whether the traffic is allowed by the egress rules of the source. Not set if the source traffic isn't filtered.",
+				Computed:            true,
+			},
+			"ingress_allowed": schema.BoolAttribute{
+				MarkdownDescription: "Whether the traffic is allowed by the ingress rules of the destination. Not set if the destination traffic isn't filtered.",
+				Computed:            true,
+			},
+			"matching_rules": schema.ListNestedAttribute{
+				MarkdownDescription: "The rules allowing the traffic.",
+				Computed:            true,
+				NestedObject: schema.NestedAttributeObject{
+					Attributes: map[string]schema.Attribute{
+						"direction": schema.StringAttribute{
+							MarkdownDescription: "Direction of the rule, `ingress` or `egress`.",
+							Computed:            true,
+						},
+						"security_group_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the security group the rule belongs to.",
+							Computed:            true,
+						},
+						"rule_id": schema.StringAttribute{
+							MarkdownDescription: "ID of the rule.",
+							Computed:            true,
+						},
+						"description": schema.StringAttribute{
+							MarkdownDescription: "Description of the rule.",
+							Computed:            true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func (d *securityGroupEvaluationDataSource) Read(ctx context.Context, req datasource.ReadRequest, resp *datasource.ReadResponse) {
+	var state evaluationModel
+	resp.Diagnostics.Append(req.Config.Get(ctx, &state)...)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	source := d.resolveEndpoint(ctx, path.Root("source"), state.Source, &resp.Diagnostics)
+	destination := d.resolveEndpoint(ctx, path.Root("destination"), state.Destination, &resp.Diagnostics)
+	if resp.Diagnostics.HasError() {
+		return
+	}
+
+	port := int64(-1)
+	if !state.Port.IsNull() {
+		port = state.Port.ValueInt64()
+	}
+
+	egress, ingress := evaluate(source, destination, state.Protocol.ValueString(), port)
+	evaluationToState(egress, ingress, &state)
+	state.ID = types.StringValue(fmt.Sprintf("%s-%s-%s-%d", endpointID(state.Source), endpointID(state.Destination), state.Protocol.ValueString(), port))
+
+	resp.Diagnostics.Append(resp.State.Set(ctx, &state)...)
+}
+
+// resolveEndpoint reads the addresses and the security groups of the endpoint.
+func (d *securityGroupEvaluationDataSource) resolveEndpoint(ctx context.Context, p path.Path, m *endpointModel, diags *diag.Diagnostics) *endpoint {
+	e := &endpoint{}
+	var sgIDs []string
+
+	switch {
+	case m.InstanceID.ValueString() != "":
+		instance, err := d.providerConfig.SDK.Compute().Instance().Get(ctx, &compute.GetInstanceRequest{
+			InstanceId: m.InstanceID.ValueString(),
+		})
+		if err != nil {
+			diags.AddAttributeError(p.AtName("instance_id"), "Failed to get Instance", "Error while requesting API to get Instance: "+err.Error())
+			return nil
+		}
+		if len(instance.GetNetworkInterfaces()) == 0 {
+			diags.AddAttributeError(p.AtName("instance_id"), "Failed to get Instance", fmt.Sprintf("Instance %s has no network interfaces", instance.GetId()))
+			return nil
+		}
+
+		nic := instance.GetNetworkInterfaces()[0]
+		for _, address := range []string{nic.GetPrimaryV4Address().GetAddress(), nic.GetPrimaryV6Address().GetAddress()} {
+			if address != "" {
+				e.networks = append(e.networks, hostNetwork(net.ParseIP(address)))
+			}
+		}
+		sgIDs = nic.GetSecurityGroupIds()
+		if len(sgIDs) == 0 {
+			sgIDs = d.defaultSecurityGroupIDs(ctx, p.AtName("instance_id"), nic.GetSubnetId(), diags)
+		}
+
+	default:
+		if m.IPAddress.ValueString() != "" {
+			ip := net.ParseIP(m.IPAddress.ValueString())
+			if ip == nil {
+				diags.AddAttributeError(p.AtName("ip_address"), "Invalid IP address", fmt.Sprintf("%q is not a valid IP address", m.IPAddress.ValueString()))
+				return nil
+			}
+			e.networks = append(e.networks, hostNetwork(ip))
+		}
+
+		if m.SubnetID.ValueString() != "" {
+			subnet, err := d.providerConfig.SDK.VPC().Subnet().Get(ctx, &vpc.GetSubnetRequest{
+				SubnetId: m.SubnetID.ValueString(),
+			})
+			if err != nil {
+				diags.AddAttributeError(p.AtName("subnet_id"), "Failed to get Subnet", "Error while requesting API to get Subnet: "+err.Error())
+				return nil
+			}
+			if len(e.networks) == 0 {
+				for _, block := range append(subnet.GetV4CidrBlocks(), subnet.GetV6CidrBlocks()...) {
+					if _, n, err := net.ParseCIDR(block); err == nil {
+						e.networks = append(e.networks, n)
+					}
+				}
+			}
+			if m.SecurityGroupID.ValueString() == "" {
+				sgIDs = d.networkDefaultSecurityGroupIDs(ctx, p.AtName("subnet_id"), subnet.GetNetworkId(), diags)
+			}
+		}
+
+		if m.SecurityGroupID.ValueString() != "" {
+			sgIDs = []string{m.SecurityGroupID.ValueString()}
+		}
+	}
+	if diags.HasError() {
+		return nil
+	}
+
+	for _, sgID := range sgIDs {
+		sg := sg_api.ReadSecurityGroup(ctx, d.providerConfig.SDK, diags, sgID)
+		if diags.HasError() {
+			return nil
+		}
+		if sg == nil {
+			diags.AddAttributeError(p, "Failed to get SecurityGroup", fmt.Sprintf("SecurityGroup with id %s not found", sgID))
+			return nil
+		}
+		e.securityGroups = append(e.securityGroups, sg)
+	}
+	return e
+}
+
+// defaultSecurityGroupIDs returns the default security group of the subnet network, it's applied to the
+// network interfaces without security groups.
+func (d *securityGroupEvaluationDataSource) defaultSecurityGroupIDs(ctx context.Context, p path.Path, subnetID string, diags *diag.Diagnostics) []string {
+	subnet, err := d.providerConfig.SDK.VPC().Subnet().Get(ctx, &vpc.GetSubnetRequest{
+		SubnetId: subnetID,
+	})
+	if err != nil {
+		diags.AddAttributeError(p, "Failed to get Subnet", "Error while requesting API to get Subnet: "+err.Error())
+		return nil
+	}
+	return d.networkDefaultSecurityGroupIDs(ctx, p, subnet.GetNetworkId(), diags)
+}
+
+func (d *securityGroupEvaluationDataSource) networkDefaultSecurityGroupIDs(ctx context.Context, p path.Path, networkID string, diags *diag.Diagnostics) []string {
+	network, err := d.providerConfig.SDK.VPC().Network().Get(ctx, &vpc.GetNetworkRequest{
+		NetworkId: networkID,
+	})
+	if err != nil {
+		diags.AddAttributeError(p, "Failed to get Network", "Error while requesting API to get Network: "+err.Error())
+		return nil
+	}
+	if network.GetDefaultSecurityGroupId() == "" {
+		return nil
+	}
+	return []string{network.GetDefaultSecurityGroupId()}
+}
+
+func (d *securityGroupEvaluationDataSource) Configure(ctx context.Context, req datasource.ConfigureRequest, resp *datasource.ConfigureResponse) {
+	if req.ProviderData == nil {
+		return
+	}
+
+	providerConfig, ok := req.ProviderData.(*provider_config.Config)
+	if !ok {
+		resp.Diagnostics.AddError(
+			"Unexpected Resource Configure Type",
+			fmt.Sprintf("Expected *provider_config.Config, got: %T. Please report this issue to the provider developers.", req.ProviderData),
+		)
+		return
+	}
+
+	d.providerConfig = providerConfig
+}
+
+func hostNetwork(ip net.IP) *net.IPNet {
+	if v4 := ip.To4(); v4 != nil {
+		return &net.IPNet{IP: v4, Mask: net.CIDRMask(32, 32)}
+	}
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(128, 128)}
+}
+
+func endpointID(m *endpointModel) string {
+	for _, v := range []types.String{m.InstanceID, m.SecurityGroupID, m.SubnetID, m.IPAddress} {
+		if v.ValueString() != "" {
+			return v.ValueString()
+		}
+	}
+	return ""
+}
@@ -0,0 +1,88 @@
+package vpc_security_group_evaluation_test
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	test "github.com/yandex-cloud/terraform-provider-yandex/pkg/testhelpers"
+)
+
+func TestAccDataSourceVPCSecurityGroupEvaluation(t *testing.T) {
+	networkName := acctest.RandomWithPrefix("tf-sg-evaluation")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:                 func() { test.AccPreCheck(t) },
+		ProtoV6ProviderFactories: test.AccProviderFactories,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVPCSecurityGroupEvaluationConfig(networkName),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_vpc_security_group_evaluation.pg", "allowed", "true"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_security_group_evaluation.pg", "egress_allowed", "true"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_security_group_evaluation.pg", "ingress_allowed", "true"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_security_group_evaluation.pg", "matching_rules.#", "2"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_security_group_evaluation.pg", "matching_rules.1.direction", "ingress"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_security_group_evaluation.pg", "matching_rules.1.description", "postgresql from app"),
+					resource.TestCheckResourceAttrPair("data.yandex_vpc_security_group_evaluation.pg", "matching_rules.1.security_group_id", "yandex_vpc_security_group.db", "id"),
+
+					resource.TestCheckResourceAttr("data.yandex_vpc_security_group_evaluation.ssh", "allowed", "false"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_security_group_evaluation.ssh", "ingress_allowed", "false"),
+
+					resource.TestCheckResourceAttr("data.yandex_vpc_security_group_evaluation.internet", "allowed", "false"),
+					resource.TestCheckNoResourceAttr("data.yandex_vpc_security_group_evaluation.internet", "egress_allowed"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceVPCSecurityGroupEvaluationConfig(networkName string) string {
+	return fmt.Sprintf(`
+resource "yandex_vpc_network" "net" {
+  name = "%s"
+}
+
+resource "yandex_vpc_security_group" "app" {
+  network_id = yandex_vpc_network.net.id
+
+  egress {
+    protocol       = "ANY"
+    v4_cidr_blocks = ["0.0.0.0/0"]
+  }
+}
+
+resource "yandex_vpc_security_group" "db" {
+  network_id = yandex_vpc_network.net.id
+
+  ingress {
+    description       = "postgresql from app"
+    protocol          = "TCP"
+    port              = 5432
+    security_group_id = yandex_vpc_security_group.app.id
+  }
+}
+
+data "yandex_vpc_security_group_evaluation" "pg" {
+  source      = { security_group_id = yandex_vpc_security_group.app.id }
+  destination = { security_group_id = yandex_vpc_security_group.db.id }
+  protocol    = "TCP"
+  port        = 5432
+}
+
+data "yandex_vpc_security_group_evaluation" "ssh" {
+  source      = { security_group_id = yandex_vpc_security_group.app.id }
+  destination = { security_group_id = yandex_vpc_security_group.db.id }
+  protocol    = "TCP"
+  port        = 22
+}
+
+data "yandex_vpc_security_group_evaluation" "internet" {
+  source      = { ip_address = "203.0.113.10" }
+  destination = { security_group_id = yandex_vpc_security_group.db.id }
+  protocol    = "TCP"
+  port        = 5432
+}
+`, networkName)
+}
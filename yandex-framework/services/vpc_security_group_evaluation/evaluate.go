@@ -0,0 +1,160 @@
+package vpc_security_group_evaluation
+
+import (
+	"net"
+	"strings"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+)
+
+const (
+	predefinedTargetSelf         = "self_security_group"
+	predefinedTargetHealthChecks = "loadbalancer_healthchecks"
+)
+
+// healthCheckNetworks are the addresses of the load balancer health check nodes,
+// see https://yandex.cloud/docs/network-load-balancer/concepts/health-check.
+var healthCheckNetworks = []string{"198.18.235.0/24", "198.18.248.0/24"}
+
+// endpoint is one side of the evaluated traffic.
+type endpoint struct {
+	// networks are the addresses of the endpoint, empty if they are unknown.
+	networks []*net.IPNet
+	// securityGroups are applied to the endpoint traffic. The traffic isn't filtered if there are none,
+	// e.g. for the addresses outside of the cloud.
+	securityGroups []*vpc.SecurityGroup
+}
+
+func (e *endpoint) hasSecurityGroup(id string) bool {
+	for _, sg := range e.securityGroups {
+		if sg.GetId() == id {
+			return true
+		}
+	}
+	return false
+}
+
+// within reports whether the addresses of one of the families are known and belong to the CIDR blocks of
+// that family. A dual-stack endpoint matches if either its IPv4 or its IPv6 addresses match, as the traffic
+// uses one family at a time.
+func (e *endpoint) within(v4CidrBlocks, v6CidrBlocks []string) bool {
+	var v4, v6 []*net.IPNet
+	for _, n := range e.networks {
+		if n.IP.To4() != nil {
+			v4 = append(v4, n)
+		} else {
+			v6 = append(v6, n)
+		}
+	}
+	return networksWithin(v4, v4CidrBlocks) || networksWithin(v6, v6CidrBlocks)
+}
+
+func networksWithin(networks []*net.IPNet, cidrBlocks []string) bool {
+	if len(networks) == 0 {
+		return false
+	}
+
+	for _, n := range networks {
+		if !networkWithin(n, cidrBlocks) {
+			return false
+		}
+	}
+	return true
+}
+
+func networkWithin(n *net.IPNet, cidrBlocks []string) bool {
+	nOnes, nBits := n.Mask.Size()
+	for _, block := range cidrBlocks {
+		_, blockNet, err := net.ParseCIDR(block)
+		if err != nil {
+			continue
+		}
+		ones, bits := blockNet.Mask.Size()
+		if bits == nBits && ones <= nOnes && blockNet.Contains(n.IP) {
+			return true
+		}
+	}
+	return false
+}
+
+type matchingRule struct {
+	direction       vpc.SecurityGroupRule_Direction
+	securityGroupID string
+	rule            *vpc.SecurityGroupRule
+}
+
+// evaluation is the result of the evaluation of one direction. The traffic is allowed if the direction
+// isn't filtered or at least one rule matches.
+type evaluation struct {
+	filtered bool
+	rules    []matchingRule
+}
+
+func (e evaluation) allowed() bool {
+	return !e.filtered || len(e.rules) > 0
+}
+
+// evaluate checks the egress rules of the source and the ingress rules of the destination. The port is
+// ignored if it's negative.
+func evaluate(source, destination *endpoint, protocol string, port int64) (egress, ingress evaluation) {
+	egress = evaluateDirection(source, destination, vpc.SecurityGroupRule_EGRESS, protocol, port)
+	ingress = evaluateDirection(destination, source, vpc.SecurityGroupRule_INGRESS, protocol, port)
+	return egress, ingress
+}
+
+func evaluateDirection(self, peer *endpoint, direction vpc.SecurityGroupRule_Direction, protocol string, port int64) evaluation {
+	result := evaluation{filtered: len(self.securityGroups) > 0}
+	for _, sg := range self.securityGroups {
+		for _, rule := range sg.GetRules() {
+			if rule.GetDirection() == direction && ruleMatches(sg, rule, peer, protocol, port) {
+				result.rules = append(result.rules, matchingRule{
+					direction:       direction,
+					securityGroupID: sg.GetId(),
+					rule:            rule,
+				})
+			}
+		}
+	}
+	return result
+}
+
+func ruleMatches(sg *vpc.SecurityGroup, rule *vpc.SecurityGroupRule, peer *endpoint, protocol string, port int64) bool {
+	if ruleProtocol := normalizeRuleProtocol(rule.GetProtocolName()); ruleProtocol != "ANY" && ruleProtocol != strings.ToUpper(protocol) {
+		return false
+	}
+
+	if !portMatches(rule.GetPorts(), port) {
+		return false
+	}
+
+	switch {
+	case rule.GetCidrBlocks() != nil:
+		return peer.within(rule.GetCidrBlocks().GetV4CidrBlocks(), rule.GetCidrBlocks().GetV6CidrBlocks())
+	case rule.GetSecurityGroupId() != "":
+		return peer.hasSecurityGroup(rule.GetSecurityGroupId())
+	case rule.GetPredefinedTarget() == predefinedTargetSelf:
+		return peer.hasSecurityGroup(sg.GetId())
+	case rule.GetPredefinedTarget() == predefinedTargetHealthChecks:
+		return peer.within(healthCheckNetworks, nil)
+	}
+	return false
+}
+
+// portMatches checks the port range of the rule, a rule without ports matches any port. A rule with ports
+// doesn't match the traffic without a port, unless it covers all the ports.
+func portMatches(ports *vpc.PortRange, port int64) bool {
+	if ports == nil || (ports.GetFromPort() <= 0 && ports.GetToPort() >= 65535) {
+		return true
+	}
+	if port < 0 {
+		return false
+	}
+	return ports.GetFromPort() <= port && port <= ports.GetToPort()
+}
+
+func normalizeRuleProtocol(protocol string) string {
+	if protocol == "" {
+		return "ANY"
+	}
+	return strings.ToUpper(protocol)
+}
@@ -0,0 +1,185 @@
+package vpc_security_group_evaluation
+
+import (
+	"net"
+	"testing"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+)
+
+func testRule(id string, direction vpc.SecurityGroupRule_Direction, protocol string, from, to int64) *vpc.SecurityGroupRule {
+	rule := &vpc.SecurityGroupRule{
+		Id:           id,
+		Direction:    direction,
+		ProtocolName: protocol,
+	}
+	if from >= 0 {
+		rule.Ports = &vpc.PortRange{FromPort: from, ToPort: to}
+	}
+	return rule
+}
+
+func withCidrs(rule *vpc.SecurityGroupRule, cidrs ...string) *vpc.SecurityGroupRule {
+	rule.Target = &vpc.SecurityGroupRule_CidrBlocks{CidrBlocks: &vpc.CidrBlocks{V4CidrBlocks: cidrs}}
+	return rule
+}
+
+func withSecurityGroup(rule *vpc.SecurityGroupRule, sgID string) *vpc.SecurityGroupRule {
+	rule.Target = &vpc.SecurityGroupRule_SecurityGroupId{SecurityGroupId: sgID}
+	return rule
+}
+
+func withPredefinedTarget(rule *vpc.SecurityGroupRule, target string) *vpc.SecurityGroupRule {
+	rule.Target = &vpc.SecurityGroupRule_PredefinedTarget{PredefinedTarget: target}
+	return rule
+}
+
+func testEndpoint(cidr string, sgs ...*vpc.SecurityGroup) *endpoint {
+	e := &endpoint{securityGroups: sgs}
+	if cidr != "" {
+		_, n, _ := net.ParseCIDR(cidr)
+		e.networks = append(e.networks, n)
+	}
+	return e
+}
+
+func ruleIDs(e evaluation) []string {
+	var ids []string
+	for _, m := range e.rules {
+		ids = append(ids, m.rule.GetId())
+	}
+	return ids
+}
+
+func TestEvaluateCidrRules(t *testing.T) {
+	app := &vpc.SecurityGroup{Id: "app", Rules: []*vpc.SecurityGroupRule{
+		withCidrs(testRule("egress-any", vpc.SecurityGroupRule_EGRESS, "ANY", -1, -1), "0.0.0.0/0"),
+	}}
+	db := &vpc.SecurityGroup{Id: "db", Rules: []*vpc.SecurityGroupRule{
+		withCidrs(testRule("pg", vpc.SecurityGroupRule_INGRESS, "TCP", 5432, 5432), "10.0.0.0/16"),
+		withCidrs(testRule("ssh", vpc.SecurityGroupRule_INGRESS, "TCP", 22, 22), "0.0.0.0/0"),
+	}}
+
+	for _, tc := range []struct {
+		name     string
+		source   string
+		protocol string
+		port     int64
+		allowed  bool
+		ingress  []string
+	}{
+		{"allowed address", "10.0.1.5/32", "TCP", 5432, true, []string{"pg"}},
+		{"address outside of the rule", "10.1.1.5/32", "TCP", 5432, false, nil},
+		{"subnet inside of the rule", "10.0.1.0/24", "tcp", 5432, true, []string{"pg"}},
+		{"subnet wider than the rule", "10.0.0.0/8", "TCP", 5432, false, nil},
+		{"other protocol", "10.0.1.5/32", "UDP", 5432, false, nil},
+		{"other port", "10.0.1.5/32", "TCP", 5433, false, nil},
+		{"no port", "10.0.1.5/32", "TCP", -1, false, nil},
+		{"unknown address", "", "TCP", 5432, false, nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			egress, ingress := evaluate(testEndpoint(tc.source, app), testEndpoint("10.0.2.10/32", db), tc.protocol, tc.port)
+			if allowed := egress.allowed() && ingress.allowed(); allowed != tc.allowed {
+				t.Errorf("expected allowed %v, got egress %v and ingress %v", tc.allowed, egress.allowed(), ingress.allowed())
+			}
+			if got := ruleIDs(ingress); len(got) != len(tc.ingress) || (len(got) > 0 && got[0] != tc.ingress[0]) {
+				t.Errorf("unexpected matching ingress rules %v", got)
+			}
+		})
+	}
+}
+
+func TestEvaluateDualStackEndpoint(t *testing.T) {
+	app := &vpc.SecurityGroup{Id: "app"}
+	db := &vpc.SecurityGroup{Id: "db", Rules: []*vpc.SecurityGroupRule{
+		withCidrs(testRule("pg-v4", vpc.SecurityGroupRule_INGRESS, "TCP", 5432, 5432), "10.0.0.0/16"),
+		{
+			Id:           "pg-v6",
+			Direction:    vpc.SecurityGroupRule_INGRESS,
+			ProtocolName: "TCP",
+			Ports:        &vpc.PortRange{FromPort: 6432, ToPort: 6432},
+			Target: &vpc.SecurityGroupRule_CidrBlocks{CidrBlocks: &vpc.CidrBlocks{
+				V6CidrBlocks: []string{"2001:db8::/32"},
+			}},
+		},
+		withCidrs(testRule("ssh", vpc.SecurityGroupRule_INGRESS, "TCP", 22, 22), "192.168.0.0/16"),
+	}}
+
+	dualStack := testEndpoint("10.0.1.5/32", app)
+	_, v6, _ := net.ParseCIDR("2001:db8::5/128")
+	dualStack.networks = append(dualStack.networks, v6)
+
+	for _, tc := range []struct {
+		name    string
+		port    int64
+		ingress []string
+	}{
+		{"IPv4 rule", 5432, []string{"pg-v4"}},
+		{"IPv6 rule", 6432, []string{"pg-v6"}},
+		{"rule of neither family", 22, nil},
+	} {
+		t.Run(tc.name, func(t *testing.T) {
+			_, ingress := evaluate(dualStack, testEndpoint("10.0.2.10/32", db), "TCP", tc.port)
+			if got := ruleIDs(ingress); len(got) != len(tc.ingress) || (len(got) > 0 && got[0] != tc.ingress[0]) {
+				t.Errorf("unexpected matching ingress rules %v", got)
+			}
+		})
+	}
+}
+
+func TestEvaluateSecurityGroupTargets(t *testing.T) {
+	app := &vpc.SecurityGroup{Id: "app"}
+	db := &vpc.SecurityGroup{Id: "db", Rules: []*vpc.SecurityGroupRule{
+		withSecurityGroup(testRule("from-app", vpc.SecurityGroupRule_INGRESS, "TCP", 5432, 5432), "app"),
+		withPredefinedTarget(testRule("self", vpc.SecurityGroupRule_INGRESS, "ANY", 0, 65535), predefinedTargetSelf),
+		withPredefinedTarget(testRule("health-checks", vpc.SecurityGroupRule_INGRESS, "TCP", 8080, 8080), predefinedTargetHealthChecks),
+	}}
+
+	// The source security group without egress rules denies the traffic
+	egress, ingress := evaluate(testEndpoint("", app), testEndpoint("", db), "TCP", 5432)
+	if egress.allowed() || !egress.filtered {
+		t.Errorf("expected egress to be denied")
+	}
+	if got := ruleIDs(ingress); len(got) != 1 || got[0] != "from-app" {
+		t.Errorf("unexpected matching ingress rules %v", got)
+	}
+
+	// self_security_group matches the traffic between the members of the security group
+	_, ingress = evaluate(testEndpoint("", db), testEndpoint("", db), "UDP", -1)
+	if got := ruleIDs(ingress); len(got) != 1 || got[0] != "self" {
+		t.Errorf("unexpected matching ingress rules %v", got)
+	}
+
+	// loadbalancer_healthchecks matches the health check nodes, the external source isn't filtered
+	egress, ingress = evaluate(testEndpoint("198.18.235.10/32"), testEndpoint("", db), "TCP", 8080)
+	if egress.filtered || !egress.allowed() {
+		t.Errorf("expected the external source not to be filtered")
+	}
+	if got := ruleIDs(ingress); len(got) != 1 || got[0] != "health-checks" {
+		t.Errorf("unexpected matching ingress rules %v", got)
+	}
+
+	_, ingress = evaluate(testEndpoint("203.0.113.10/32"), testEndpoint("", db), "TCP", 8080)
+	if ingress.allowed() {
+		t.Errorf("expected the traffic from the internet to be denied, matching rules %v", ruleIDs(ingress))
+	}
+}
+
+func TestPortMatches(t *testing.T) {
+	for _, tc := range []struct {
+		ports *vpc.PortRange
+		port  int64
+		match bool
+	}{
+		{nil, 80, true},
+		{nil, -1, true},
+		{&vpc.PortRange{FromPort: 0, ToPort: 65535}, -1, true},
+		{&vpc.PortRange{FromPort: 80, ToPort: 90}, 85, true},
+		{&vpc.PortRange{FromPort: 80, ToPort: 90}, 91, false},
+		{&vpc.PortRange{FromPort: 80, ToPort: 90}, -1, false},
+	} {
+		if got := portMatches(tc.ports, tc.port); got != tc.match {
+			t.Errorf("portMatches(%v, %d) = %v, expected %v", tc.ports, tc.port, got, tc.match)
+		}
+	}
+}
@@ -0,0 +1,66 @@
+package vpc_security_group_evaluation
+
+import (
+	"github.com/hashicorp/terraform-plugin-framework/attr"
+	"github.com/hashicorp/terraform-plugin-framework/types"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+)
+
+type evaluationModel struct {
+	ID             types.String   `tfsdk:"id"`
+	Source         *endpointModel `tfsdk:"source"`
+	Destination    *endpointModel `tfsdk:"destination"`
+	Protocol       types.String   `tfsdk:"protocol"`
+	Port           types.Int64    `tfsdk:"port"`
+	Allowed        types.Bool     `tfsdk:"allowed"`
+	EgressAllowed  types.Bool     `tfsdk:"egress_allowed"`
+	IngressAllowed types.Bool     `tfsdk:"ingress_allowed"`
+	MatchingRules  types.List     `tfsdk:"matching_rules"`
+}
+
+type endpointModel struct {
+	InstanceID      types.String `tfsdk:"instance_id"`
+	SecurityGroupID types.String `tfsdk:"security_group_id"`
+	SubnetID        types.String `tfsdk:"subnet_id"`
+	IPAddress       types.String `tfsdk:"ip_address"`
+}
+
+var matchingRuleType = types.ObjectType{
+	AttrTypes: map[string]attr.Type{
+		"direction":         types.StringType,
+		"security_group_id": types.StringType,
+		"rule_id":           types.StringType,
+		"description":       types.StringType,
+	},
+}
+
+// evaluationToState sets the results, the allowance of a direction is null if its traffic isn't filtered.
+func evaluationToState(egress, ingress evaluation, state *evaluationModel) {
+	state.Allowed = types.BoolValue(egress.allowed() && ingress.allowed())
+	state.EgressAllowed = types.BoolNull()
+	if egress.filtered {
+		state.EgressAllowed = types.BoolValue(egress.allowed())
+	}
+	state.IngressAllowed = types.BoolNull()
+	if ingress.filtered {
+		state.IngressAllowed = types.BoolValue(ingress.allowed())
+	}
+
+	var rules []attr.Value
+	for _, m := range append(egress.rules, ingress.rules...) {
+		rules = append(rules, types.ObjectValueMust(matchingRuleType.AttrTypes, map[string]attr.Value{
+			"direction":         types.StringValue(directionName(m.direction)),
+			"security_group_id": types.StringValue(m.securityGroupID),
+			"rule_id":           types.StringValue(m.rule.GetId()),
+			"description":       types.StringValue(m.rule.GetDescription()),
+		}))
+	}
+	state.MatchingRules = types.ListValueMust(matchingRuleType, rules)
+}
+
+func directionName(direction vpc.SecurityGroupRule_Direction) string {
+	if direction == vpc.SecurityGroupRule_EGRESS {
+		return "egress"
+	}
+	return "ingress"
+}
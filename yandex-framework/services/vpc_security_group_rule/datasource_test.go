@@ -39,6 +39,8 @@ resource "yandex_vpc_security_group" "sg" {
   network_id  = "${yandex_vpc_network.net.id}"
   name        = "some-name"
   description = "some description"
+
+  rules_management = "external"
 }
 
 resource "yandex_vpc_security_group_rule" "rule" {
@@ -59,24 +59,6 @@ func (r securityGroupRuleModel) BodyEqual(o securityGroupRuleModel) bool {
 		r.V6CidrBlocks.Equal(o.V6CidrBlocks)
 }
 
-func expandRulePorts(port, fromPort, toPort int64) (*vpc.PortRange, error) {
-	if port == -1 && fromPort == -1 && toPort == -1 {
-		return nil, nil
-	}
-
-	if port != -1 {
-		if fromPort != -1 || toPort != -1 {
-			return nil, fmt.Errorf("cannot set from_port/to_port with port")
-		}
-		fromPort = port
-		toPort = port
-	} else if fromPort == -1 || toPort == -1 {
-		return nil, fmt.Errorf("port or from_port + to_port must be defined")
-	}
-
-	return &vpc.PortRange{FromPort: fromPort, ToPort: toPort}, nil
-}
-
 func securityGroupRuleToState(ctx context.Context, rule *vpc.SecurityGroupRule, state *securityGroupRuleModel) diag.Diagnostics {
 	var diags diag.Diagnostics
 
@@ -126,7 +108,7 @@ func securityGroupRuleToState(ctx context.Context, rule *vpc.SecurityGroupRule,
 func stateToSecurityGroupRuleSpec(ctx context.Context, state *securityGroupRuleModel) (*vpc.SecurityGroupRuleSpec, diag.Diagnostics) {
 	var diags = diag.Diagnostics{}
 
-	portRange, err := expandRulePorts(state.Port.ValueInt64(), state.FromPort.ValueInt64(), state.ToPort.ValueInt64())
+	portRange, err := sg.ExpandRulePorts(state.Port.ValueInt64(), state.FromPort.ValueInt64(), state.ToPort.ValueInt64())
 	if err != nil {
 		diags.AddError(
 			"Failed to construct PortRange",
@@ -277,6 +277,8 @@ resource "yandex_vpc_security_group" "sg1" {
   description = "description for security group"
   network_id  = "${yandex_vpc_network.foo.id}"
   folder_id   = "%s"
+
+  rules_management = "external"
 }
 
 resource "yandex_vpc_security_group_rule" "sgr1" {
@@ -302,6 +304,8 @@ resource "yandex_vpc_security_group" "sg1" {
   description = "description for security group"
   network_id  = "${yandex_vpc_network.foo.id}"
   folder_id   = "%s"
+
+  rules_management = "external"
 }
 
 resource "yandex_vpc_security_group_rule" "sgr1" {
@@ -333,6 +337,8 @@ resource "yandex_vpc_security_group" "sg1" {
   description = "description for security group"
   network_id  = "${yandex_vpc_network.foo.id}"
   folder_id   = "%s"
+
+  rules_management = "external"
 }
 
 resource "yandex_vpc_security_group" "sg2" {
@@ -340,6 +346,8 @@ resource "yandex_vpc_security_group" "sg2" {
   description = "description for security group"
   network_id  = "${yandex_vpc_network.foo.id}"
   folder_id   = "%s"
+
+  rules_management = "external"
 }
 
 resource "yandex_vpc_security_group_rule" "sgr1" {
@@ -364,6 +372,8 @@ resource "yandex_vpc_security_group" "sg1" {
   description = "description for security group"
   network_id  = "${yandex_vpc_network.foo.id}"
   folder_id   = "%s"
+
+  rules_management = "external"
 }
 
 resource "yandex_vpc_security_group" "sg2" {
@@ -371,6 +381,8 @@ resource "yandex_vpc_security_group" "sg2" {
   description = "description for security group"
   network_id  = "${yandex_vpc_network.foo.id}"
   folder_id   = "%s"
+
+  rules_management = "external"
 }
 
 resource "yandex_vpc_security_group_rule" "sgr1" {
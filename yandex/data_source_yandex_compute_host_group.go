@@ -0,0 +1,192 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-sdk/sdkresolvers"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+)
+
+func dataSourceYandexComputeHostGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get information about a Yandex Compute host group and its dedicated hosts. For more information, see [the official documentation](https://yandex.cloud/docs/compute/concepts/dedicated-host).\n\n~> One of `host_group_id` or `name` should be specified.\n",
+
+		ReadContext: dataSourceYandexComputeHostGroupRead,
+		Schema: map[string]*schema.Schema{
+			"host_group_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the host group.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"folder_id": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["folder_id"],
+				Optional:    true,
+				Computed:    true,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["created_at"],
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["name"],
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["description"],
+				Computed:    true,
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Description: common.ResourceDescriptions["labels"],
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["zone"],
+				Computed:    true,
+			},
+			"type_id": {
+				Type:        schema.TypeString,
+				Description: resourceYandexComputeHostGroup().Schema["type_id"].Description,
+				Computed:    true,
+			},
+			"maintenance_policy": {
+				Type:        schema.TypeString,
+				Description: resourceYandexComputeHostGroup().Schema["maintenance_policy"].Description,
+				Computed:    true,
+			},
+			"fixed_scale_size": {
+				Type:        schema.TypeInt,
+				Description: "The number of hosts in the group.",
+				Computed:    true,
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: resourceYandexComputeHostGroup().Schema["status"].Description,
+				Computed:    true,
+			},
+			"hosts": {
+				Type:        schema.TypeList,
+				Description: "Dedicated hosts of the group.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"id": {
+							Type:        schema.TypeString,
+							Description: "ID of the host, it can be used in the `yc.hostId` host affinity rules.",
+							Computed:    true,
+						},
+						"server_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the physical server that the host belongs to.",
+							Computed:    true,
+						},
+						"status": {
+							Type:        schema.TypeString,
+							Description: "The status of the host.",
+							Computed:    true,
+						},
+						"replacement_host_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the host which replaces this host after the maintenance.",
+							Computed:    true,
+						},
+						"replacement_deadline_at": {
+							Type:        schema.TypeString,
+							Description: "The time when the host is replaced.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceYandexComputeHostGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	err := checkOneOf(d, "host_group_id", "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	hostGroupID := d.Get("host_group_id").(string)
+	_, hostGroupNameOk := d.GetOk("name")
+
+	if hostGroupNameOk {
+		if hostGroupID, err = resolveObjectID(ctx, config, d, sdkresolvers.HostGroupResolver); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	hostGroup, err := config.sdk.Compute().HostGroup().Get(ctx, &compute.GetHostGroupRequest{
+		HostGroupId: hostGroupID,
+	})
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("Host group with ID %q", hostGroupID)))
+	}
+
+	hosts, err := config.sdk.Compute().HostGroup().HostGroupHostsIterator(ctx, &compute.ListHostGroupHostsRequest{
+		HostGroupId: hostGroupID,
+	}).TakeAll()
+	if err != nil {
+		return diag.Errorf("Error while requesting API to list hosts of host group %q: %s", hostGroupID, err)
+	}
+
+	d.Set("host_group_id", hostGroup.Id)
+	d.Set("folder_id", hostGroup.FolderId)
+	d.Set("created_at", getTimestamp(hostGroup.CreatedAt))
+	d.Set("name", hostGroup.Name)
+	d.Set("description", hostGroup.Description)
+	d.Set("zone", hostGroup.ZoneId)
+	d.Set("type_id", hostGroup.TypeId)
+	d.Set("fixed_scale_size", int(hostGroup.GetScalePolicy().GetFixedScale().GetSize()))
+	d.Set("status", strings.ToLower(hostGroup.Status.String()))
+
+	if hostGroup.MaintenancePolicy == compute.MaintenancePolicy_MAINTENANCE_POLICY_UNSPECIFIED {
+		d.Set("maintenance_policy", "unspecified")
+	} else {
+		d.Set("maintenance_policy", strings.ToLower(hostGroup.MaintenancePolicy.String()))
+	}
+
+	if err := d.Set("labels", hostGroup.Labels); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("hosts", flattenHostGroupHosts(hosts)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(hostGroup.Id)
+
+	return nil
+}
+
+func flattenHostGroupHosts(hosts []*compute.Host) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(hosts))
+	for _, host := range hosts {
+		result = append(result, map[string]interface{}{
+			"id":                      host.Id,
+			"server_id":               host.ServerId,
+			"status":                  strings.ToLower(host.Status.String()),
+			"replacement_host_id":     host.GetReplacement().GetHostId(),
+			"replacement_deadline_at": getTimestamp(host.GetReplacement().GetDeadlineAt()),
+		})
+	}
+	return result
+}
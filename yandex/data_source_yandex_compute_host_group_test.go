@@ -0,0 +1,71 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceComputeHostGroup_byID(t *testing.T) {
+	t.Parallel()
+
+	hostGroupName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeHostGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceComputeHostGroupConfig(hostGroupName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceIDField("data.yandex_compute_host_group.source", "host_group_id"),
+					resource.TestCheckResourceAttr("data.yandex_compute_host_group.source", "name", hostGroupName),
+					resource.TestCheckResourceAttr("data.yandex_compute_host_group.source", "fixed_scale_size", "1"),
+					resource.TestCheckResourceAttr("data.yandex_compute_host_group.source", "hosts.#", "1"),
+					resource.TestCheckResourceAttrSet("data.yandex_compute_host_group.source", "hosts.0.id"),
+					resource.TestCheckResourceAttrSet("data.yandex_compute_host_group.source", "type_id"),
+					testAccCheckCreatedAtAttr("data.yandex_compute_host_group.source"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceComputeHostGroup_byName(t *testing.T) {
+	t.Parallel()
+
+	hostGroupName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeHostGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceComputeHostGroupConfig(hostGroupName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceIDField("data.yandex_compute_host_group.source", "host_group_id"),
+					resource.TestCheckResourceAttr("data.yandex_compute_host_group.source", "name", hostGroupName),
+					resource.TestCheckResourceAttr("data.yandex_compute_host_group.source", "zone", "ru-central1-a"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceComputeHostGroupConfig(name string, useID bool) string {
+	if useID {
+		return testAccComputeHostGroup_basic(name, "", 1) + `
+data "yandex_compute_host_group" "source" {
+  host_group_id = "${yandex_compute_host_group.foobar.id}"
+}
+`
+	}
+	return testAccComputeHostGroup_basic(name, "", 1) + `
+data "yandex_compute_host_group" "source" {
+  name = "${yandex_compute_host_group.foobar.name}"
+}
+`
+}
@@ -419,7 +419,30 @@ func dataSourceYandexComputeInstanceGroup() *schema.Resource {
 								Schema: map[string]*schema.Schema{
 									"placement_group_id": {
 										Type:     schema.TypeString,
-										Required: true,
+										Computed: true,
+									},
+									"host_affinity_rules": {
+										Type:     schema.TypeList,
+										Computed: true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"op": {
+													Type:     schema.TypeString,
+													Computed: true,
+												},
+												"values": {
+													Type:     schema.TypeList,
+													Computed: true,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+											},
+										},
 									},
 								},
 							},
@@ -1013,6 +1036,42 @@ func dataSourceYandexComputeInstanceGroup() *schema.Resource {
 				Description: common.ResourceDescriptions["deletion_protection"],
 				Computed:    true,
 			},
+
+			"rollout_status": {
+				Type:        schema.TypeList,
+				Description: resourceYandexComputeInstanceGroup().Schema["rollout_status"].Description,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_size": {
+							Type:        schema.TypeInt,
+							Description: "Target number of instances in the group.",
+							Computed:    true,
+						},
+						"running_actual_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of running instances that match the current instance template.",
+							Computed:    true,
+						},
+						"running_outdated_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of running instances that don't match the current instance template.",
+							Computed:    true,
+						},
+						"processing_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of instances that are being created, updated, checked or deleted.",
+							Computed:    true,
+						},
+						"status_counts": {
+							Type:        schema.TypeMap,
+							Description: "Number of managed instances by their status.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -0,0 +1,59 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+func dataSourceYandexComputeInstanceSerialOutput() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the serial port output of a Yandex Compute instance, e.g. for debugging failed boots. For more information, see [the official documentation](https://yandex.cloud/docs/compute/operations/vm-info/get-serial-port-output).\n",
+
+		ReadContext: dataSourceYandexComputeInstanceSerialOutputRead,
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the instance.",
+				Required:    true,
+			},
+			"port": {
+				Type:         schema.TypeInt,
+				Description:  "Serial port to retrieve the output from. The default is `1`.",
+				Optional:     true,
+				Default:      1,
+				ValidateFunc: validation.IntBetween(1, 4),
+			},
+			"contents": {
+				Type:        schema.TypeString,
+				Description: "The contents of the serial port output.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceYandexComputeInstanceSerialOutputRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	instanceID := d.Get("instance_id").(string)
+	port := d.Get("port").(int)
+
+	resp, err := config.sdk.Compute().Instance().GetSerialPortOutput(ctx, &compute.GetInstanceSerialPortOutputRequest{
+		InstanceId: instanceID,
+		Port:       int64(port),
+	})
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("Instance with ID %q", instanceID)))
+	}
+
+	d.Set("contents", resp.GetContents())
+	d.SetId(fmt.Sprintf("%s/%d", instanceID, port))
+
+	return nil
+}
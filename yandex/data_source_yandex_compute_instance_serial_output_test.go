@@ -0,0 +1,36 @@
+package yandex
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceComputeInstanceSerialOutput_basic(t *testing.T) {
+	t.Parallel()
+
+	instanceName := fmt.Sprintf("instance-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstance_waitFor(instanceName) + `
+data "yandex_compute_instance_serial_output" "source" {
+  instance_id = "${yandex_compute_instance.foobar.id}"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_compute_instance_serial_output.source", "port", "1"),
+					resource.TestMatchResourceAttr("data.yandex_compute_instance_serial_output.source",
+						"contents", regexp.MustCompile("Cloud-init .* finished")),
+				),
+			},
+		},
+	})
+}
@@ -0,0 +1,166 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/go-sdk/sdkresolvers"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+)
+
+func dataSourceYandexComputeReservedInstancePool() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get information about a Yandex Compute reserved instance pool. For more information, see [the official documentation](https://yandex.cloud/docs/compute/concepts/reserved-pools).\n\n~> One of `reserved_instance_pool_id` or `name` should be specified.\n",
+
+		ReadContext: dataSourceYandexComputeReservedInstancePoolRead,
+		Schema: map[string]*schema.Schema{
+			"reserved_instance_pool_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the reserved instance pool.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"folder_id": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["folder_id"],
+				Optional:    true,
+				Computed:    true,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["created_at"],
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["name"],
+				Optional:    true,
+				Computed:    true,
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["description"],
+				Computed:    true,
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Description: common.ResourceDescriptions["labels"],
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["zone"],
+				Computed:    true,
+			},
+			"platform_id": {
+				Type:        schema.TypeString,
+				Description: resourceYandexComputeReservedInstancePool().Schema["platform_id"].Description,
+				Computed:    true,
+			},
+			"resources_spec": {
+				Type:        schema.TypeList,
+				Description: resourceYandexComputeReservedInstancePool().Schema["resources_spec"].Description,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"memory": {
+							Type:        schema.TypeFloat,
+							Description: "Memory size in GB.",
+							Computed:    true,
+						},
+						"cores": {
+							Type:        schema.TypeInt,
+							Description: "CPU cores for the instance.",
+							Computed:    true,
+						},
+						"core_fraction": {
+							Type:        schema.TypeInt,
+							Description: "Baseline performance for a core as a percent.",
+							Computed:    true,
+						},
+						"gpus": {
+							Type:        schema.TypeInt,
+							Description: "The number of GPU devices for the instance.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"gpu_settings": {
+				Type:        schema.TypeList,
+				Description: resourceYandexComputeReservedInstancePool().Schema["gpu_settings"].Description,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gpu_cluster_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the GPU cluster to attach the instances to.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+			"size": {
+				Type:        schema.TypeInt,
+				Description: resourceYandexComputeReservedInstancePool().Schema["size"].Description,
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceYandexComputeReservedInstancePoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	err := checkOneOf(d, "reserved_instance_pool_id", "name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	poolID := d.Get("reserved_instance_pool_id").(string)
+	_, poolNameOk := d.GetOk("name")
+
+	if poolNameOk {
+		if poolID, err = resolveObjectID(ctx, config, d, sdkresolvers.ReservedInstancePoolResolver); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	pool, err := config.sdk.Compute().ReservedInstancePool().Get(ctx, &compute.GetReservedInstancePoolRequest{
+		ReservedInstancePoolId: poolID,
+	})
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("Reserved instance pool with ID %q", poolID)))
+	}
+
+	d.Set("reserved_instance_pool_id", pool.Id)
+	d.Set("folder_id", pool.FolderId)
+	d.Set("created_at", getTimestamp(pool.CreatedAt))
+	d.Set("name", pool.Name)
+	d.Set("description", pool.Description)
+	d.Set("zone", pool.ZoneId)
+	d.Set("platform_id", pool.PlatformId)
+	d.Set("size", int(pool.Size))
+
+	if err := d.Set("labels", pool.Labels); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("resources_spec", flattenReservedInstancePoolResourcesSpec(pool.ResourcesSpec)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("gpu_settings", flattenReservedInstancePoolGpuSettings(pool.GpuSettings)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(pool.Id)
+
+	return nil
+}
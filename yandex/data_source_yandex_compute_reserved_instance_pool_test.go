@@ -0,0 +1,69 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceComputeReservedInstancePool_byID(t *testing.T) {
+	t.Parallel()
+
+	poolName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeReservedInstancePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceComputeReservedInstancePoolConfig(poolName, true),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceIDField("data.yandex_compute_reserved_instance_pool.source", "reserved_instance_pool_id"),
+					resource.TestCheckResourceAttr("data.yandex_compute_reserved_instance_pool.source", "name", poolName),
+					resource.TestCheckResourceAttr("data.yandex_compute_reserved_instance_pool.source", "size", "1"),
+					resource.TestCheckResourceAttr("data.yandex_compute_reserved_instance_pool.source", "resources_spec.0.cores", "2"),
+					testAccCheckCreatedAtAttr("data.yandex_compute_reserved_instance_pool.source"),
+				),
+			},
+		},
+	})
+}
+
+func TestAccDataSourceComputeReservedInstancePool_byName(t *testing.T) {
+	t.Parallel()
+
+	poolName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeReservedInstancePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceComputeReservedInstancePoolConfig(poolName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckResourceIDField("data.yandex_compute_reserved_instance_pool.source", "reserved_instance_pool_id"),
+					resource.TestCheckResourceAttr("data.yandex_compute_reserved_instance_pool.source", "name", poolName),
+					resource.TestCheckResourceAttr("data.yandex_compute_reserved_instance_pool.source", "zone", "ru-central1-a"),
+				),
+			},
+		},
+	})
+}
+
+func testAccDataSourceComputeReservedInstancePoolConfig(name string, useID bool) string {
+	if useID {
+		return testAccComputeReservedInstancePool_basic(name, "", 1) + `
+data "yandex_compute_reserved_instance_pool" "source" {
+  reserved_instance_pool_id = "${yandex_compute_reserved_instance_pool.foobar.id}"
+}
+`
+	}
+	return testAccComputeReservedInstancePool_basic(name, "", 1) + `
+data "yandex_compute_reserved_instance_pool" "source" {
+  name = "${yandex_compute_reserved_instance_pool.foobar.name}"
+}
+`
+}
@@ -0,0 +1,173 @@
+package yandex
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+)
+
+func dataSourceYandexComputeSnapshots() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get a list of ready Yandex Compute snapshots, newest first, e.g. to pick the latest snapshot created by a snapshot schedule for a disk. For more information, see [the official documentation](https://yandex.cloud/docs/compute/concepts/snapshot).\n\n" +
+			"~> If `snapshot_schedule_id` is set, the snapshots created by the schedule are listed, otherwise the snapshots of the folder.\n",
+		Read: dataSourceYandexComputeSnapshotsRead,
+		Schema: map[string]*schema.Schema{
+			"folder_id": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["folder_id"],
+				Computed:    true,
+				Optional:    true,
+			},
+			"snapshot_schedule_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the snapshot schedule to list the created snapshots for.",
+				Optional:    true,
+			},
+			"source_disk_id": {
+				Type:        schema.TypeString,
+				Description: "List only the snapshots of this disk.",
+				Optional:    true,
+			},
+			"latest_snapshot_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the most recently created snapshot, empty if nothing is found.",
+				Computed:    true,
+			},
+			"snapshots": {
+				Type:        schema.TypeList,
+				Description: "Found snapshots, newest first.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"snapshot_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the snapshot.",
+							Computed:    true,
+						},
+						"name": {
+							Type:        schema.TypeString,
+							Description: common.ResourceDescriptions["name"],
+							Computed:    true,
+						},
+						"source_disk_id": {
+							Type:        schema.TypeString,
+							Description: resourceYandexComputeSnapshot().Schema["source_disk_id"].Description,
+							Computed:    true,
+						},
+						"disk_size": {
+							Type:        schema.TypeInt,
+							Description: resourceYandexComputeSnapshot().Schema["disk_size"].Description,
+							Computed:    true,
+						},
+						"storage_size": {
+							Type:        schema.TypeInt,
+							Description: resourceYandexComputeSnapshot().Schema["storage_size"].Description,
+							Computed:    true,
+						},
+						"labels": {
+							Type:        schema.TypeMap,
+							Description: common.ResourceDescriptions["labels"],
+							Elem:        &schema.Schema{Type: schema.TypeString},
+							Computed:    true,
+						},
+						"created_at": {
+							Type:        schema.TypeString,
+							Description: common.ResourceDescriptions["created_at"],
+							Computed:    true,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceYandexComputeSnapshotsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	ctx := config.Context()
+
+	var snapshots []*compute.Snapshot
+	var err error
+
+	scheduleID := d.Get("snapshot_schedule_id").(string)
+	folderID := ""
+	if scheduleID != "" {
+		snapshots, err = config.sdk.Compute().SnapshotSchedule().SnapshotScheduleSnapshotsIterator(ctx, &compute.ListSnapshotScheduleSnapshotsRequest{
+			SnapshotScheduleId: scheduleID,
+		}).TakeAll()
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to list snapshots of Snapshot schedule %q: %s", scheduleID, err)
+		}
+	} else {
+		folderID, err = getFolderID(d, config)
+		if err != nil {
+			return fmt.Errorf("Error getting folder ID while listing snapshots: %s", err)
+		}
+
+		snapshots, err = config.sdk.Compute().Snapshot().SnapshotIterator(ctx, &compute.ListSnapshotsRequest{
+			FolderId: folderID,
+		}).TakeAll()
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to list snapshots in folder %q: %s", folderID, err)
+		}
+	}
+
+	sourceDiskID := d.Get("source_disk_id").(string)
+	snapshots = filterReadyComputeSnapshots(snapshots, sourceDiskID)
+
+	latestSnapshotID := ""
+	if len(snapshots) > 0 {
+		latestSnapshotID = snapshots[0].GetId()
+	}
+
+	d.Set("folder_id", folderID)
+	d.Set("latest_snapshot_id", latestSnapshotID)
+	if err := d.Set("snapshots", flattenComputeSnapshotsList(snapshots)); err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(strings.Join([]string{folderID, scheduleID, sourceDiskID}, ":"))))
+
+	return nil
+}
+
+// filterReadyComputeSnapshots returns the ready snapshots of the disk (any disk if sourceDiskID is empty), newest first.
+func filterReadyComputeSnapshots(snapshots []*compute.Snapshot, sourceDiskID string) []*compute.Snapshot {
+	var result []*compute.Snapshot
+	for _, snapshot := range snapshots {
+		if snapshot.GetStatus() != compute.Snapshot_READY {
+			continue
+		}
+		if sourceDiskID != "" && snapshot.GetSourceDiskId() != sourceDiskID {
+			continue
+		}
+		result = append(result, snapshot)
+	}
+
+	sort.SliceStable(result, func(i, j int) bool {
+		return result[i].GetCreatedAt().AsTime().After(result[j].GetCreatedAt().AsTime())
+	})
+	return result
+}
+
+func flattenComputeSnapshotsList(snapshots []*compute.Snapshot) []map[string]interface{} {
+	result := make([]map[string]interface{}, 0, len(snapshots))
+	for _, snapshot := range snapshots {
+		result = append(result, map[string]interface{}{
+			"snapshot_id":    snapshot.GetId(),
+			"name":           snapshot.GetName(),
+			"source_disk_id": snapshot.GetSourceDiskId(),
+			"disk_size":      toGigabytes(snapshot.GetDiskSize()),
+			"storage_size":   toGigabytes(snapshot.GetStorageSize()),
+			"labels":         snapshot.GetLabels(),
+			"created_at":     getTimestamp(snapshot.GetCreatedAt()),
+		})
+	}
+	return result
+}
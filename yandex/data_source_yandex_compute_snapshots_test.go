@@ -0,0 +1,93 @@
+package yandex
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"google.golang.org/protobuf/types/known/timestamppb"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+func TestFilterReadyComputeSnapshots(t *testing.T) {
+	now := time.Now()
+	snapshot := func(id, diskID string, status compute.Snapshot_Status, age time.Duration) *compute.Snapshot {
+		return &compute.Snapshot{
+			Id:           id,
+			SourceDiskId: diskID,
+			Status:       status,
+			CreatedAt:    timestamppb.New(now.Add(-age)),
+		}
+	}
+
+	snapshots := []*compute.Snapshot{
+		snapshot("old", "disk1", compute.Snapshot_READY, 48*time.Hour),
+		snapshot("creating", "disk1", compute.Snapshot_CREATING, 0),
+		snapshot("latest", "disk1", compute.Snapshot_READY, time.Hour),
+		snapshot("other", "disk2", compute.Snapshot_READY, 30*time.Minute),
+	}
+
+	cases := []struct {
+		name         string
+		sourceDiskID string
+		expected     []string
+	}{
+		{
+			name:         "disk",
+			sourceDiskID: "disk1",
+			expected:     []string{"latest", "old"},
+		},
+		{
+			name:     "any disk",
+			expected: []string{"other", "latest", "old"},
+		},
+		{
+			name:         "unknown disk",
+			sourceDiskID: "disk3",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			var ids []string
+			for _, s := range filterReadyComputeSnapshots(snapshots, tc.sourceDiskID) {
+				ids = append(ids, s.GetId())
+			}
+			if !reflect.DeepEqual(ids, tc.expected) {
+				t.Errorf("Got %v, expected %v", ids, tc.expected)
+			}
+		})
+	}
+}
+
+func TestAccDataSourceComputeSnapshots_bySourceDisk(t *testing.T) {
+	t.Parallel()
+
+	snapshotName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	diskName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeSnapshot_basic(snapshotName, diskName, "my-value-for-tag") + `
+data "yandex_compute_snapshots" "source" {
+  source_disk_id = "${yandex_compute_snapshot.foobar.source_disk_id}"
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_compute_snapshots.source", "snapshots.#", "1"),
+					resource.TestCheckResourceAttrPair("data.yandex_compute_snapshots.source", "latest_snapshot_id",
+						"yandex_compute_snapshot.foobar", "id"),
+					resource.TestCheckResourceAttr("data.yandex_compute_snapshots.source", "snapshots.0.name", snapshotName),
+				),
+			},
+		},
+	})
+}
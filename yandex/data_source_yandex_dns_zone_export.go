@@ -0,0 +1,61 @@
+package yandex
+
+import (
+	"fmt"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+)
+
+func dataSourceYandexDnsZoneExport() *schema.Resource {
+	return &schema.Resource{
+		Description: "Renders all DNS RecordSets of a DNS Zone to the zone file text in the RFC 1035 format (BIND zone file), e.g. for backups.\n",
+		Read:        dataSourceYandexDnsZoneExportRead,
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"dns_zone_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the DNS Zone.",
+				Required:    true,
+			},
+
+			"zone": {
+				Type:        schema.TypeString,
+				Description: resourceYandexDnsZone().Schema["zone"].Description,
+				Computed:    true,
+			},
+
+			"content": {
+				Type:        schema.TypeString,
+				Description: "Text of the zone file.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func dataSourceYandexDnsZoneExportRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sdk := getSDK(config)
+
+	zoneID := d.Get("dns_zone_id").(string)
+	zone, err := sdk.DNS().DnsZone().Get(config.Context(), &dns.GetDnsZoneRequest{
+		DnsZoneId: zoneID,
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DnsZone %q", zoneID))
+	}
+
+	recordSets, err := listDnsZoneRecordSets(config.Context(), config, zoneID)
+	if err != nil {
+		return err
+	}
+
+	d.Set("zone", zone.GetZone())
+	d.Set("content", renderDNSZoneFile(zone.GetZone(), recordSets))
+	d.SetId(zoneID)
+
+	return nil
+}
@@ -0,0 +1,46 @@
+package yandex
+
+import (
+	"fmt"
+	"regexp"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceDnsZoneExport_basic(t *testing.T) {
+	t.Parallel()
+
+	zoneName := acctest.RandomWithPrefix("tf-dns-zone")
+	fqdn := acctest.RandomWithPrefix("tf-test") + ".dnstest.test."
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDnsZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSRecordSetBasic(zoneName, fqdn) + `
+data "yandex_dns_zone_export" "export" {
+  dns_zone_id = yandex_dns_recordset.rs1.zone_id
+}
+
+data "yandex_dns_zone_file" "parsed" {
+  content = data.yandex_dns_zone_export.export.content
+}
+`,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_dns_zone_export.export", "zone", fqdn),
+					resource.TestMatchResourceAttr("data.yandex_dns_zone_export.export", "content",
+						regexp.MustCompile(fmt.Sprintf(`(?m)^\$ORIGIN %s$`, regexp.QuoteMeta(fqdn)))),
+					resource.TestMatchResourceAttr("data.yandex_dns_zone_export.export", "content",
+						regexp.MustCompile(`(?m)^srv\t200\tIN\tA\t192\.168\.0\.1$`)),
+					resource.TestCheckResourceAttr("data.yandex_dns_zone_file.parsed", "records.#", "1"),
+					resource.TestCheckResourceAttr("data.yandex_dns_zone_file.parsed", "records.0.name", "srv."+fqdn),
+					resource.TestCheckResourceAttr("data.yandex_dns_zone_file.parsed", "records.0.data.#", "2"),
+				),
+			},
+		},
+	})
+}
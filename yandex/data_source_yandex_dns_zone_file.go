@@ -0,0 +1,105 @@
+package yandex
+
+import (
+	"fmt"
+	"strconv"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+)
+
+func dataSourceYandexDnsZoneFile() *schema.Resource {
+	return &schema.Resource{
+		Description: "Parses a zone file in the RFC 1035 format (BIND zone file) into DNS RecordSets, which can be used with `yandex_dns_recordset` or `yandex_dns_zone_records`. The `$ORIGIN` and `$TTL` directives, relative names and multi-string TXT records are supported, `$INCLUDE` is not.\n\n" +
+			"~> SOA records and NS records of the zone apex are skipped, as they are managed by the DNS service.\n",
+		Read: dataSourceYandexDnsZoneFileRead,
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"content": {
+				Type:        schema.TypeString,
+				Description: "Text of the zone file.",
+				Required:    true,
+			},
+
+			"origin": {
+				Type:        schema.TypeString,
+				Description: "The origin for relative names until the first `$ORIGIN` directive, e.g. `example.com.`.",
+				Optional:    true,
+			},
+
+			"default_ttl": {
+				Type:         schema.TypeInt,
+				Description:  "The TTL of records without TTL until the first `$TTL` directive. If it is not set, the TTL of the previous record is used.",
+				Optional:     true,
+				ValidateFunc: validation.IntBetween(0, 2147483647),
+			},
+
+			"records": {
+				Type:        schema.TypeList,
+				Description: "The DNS RecordSets of the zone file with fully qualified names.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "The fully qualified DNS name of the record set.",
+							Computed:    true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Description: "The DNS record set type.",
+							Computed:    true,
+						},
+						"ttl": {
+							Type:        schema.TypeInt,
+							Description: "The time-to-live of the record set (seconds).",
+							Computed:    true,
+						},
+						"data": {
+							Type:        schema.TypeList,
+							Description: "The string data for the records in the record set.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceYandexDnsZoneFileRead(d *schema.ResourceData, meta interface{}) error {
+	defaultTTL := int64(-1)
+	if v, ok := d.GetOkExists("default_ttl"); ok {
+		defaultTTL = int64(v.(int))
+	}
+
+	recordSets, err := parseDNSZoneFile(d.Get("content").(string), d.Get("origin").(string), defaultTTL)
+	if err != nil {
+		return fmt.Errorf("Error parsing zone file: %s", err)
+	}
+
+	if err := d.Set("records", flattenDnsZoneFileRecordSets(recordSets)); err != nil {
+		return err
+	}
+
+	d.SetId(strconv.Itoa(schema.HashString(d.Get("content").(string) + d.Get("origin").(string))))
+
+	return nil
+}
+
+func flattenDnsZoneFileRecordSets(recordSets []*dns.RecordSet) []map[string]interface{} {
+	records := make([]map[string]interface{}, 0, len(recordSets))
+	for _, rs := range recordSets {
+		records = append(records, map[string]interface{}{
+			"name": rs.GetName(),
+			"type": rs.GetType(),
+			"ttl":  int(rs.GetTtl()),
+			"data": rs.GetData(),
+		})
+	}
+	return records
+}
@@ -66,6 +66,40 @@ func dataSourceYandexVPCPrivateEndpoint() *schema.Resource {
 					Schema: map[string]*schema.Schema{},
 				},
 			},
+			"service": {
+				Type:        schema.TypeList,
+				Description: resourceYandexVPCPrivateEndpoint().Schema["service"].Description,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
+			"dns_records": {
+				Type:        schema.TypeList,
+				Description: resourceYandexVPCPrivateEndpoint().Schema["dns_records"].Description,
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"type": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+						"value": {
+							Type:     schema.TypeString,
+							Computed: true,
+						},
+					},
+				},
+			},
 			"endpoint_address": {
 				Type:     schema.TypeList,
 				Computed: true,
@@ -127,5 +161,12 @@ func dataSourceYandexVPCPrivateEndpointRead(d *schema.ResourceData, meta interfa
 		return err
 	}
 
-	return yandexVPCPrivateEndpointRead(d, meta, peID)
+	if err := yandexVPCPrivateEndpointRead(d, meta, peID); err != nil {
+		return err
+	}
+
+	if d.Get("service.0.type").(string) == vpcPrivateEndpointServiceObjectStorage {
+		return d.Set("object_storage", flattenPrivateEndpointObjectStorage(nil))
+	}
+	return nil
 }
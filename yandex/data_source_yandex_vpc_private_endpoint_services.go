@@ -0,0 +1,75 @@
+package yandex
+
+import (
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+)
+
+func dataSourceYandexVPCPrivateEndpointServices() *schema.Resource {
+	return &schema.Resource{
+		Description: "Get the list of services that [VPC Private Endpoints](https://yandex.cloud/docs/vpc/concepts/private-endpoint) can be created for in a region. The list reflects the services supported by the provider.\n",
+
+		Read: dataSourceYandexVPCPrivateEndpointServicesRead,
+		Schema: map[string]*schema.Schema{
+			"region_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the region to list the services for. If it is not provided, the provider region is used.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"services": {
+				Type:        schema.TypeList,
+				Description: "List of the services available in the region.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:        schema.TypeString,
+							Description: "Type of the service to use in the `service` block of the `yandex_vpc_private_endpoint`.",
+							Computed:    true,
+						},
+						"description": {
+							Type:        schema.TypeString,
+							Description: "Description of the service.",
+							Computed:    true,
+						},
+						"dns_names": {
+							Type:        schema.TypeList,
+							Description: "Names of the private DNS records created for an endpoint of the service.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeString},
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func dataSourceYandexVPCPrivateEndpointServicesRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	region := config.Region
+	if v, ok := d.GetOk("region_id"); ok {
+		region = v.(string)
+	}
+
+	services := make([]map[string]interface{}, 0, len(vpcPrivateEndpointServices))
+	for _, service := range vpcPrivateEndpointServices {
+		dnsNames, ok := service.DNSNames[region]
+		if !ok {
+			continue
+		}
+		services = append(services, map[string]interface{}{
+			"type":        service.Type,
+			"description": service.Description,
+			"dns_names":   dnsNames,
+		})
+	}
+
+	d.SetId(region)
+
+	if err := d.Set("region_id", region); err != nil {
+		return err
+	}
+	return d.Set("services", services)
+}
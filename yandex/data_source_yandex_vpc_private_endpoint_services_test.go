@@ -0,0 +1,33 @@
+package yandex
+
+import (
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+)
+
+func TestAccDataSourceVPCPrivateEndpointServices(t *testing.T) {
+	t.Parallel()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:  func() { testAccPreCheck(t) },
+		Providers: testAccProviders,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDataSourceVPCPrivateEndpointServicesConfig,
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("data.yandex_vpc_private_endpoint_services.ru", "region_id", "ru-central1"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_private_endpoint_services.ru", "services.#", "1"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_private_endpoint_services.ru", "services.0.type", "object_storage"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_private_endpoint_services.ru", "services.0.dns_names.0", "storage.yandexcloud.net"),
+				),
+			},
+		},
+	})
+}
+
+const testAccDataSourceVPCPrivateEndpointServicesConfig = `
+data "yandex_vpc_private_endpoint_services" "ru" {
+  region_id = "ru-central1"
+}
+`
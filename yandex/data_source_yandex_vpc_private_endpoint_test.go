@@ -31,6 +31,8 @@ func TestAccDataSourceVPCPrivateEndpointByID(t *testing.T) {
 					testAccCheckCreatedAtAttr("data.yandex_vpc_private_endpoint.pe"),
 					resource.TestCheckResourceAttr("data.yandex_vpc_private_endpoint.pe", "dns_options.#", "1"),
 					resource.TestCheckResourceAttr("data.yandex_vpc_private_endpoint.pe", "dns_options.0.private_dns_records_enabled", "false"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_private_endpoint.pe", "service.0.type", "object_storage"),
+					resource.TestCheckResourceAttr("data.yandex_vpc_private_endpoint.pe", "object_storage.#", "1"),
 					resource.TestCheckResourceAttr("data.yandex_vpc_private_endpoint.pe", "endpoint_address.#", "1"),
 					resource.TestCheckResourceAttrSet("data.yandex_vpc_private_endpoint.pe", "endpoint_address.0.subnet_id"),
 					resource.TestCheckResourceAttrSet("data.yandex_vpc_private_endpoint.pe", "endpoint_address.0.address"),
@@ -0,0 +1,396 @@
+package yandex
+
+import (
+	"fmt"
+	"sort"
+	"strconv"
+	"strings"
+	"unicode"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+)
+
+// Parsing and rendering of RFC 1035 zone files ("master files") used by
+// the yandex_dns_zone_file and yandex_dns_zone_export data sources.
+
+const dnsZoneFileMaxTXTStringLength = 255
+
+type dnsZoneFileLine struct {
+	number       int
+	tokens       []string
+	ownerOmitted bool
+}
+
+// parseDNSZoneFile parses the zone file into record sets with fully qualified names. The records of the
+// same name and type are merged into one record set with the TTL of the first record. SOA records and
+// NS records of the zone apex are skipped, as they are managed by the DNS service.
+func parseDNSZoneFile(content, origin string, defaultTTL int64) ([]*dns.RecordSet, error) {
+	lines, err := tokenizeDNSZoneFile(content)
+	if err != nil {
+		return nil, err
+	}
+
+	if origin != "" {
+		origin = dnsZoneFileCanonicalOrigin(origin)
+	}
+	apex := origin
+
+	var (
+		result   []*dns.RecordSet
+		index    = make(map[string]*dns.RecordSet)
+		owner    string
+		lastTTL  int64 = -1
+		ttlValue       = defaultTTL
+	)
+
+	for _, line := range lines {
+		tokens := line.tokens
+
+		switch strings.ToUpper(tokens[0]) {
+		case "$ORIGIN":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: $ORIGIN expects one argument", line.number)
+			}
+			origin, err = dnsZoneFileAbsoluteName(tokens[1], origin)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s", line.number, err)
+			}
+			if apex == "" {
+				apex = origin
+			}
+			continue
+		case "$TTL":
+			if len(tokens) != 2 {
+				return nil, fmt.Errorf("line %d: $TTL expects one argument", line.number)
+			}
+			ttlValue, err = parseDNSZoneFileTTL(tokens[1])
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s", line.number, err)
+			}
+			lastTTL = -1
+			continue
+		}
+		if strings.HasPrefix(tokens[0], "$") {
+			return nil, fmt.Errorf("line %d: unsupported directive %s", line.number, tokens[0])
+		}
+
+		if !line.ownerOmitted {
+			owner, err = dnsZoneFileAbsoluteName(tokens[0], origin)
+			if err != nil {
+				return nil, fmt.Errorf("line %d: %s", line.number, err)
+			}
+			tokens = tokens[1:]
+		} else if owner == "" {
+			return nil, fmt.Errorf("line %d: record without owner name", line.number)
+		}
+
+		// TTL and class may precede the type in any order
+		ttl := int64(-1)
+		for i := 0; i < 2 && len(tokens) > 0; i++ {
+			if isDNSZoneFileClass(tokens[0]) {
+				tokens = tokens[1:]
+			} else if v, err := parseDNSZoneFileTTL(tokens[0]); err == nil && ttl < 0 {
+				ttl = v
+				tokens = tokens[1:]
+			} else {
+				break
+			}
+		}
+		if len(tokens) < 2 {
+			return nil, fmt.Errorf("line %d: record type or data is missing", line.number)
+		}
+
+		switch {
+		case ttl >= 0:
+		case ttlValue >= 0:
+			ttl = ttlValue
+		case lastTTL >= 0:
+			ttl = lastTTL
+		default:
+			return nil, fmt.Errorf("line %d: record TTL is not set and there is no $TTL directive", line.number)
+		}
+		lastTTL = ttl
+
+		recordType := strings.ToUpper(tokens[0])
+		data, err := dnsZoneFileRecordData(recordType, tokens[1:], origin)
+		if err != nil {
+			return nil, fmt.Errorf("line %d: %s", line.number, err)
+		}
+
+		if recordType == "SOA" || (recordType == "NS" && strings.EqualFold(owner, apex)) {
+			continue
+		}
+
+		key := dnsRecordSetKey(owner, recordType)
+		if rs, ok := index[key]; ok {
+			rs.Data = append(rs.Data, data)
+			continue
+		}
+		rs := &dns.RecordSet{
+			Name: owner,
+			Type: recordType,
+			Ttl:  ttl,
+			Data: []string{data},
+		}
+		index[key] = rs
+		result = append(result, rs)
+	}
+
+	return result, nil
+}
+
+// tokenizeDNSZoneFile splits the zone file into logical lines, handling comments, quoted strings and
+// parentheses that continue a record on the following lines.
+func tokenizeDNSZoneFile(content string) ([]*dnsZoneFileLine, error) {
+	var (
+		lines   []*dnsZoneFileLine
+		current *dnsZoneFileLine
+		depth   int
+	)
+
+	for i, raw := range strings.Split(content, "\n") {
+		number := i + 1
+		raw = strings.TrimRight(raw, "\r")
+
+		if depth == 0 {
+			current = &dnsZoneFileLine{
+				number:       number,
+				ownerOmitted: len(raw) > 0 && (raw[0] == ' ' || raw[0] == '\t'),
+			}
+		}
+
+		var token strings.Builder
+		inToken, inQuotes, escaped := false, false, false
+		flush := func() {
+			if inToken {
+				current.tokens = append(current.tokens, token.String())
+				token.Reset()
+				inToken = false
+			}
+		}
+
+	scan:
+		for _, r := range raw {
+			switch {
+			case inQuotes:
+				token.WriteRune(r)
+				if escaped {
+					escaped = false
+				} else if r == '\\' {
+					escaped = true
+				} else if r == '"' {
+					inQuotes = false
+				}
+			case r == '"':
+				inToken, inQuotes = true, true
+				token.WriteRune(r)
+			case r == ';':
+				break scan
+			case r == '(':
+				flush()
+				depth++
+			case r == ')':
+				flush()
+				if depth == 0 {
+					return nil, fmt.Errorf("line %d: unbalanced parentheses", number)
+				}
+				depth--
+			case unicode.IsSpace(r):
+				flush()
+			default:
+				inToken = true
+				token.WriteRune(r)
+			}
+		}
+		if inQuotes {
+			return nil, fmt.Errorf("line %d: unterminated quoted string", number)
+		}
+		flush()
+
+		if depth == 0 && len(current.tokens) > 0 {
+			lines = append(lines, current)
+		}
+	}
+
+	if depth != 0 {
+		return nil, fmt.Errorf("line %d: unbalanced parentheses", current.number)
+	}
+	return lines, nil
+}
+
+func dnsZoneFileRecordData(recordType string, rdata []string, origin string) (string, error) {
+	// Positions of the domain names in the record data, which may be relative to the origin
+	namePositions := map[string]int{
+		"CNAME": 0,
+		"NS":    0,
+		"PTR":   0,
+		"ANAME": 0,
+		"DNAME": 0,
+		"MX":    1,
+		"SRV":   3,
+	}
+
+	switch recordType {
+	case "TXT", "SPF":
+		var b strings.Builder
+		for _, s := range rdata {
+			b.WriteString(unquoteDNSZoneFileString(s))
+		}
+		return b.String(), nil
+	}
+
+	if pos, ok := namePositions[recordType]; ok {
+		if len(rdata) <= pos {
+			return "", fmt.Errorf("%s record data is incomplete", recordType)
+		}
+		name, err := dnsZoneFileAbsoluteName(rdata[pos], origin)
+		if err != nil {
+			return "", err
+		}
+		rdata = append(append(append([]string(nil), rdata[:pos]...), name), rdata[pos+1:]...)
+	}
+
+	return strings.Join(rdata, " "), nil
+}
+
+func dnsZoneFileAbsoluteName(name, origin string) (string, error) {
+	if name == "@" || !strings.HasSuffix(name, ".") {
+		if origin == "" {
+			return "", fmt.Errorf("relative name %q without origin, set $ORIGIN or the origin argument", name)
+		}
+	}
+	return dnsRecordSetFQDN(name, origin), nil
+}
+
+func dnsZoneFileCanonicalOrigin(origin string) string {
+	if !strings.HasSuffix(origin, ".") {
+		return origin + "."
+	}
+	return origin
+}
+
+// parseDNSZoneFileTTL parses the TTL in seconds or in the BIND format with units, e.g. 1h30m.
+func parseDNSZoneFileTTL(value string) (int64, error) {
+	if ttl, err := strconv.ParseInt(value, 10, 64); err == nil && ttl >= 0 {
+		return ttl, nil
+	}
+
+	units := map[byte]int64{'s': 1, 'm': 60, 'h': 3600, 'd': 86400, 'w': 604800}
+
+	var ttl, number int64
+	hasNumber := false
+	for i := 0; i < len(value); i++ {
+		c := value[i]
+		switch {
+		case c >= '0' && c <= '9':
+			number = number*10 + int64(c-'0')
+			hasNumber = true
+		case hasNumber && units[byte(unicode.ToLower(rune(c)))] > 0:
+			ttl += number * units[byte(unicode.ToLower(rune(c)))]
+			number, hasNumber = 0, false
+		default:
+			return 0, fmt.Errorf("invalid TTL %q", value)
+		}
+	}
+	if hasNumber || value == "" {
+		return 0, fmt.Errorf("invalid TTL %q", value)
+	}
+	return ttl, nil
+}
+
+func isDNSZoneFileClass(token string) bool {
+	switch strings.ToUpper(token) {
+	case "IN", "CH", "HS", "CS":
+		return true
+	}
+	return false
+}
+
+func unquoteDNSZoneFileString(s string) string {
+	if len(s) < 2 || s[0] != '"' || s[len(s)-1] != '"' {
+		return s
+	}
+
+	var b strings.Builder
+	escaped := false
+	for _, r := range s[1 : len(s)-1] {
+		if !escaped && r == '\\' {
+			escaped = true
+			continue
+		}
+		escaped = false
+		b.WriteRune(r)
+	}
+	return b.String()
+}
+
+// renderDNSZoneFile renders the record sets of the zone to the zone file text, names are written
+// relative to the zone.
+func renderDNSZoneFile(zone string, recordSets []*dns.RecordSet) string {
+	sorted := append([]*dns.RecordSet(nil), recordSets...)
+	sort.SliceStable(sorted, func(i, j int) bool {
+		if sorted[i].GetName() != sorted[j].GetName() {
+			// the zone apex goes first
+			if strings.EqualFold(sorted[i].GetName(), zone) {
+				return true
+			}
+			if strings.EqualFold(sorted[j].GetName(), zone) {
+				return false
+			}
+			return sorted[i].GetName() < sorted[j].GetName()
+		}
+		return dnsZoneFileTypeOrder(sorted[i].GetType()) < dnsZoneFileTypeOrder(sorted[j].GetType())
+	})
+
+	var b strings.Builder
+	fmt.Fprintf(&b, "$ORIGIN %s\n", zone)
+	for _, rs := range sorted {
+		name := dnsZoneFileRelativeName(rs.GetName(), zone)
+		data := append([]string(nil), rs.GetData()...)
+		sort.Strings(data)
+		for _, d := range data {
+			if rs.GetType() == "TXT" || rs.GetType() == "SPF" {
+				d = quoteDNSZoneFileString(d)
+			}
+			fmt.Fprintf(&b, "%s\t%d\tIN\t%s\t%s\n", name, rs.GetTtl(), rs.GetType(), d)
+		}
+	}
+	return b.String()
+}
+
+func dnsZoneFileTypeOrder(recordType string) string {
+	switch recordType {
+	case "SOA":
+		return "0"
+	case "NS":
+		return "1"
+	}
+	return "2" + recordType
+}
+
+func dnsZoneFileRelativeName(name, zone string) string {
+	if strings.EqualFold(name, zone) {
+		return "@"
+	}
+	if suffix := "." + zone; len(name) > len(suffix) && strings.EqualFold(name[len(name)-len(suffix):], suffix) {
+		return name[:len(name)-len(suffix)]
+	}
+	return name
+}
+
+// quoteDNSZoneFileString quotes the TXT record data, long values are split to several strings.
+func quoteDNSZoneFileString(s string) string {
+	if strings.HasPrefix(s, "\"") {
+		return s
+	}
+
+	escape := strings.NewReplacer(`\`, `\\`, `"`, `\"`)
+
+	var parts []string
+	for len(s) > dnsZoneFileMaxTXTStringLength {
+		parts = append(parts, `"`+escape.Replace(s[:dnsZoneFileMaxTXTStringLength])+`"`)
+		s = s[dnsZoneFileMaxTXTStringLength:]
+	}
+	parts = append(parts, `"`+escape.Replace(s)+`"`)
+	return strings.Join(parts, " ")
+}
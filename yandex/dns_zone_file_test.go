@@ -0,0 +1,163 @@
+package yandex
+
+import (
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+)
+
+const testDNSZoneFile = `
+$ORIGIN example.com.
+$TTL 1h
+@       IN  SOA  ns1.example.com. admin.example.com. (
+                 2024010101 ; serial
+                 7200       ; refresh
+                 3600       ; retry
+                 1209600    ; expire
+                 3600 )     ; minimum
+@           NS   ns1.example.com.
+@           NS   ns2.example.com.
+@       300 IN A 203.0.113.10
+www     IN 300 CNAME @
+mail        A    203.0.113.20
+            A    203.0.113.21
+@           MX   10 mail
+@           TXT  "v=spf1 include:_spf.example.net ~all"
+dkim._domainkey TXT ( "v=DKIM1; k=rsa; "
+                      "p=MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQ" ) ; multi-string
+_sip._tcp   SRV  10 60 5060 sip
+$ORIGIN sub.example.com.
+api     1d  AAAA 2001:db8::1
+sub.example.com. NS ns.other.net.
+`
+
+func TestParseDNSZoneFile(t *testing.T) {
+	recordSets, err := parseDNSZoneFile(testDNSZoneFile, "", -1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := []*dns.RecordSet{
+		{Name: "example.com.", Type: "A", Ttl: 300, Data: []string{"203.0.113.10"}},
+		{Name: "www.example.com.", Type: "CNAME", Ttl: 300, Data: []string{"example.com."}},
+		{Name: "mail.example.com.", Type: "A", Ttl: 3600, Data: []string{"203.0.113.20", "203.0.113.21"}},
+		{Name: "example.com.", Type: "MX", Ttl: 3600, Data: []string{"10 mail.example.com."}},
+		{Name: "example.com.", Type: "TXT", Ttl: 3600, Data: []string{"v=spf1 include:_spf.example.net ~all"}},
+		{Name: "dkim._domainkey.example.com.", Type: "TXT", Ttl: 3600, Data: []string{"v=DKIM1; k=rsa; p=MIGfMA0GCSqGSIb3DQEBAQUAA4GNADCBiQ"}},
+		{Name: "_sip._tcp.example.com.", Type: "SRV", Ttl: 3600, Data: []string{"10 60 5060 sip.example.com."}},
+		{Name: "api.sub.example.com.", Type: "AAAA", Ttl: 86400, Data: []string{"2001:db8::1"}},
+		{Name: "sub.example.com.", Type: "NS", Ttl: 3600, Data: []string{"ns.other.net."}},
+	}
+
+	if !reflect.DeepEqual(recordSets, expected) {
+		t.Fatalf("Got:\n\n%v\n\nExpected:\n\n%v\n", recordSets, expected)
+	}
+}
+
+func TestParseDNSZoneFileOriginAndTTLArguments(t *testing.T) {
+	recordSets, err := parseDNSZoneFile("www A 10.0.0.1\n     A 10.0.0.2\napi 60 A 10.0.0.3\ndb A 10.0.0.4\n", "example.com", 600)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	expected := []*dns.RecordSet{
+		{Name: "www.example.com.", Type: "A", Ttl: 600, Data: []string{"10.0.0.1", "10.0.0.2"}},
+		{Name: "api.example.com.", Type: "A", Ttl: 60, Data: []string{"10.0.0.3"}},
+		{Name: "db.example.com.", Type: "A", Ttl: 600, Data: []string{"10.0.0.4"}},
+	}
+	if !reflect.DeepEqual(recordSets, expected) {
+		t.Fatalf("Got:\n\n%v\n\nExpected:\n\n%v\n", recordSets, expected)
+	}
+}
+
+func TestParseDNSZoneFileErrors(t *testing.T) {
+	cases := map[string]string{
+		"relative name without origin": "www 300 A 10.0.0.1\n",
+		"no TTL":                       "$ORIGIN example.com.\nwww A 10.0.0.1\n",
+		"unbalanced parentheses":       "$ORIGIN example.com.\n$TTL 300\nwww TXT ( \"a\"\n",
+		"unterminated string":          "$ORIGIN example.com.\n$TTL 300\nwww TXT \"a\n",
+		"include":                      "$INCLUDE other.zone\n",
+		"missing data":                 "$ORIGIN example.com.\n$TTL 300\nwww A\n",
+		"owner omitted":                " 300 A 10.0.0.1\n",
+		"invalid TTL":                  "$TTL 1x\n",
+	}
+
+	for name, content := range cases {
+		t.Run(name, func(t *testing.T) {
+			if _, err := parseDNSZoneFile(content, "", -1); err == nil {
+				t.Errorf("Parsing of %q should fail", content)
+			}
+		})
+	}
+}
+
+func TestParseDNSZoneFileTTL(t *testing.T) {
+	cases := map[string]int64{
+		"0":     0,
+		"3600":  3600,
+		"1h":    3600,
+		"1h30m": 5400,
+		"2D":    172800,
+		"1w1d":  691200,
+	}
+
+	for value, expected := range cases {
+		ttl, err := parseDNSZoneFileTTL(value)
+		if err != nil || ttl != expected {
+			t.Errorf("TTL %q: got %d, %v, expected %d", value, ttl, err, expected)
+		}
+	}
+
+	for _, value := range []string{"", "h", "1h2", "-1", "A"} {
+		if _, err := parseDNSZoneFileTTL(value); err == nil {
+			t.Errorf("Parsing of TTL %q should fail", value)
+		}
+	}
+}
+
+func TestRenderDNSZoneFile(t *testing.T) {
+	recordSets := []*dns.RecordSet{
+		{Name: "www.example.com.", Type: "CNAME", Ttl: 300, Data: []string{"example.com."}},
+		{Name: "example.com.", Type: "TXT", Ttl: 300, Data: []string{`v=spf1 "quoted" ~all`}},
+		{Name: "example.com.", Type: "NS", Ttl: 3600, Data: []string{"ns2.yandexcloud.net.", "ns1.yandexcloud.net."}},
+		{Name: "example.com.", Type: "SOA", Ttl: 3600, Data: []string{"ns1.yandexcloud.net. mx.cloud.yandex.net. 1 10800 900 604800 900"}},
+		{Name: "other.example.org.", Type: "A", Ttl: 60, Data: []string{"10.0.0.1"}},
+	}
+
+	expected := `$ORIGIN example.com.
+@	3600	IN	SOA	ns1.yandexcloud.net. mx.cloud.yandex.net. 1 10800 900 604800 900
+@	3600	IN	NS	ns1.yandexcloud.net.
+@	3600	IN	NS	ns2.yandexcloud.net.
+@	300	IN	TXT	"v=spf1 \"quoted\" ~all"
+other.example.org.	60	IN	A	10.0.0.1
+www	300	IN	CNAME	example.com.
+`
+
+	content := renderDNSZoneFile("example.com.", recordSets)
+	if content != expected {
+		t.Fatalf("Got:\n\n%s\n\nExpected:\n\n%s\n", content, expected)
+	}
+
+	// the rendered zone file is parsed back to the same records, except SOA and apex NS
+	parsed, err := parseDNSZoneFile(content, "", -1)
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+	if len(parsed) != 3 || parsed[0].GetData()[0] != `v=spf1 "quoted" ~all` {
+		t.Fatalf("Unexpected result of parsing the rendered zone file: %v", parsed)
+	}
+}
+
+func TestQuoteDNSZoneFileString(t *testing.T) {
+	long := strings.Repeat("a", 300)
+	quoted := quoteDNSZoneFileString(long)
+	if quoted != `"`+long[:255]+`" "`+long[255:]+`"` {
+		t.Fatalf("Unexpected quoting of a long string: %s", quoted)
+	}
+
+	if unquoted := unquoteDNSZoneFileString(quoteDNSZoneFileString(`a\b"c`)); unquoted != `a\b"c` {
+		t.Fatalf("Unexpected result of quoting and unquoting: %s", unquoted)
+	}
+}
@@ -441,8 +441,17 @@ func flattenInstanceGroupLoadBalancerSpec(ig *instancegroup.InstanceGroup) ([]ma
 
 func flattenInstanceGroupPlacementPolicy(policy *instancegroup.PlacementPolicy) ([]map[string]interface{}, error) {
 	if policy != nil {
+		var affinityRules []interface{}
+		for _, rule := range policy.HostAffinityRules {
+			affinityRules = append(affinityRules, map[string]interface{}{
+				"key":    rule.Key,
+				"op":     rule.Op.String(),
+				"values": rule.Values,
+			})
+		}
 		placementMap := map[string]interface{}{
-			"placement_group_id": policy.PlacementGroupId,
+			"placement_group_id":  policy.PlacementGroupId,
+			"host_affinity_rules": affinityRules,
 		}
 		return []map[string]interface{}{placementMap}, nil
 	}
@@ -1076,10 +1085,39 @@ func expandInstanceGroupLoadBalancerSpec(d *schema.ResourceData) (*instancegroup
 }
 
 func expandInstanceGroupPlacementPolicy(d *schema.ResourceData, prefix string) *instancegroup.PlacementPolicy {
-	if v, ok := d.GetOk(prefix + ".0.placement_group_id"); ok {
-		return &instancegroup.PlacementPolicy{PlacementGroupId: v.(string)}
+	placementGroupID, placementGroupOk := d.GetOk(prefix + ".0.placement_group_id")
+	rules, rulesOk := d.GetOk(prefix + ".0.host_affinity_rules")
+	if !placementGroupOk && !rulesOk {
+		return nil
+	}
+
+	policy := &instancegroup.PlacementPolicy{}
+	if placementGroupOk {
+		policy.PlacementGroupId = placementGroupID.(string)
+	}
+	if rulesOk {
+		policy.HostAffinityRules = expandInstanceGroupHostAffinityRules(rules.([]interface{}))
+	}
+	return policy
+}
+
+func expandInstanceGroupHostAffinityRules(ruleSpecs []interface{}) []*instancegroup.PlacementPolicy_HostAffinityRule {
+	hostAffinityRules := make([]*instancegroup.PlacementPolicy_HostAffinityRule, 0, len(ruleSpecs))
+	for _, r := range ruleSpecs {
+		ruleSpec := r.(map[string]interface{})
+		operator := instancegroup.PlacementPolicy_HostAffinityRule_Operator_value[ruleSpec["op"].(string)]
+
+		var values []string
+		for _, value := range ruleSpec["values"].([]interface{}) {
+			values = append(values, value.(string))
+		}
+		hostAffinityRules = append(hostAffinityRules, &instancegroup.PlacementPolicy_HostAffinityRule{
+			Key:    ruleSpec["key"].(string),
+			Op:     instancegroup.PlacementPolicy_HostAffinityRule_Operator(operator),
+			Values: values,
+		})
 	}
-	return nil
+	return hostAffinityRules
 }
 
 func flattenInstanceGroupAttachedDisk(diskSpec *instancegroup.AttachedDiskSpec) (map[string]interface{}, error) {
@@ -1193,7 +1193,32 @@ func TestFlattenInstanceGroupPlacementPolicy(t *testing.T) {
 			},
 			expected: []map[string]interface{}{
 				{
-					"placement_group_id": "123",
+					"placement_group_id":  "123",
+					"host_affinity_rules": []interface{}(nil),
+				},
+			},
+		},
+		{
+			name: "host affinity rules",
+			spec: &instancegroup.PlacementPolicy{
+				HostAffinityRules: []*instancegroup.PlacementPolicy_HostAffinityRule{
+					{
+						Key:    "yc.hostGroupId",
+						Op:     instancegroup.PlacementPolicy_HostAffinityRule_IN,
+						Values: []string{"hg-1", "hg-2"},
+					},
+				},
+			},
+			expected: []map[string]interface{}{
+				{
+					"placement_group_id": "",
+					"host_affinity_rules": []interface{}{
+						map[string]interface{}{
+							"key":    "yc.hostGroupId",
+							"op":     "IN",
+							"values": []string{"hg-1", "hg-2"},
+						},
+					},
 				},
 			},
 		},
@@ -1209,7 +1234,8 @@ func TestFlattenInstanceGroupPlacementPolicy(t *testing.T) {
 			},
 			expected: []map[string]interface{}{
 				{
-					"placement_group_id": "",
+					"placement_group_id":  "",
+					"host_affinity_rules": []interface{}(nil),
 				},
 			},
 		},
@@ -15,6 +15,7 @@ import (
 
 	"github.com/aws/aws-sdk-go/aws"
 	"github.com/aws/aws-sdk-go/service/s3"
+	"github.com/aws/aws-sdk-go/service/s3/s3manager"
 	"github.com/mitchellh/go-homedir"
 )
 
@@ -140,6 +141,54 @@ func (c *Client) CreateObject(ctx context.Context, data CreationData) (bool, err
 	return true, nil
 }
 
+// UploadFile streams the local file to the bucket with the given key,
+// large files are uploaded in multiple parts.
+func (c *Client) UploadFile(ctx context.Context, bucket, key, source string) error {
+	path, err := homedir.Expand(source)
+	if err != nil {
+		return fmt.Errorf("error expanding homedir in source (%s): %w", source, err)
+	}
+
+	file, err := os.Open(path)
+	if err != nil {
+		return fmt.Errorf("error opening file (%s): %w", path, err)
+	}
+	defer func() {
+		err := file.Close()
+		if err != nil {
+			log.Printf("[WARN] Error closing file (%s): %s", path, err)
+		}
+	}()
+
+	log.Printf("[DEBUG] Uploading file %s to bucket %q with key %q", path, bucket, key)
+	uploader := s3manager.NewUploaderWithClient(c.s3)
+	_, err = uploader.UploadWithContext(ctx, &s3manager.UploadInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+		Body:   file,
+	})
+	if err != nil {
+		return fmt.Errorf("error uploading file (%s) to bucket %q: %w", path, bucket, err)
+	}
+
+	return nil
+}
+
+// PresignGetObject returns the URL to download the object without credentials until the expiration.
+func (c *Client) PresignGetObject(bucket, key string, expire time.Duration) (string, error) {
+	req, _ := c.s3.GetObjectRequest(&s3.GetObjectInput{
+		Bucket: aws.String(bucket),
+		Key:    aws.String(key),
+	})
+
+	url, err := req.Presign(expire)
+	if err != nil {
+		return "", fmt.Errorf("error presigning storage object %q in bucket %q: %w", key, bucket, err)
+	}
+
+	return url, nil
+}
+
 var ErrObjectNotFound = errors.New("object not found")
 
 type Object struct {
@@ -394,11 +394,24 @@ func flattenLBTargets(tg *loadbalancer.TargetGroup) (*schema.Set, error) {
 
 func flattenLBListenerSpecs(nlb *loadbalancer.NetworkLoadBalancer) (*schema.Set, error) {
 	result := &schema.Set{F: resourceLBNetworkLoadBalancerListenerHash}
+
+	for _, ls := range nlb.Listeners {
+		flListener, err := flattenLBListener(nlb.Type, ls)
+		if err != nil {
+			return nil, err
+		}
+		result.Add(flListener)
+	}
+
+	return result, nil
+}
+
+func flattenLBListener(nlbType loadbalancer.NetworkLoadBalancer_Type, ls *loadbalancer.Listener) (map[string]interface{}, error) {
 	var (
 		addressSpecKey     string
 		flattenAddressSpec func(*loadbalancer.Listener) (*schema.Set, error)
 	)
-	switch nlb.Type {
+	switch nlbType {
 	case loadbalancer.NetworkLoadBalancer_EXTERNAL:
 		addressSpecKey = "external_address_spec"
 		flattenAddressSpec = flattenLBExternalAddressSpec
@@ -406,24 +419,21 @@ func flattenLBListenerSpecs(nlb *loadbalancer.NetworkLoadBalancer) (*schema.Set,
 		addressSpecKey = "internal_address_spec"
 		flattenAddressSpec = flattenLBInternalAddressSpec
 	default:
-		return nil, fmt.Errorf("Unknown network load balancer type: %v", nlb.Type)
+		return nil, fmt.Errorf("Unknown network load balancer type: %v", nlbType)
 	}
-	for _, ls := range nlb.Listeners {
-		as, err := flattenAddressSpec(ls)
-		if err != nil {
-			return nil, err
-		}
-		flListener := map[string]interface{}{
-			"name":         ls.Name,
-			"port":         int(ls.Port),
-			"target_port":  int(ls.TargetPort),
-			"protocol":     strings.ToLower(ls.Protocol.String()),
-			addressSpecKey: as,
-		}
-		result.Add(flListener)
+
+	as, err := flattenAddressSpec(ls)
+	if err != nil {
+		return nil, err
 	}
 
-	return result, nil
+	return map[string]interface{}{
+		"name":         ls.Name,
+		"port":         int(ls.Port),
+		"target_port":  int(ls.TargetPort),
+		"protocol":     strings.ToLower(ls.Protocol.String()),
+		addressSpecKey: as,
+	}, nil
 }
 
 func flattenLBExternalAddressSpec(ls *loadbalancer.Listener) (*schema.Set, error) {
@@ -574,3 +584,26 @@ func getFirstElement(config map[string]interface{}, name string) (map[string]int
 	}
 	return nil, false
 }
+
+func makeLBNetworkLoadBalancerChildID(nlbID, childID string) string {
+	return nlbID + "/" + childID
+}
+
+// parseLBNetworkLoadBalancerChildID parses IDs of the listeners and the attached target groups,
+// childName is only used in the error message.
+func parseLBNetworkLoadBalancerChildID(id, childName string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid ID %q, expected format is network_load_balancer_id/%s", id, childName)
+	}
+	return parts[0], parts[1], nil
+}
+
+func findLBAttachedTargetGroup(atgs []*loadbalancer.AttachedTargetGroup, targetGroupID string) *loadbalancer.AttachedTargetGroup {
+	for _, atg := range atgs {
+		if atg.GetTargetGroupId() == targetGroupID {
+			return atg
+		}
+	}
+	return nil
+}
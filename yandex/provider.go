@@ -161,14 +161,20 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_compute_disk_placement_group":                     dataSourceYandexComputeDiskPlacementGroup(),
 			"yandex_compute_filesystem":                               dataSourceYandexComputeFilesystem(),
 			"yandex_compute_gpu_cluster":                              dataSourceYandexComputeGpuCluster(),
+			"yandex_compute_host_group":                               dataSourceYandexComputeHostGroup(),
+			"yandex_compute_reserved_instance_pool":                   dataSourceYandexComputeReservedInstancePool(),
 			"yandex_compute_image":                                    dataSourceYandexComputeImage(),
 			"yandex_compute_instance":                                 dataSourceYandexComputeInstance(),
 			"yandex_compute_instance_group":                           dataSourceYandexComputeInstanceGroup(),
+			"yandex_compute_instance_serial_output":                   dataSourceYandexComputeInstanceSerialOutput(),
 			"yandex_compute_placement_group":                          dataSourceYandexComputePlacementGroup(),
 			"yandex_compute_snapshot":                                 dataSourceYandexComputeSnapshot(),
 			"yandex_compute_snapshot_schedule":                        dataSourceYandexComputeSnapshotSchedule(),
+			"yandex_compute_snapshots":                                dataSourceYandexComputeSnapshots(),
 			"yandex_dataproc_cluster":                                 dataSourceYandexDataprocCluster(),
 			"yandex_dns_zone":                                         dataSourceYandexDnsZone(),
+			"yandex_dns_zone_export":                                  dataSourceYandexDnsZoneExport(),
+			"yandex_dns_zone_file":                                    dataSourceYandexDnsZoneFile(),
 			"yandex_serverless_eventrouter_bus":                       dataSourceYandexServerlessEventrouterBus(),
 			"yandex_serverless_eventrouter_connector":                 dataSourceYandexServerlessEventrouterConnector(),
 			"yandex_serverless_eventrouter_rule":                      dataSourceYandexServerlessEventrouterRule(),
@@ -229,6 +235,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_vpc_security_group":                               dataSourceYandexVPCSecurityGroup(),
 			"yandex_vpc_subnet":                                       dataSourceYandexVPCSubnet(),
 			"yandex_vpc_private_endpoint":                             dataSourceYandexVPCPrivateEndpoint(),
+			"yandex_vpc_private_endpoint_services":                    dataSourceYandexVPCPrivateEndpointServices(),
 			"yandex_ydb_database_dedicated":                           dataSourceYandexYDBDatabaseDedicated(),
 			"yandex_ydb_database_serverless":                          dataSourceYandexYDBDatabaseServerless(),
 			"yandex_sws_security_profile":                             dataSourceYandexSmartwebsecuritySecurityProfile(),
@@ -243,7 +250,9 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_alb_http_router":                                   resourceYandexALBHTTPRouter(),
 			"yandex_alb_load_balancer":                                 resourceYandexALBLoadBalancer(),
 			"yandex_alb_target_group":                                  resourceYandexALBTargetGroup(),
+			"yandex_alb_target_group_target":                           resourceYandexALBTargetGroupTarget(),
 			"yandex_alb_virtual_host":                                  addPassthroughImport(withALBVirtualHostID(resourceYandexALBVirtualHost())),
+			"yandex_alb_virtual_host_route":                            resourceYandexALBVirtualHostRoute(),
 			"yandex_api_gateway":                                       resourceYandexApiGateway(),
 			"yandex_audit_trails_trail":                                resourceYandexAuditTrailsTrail(),
 			"yandex_backup_policy":                                     resourceYandexBackupPolicy(),
@@ -260,14 +269,19 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_cm_certificate_iam_binding":                        resourceYandexCMCertificateIAMBinding(),
 			"yandex_cm_certificate_iam_member":                         resourceYandexCMCertificateIAMMember(),
 			"yandex_compute_disk":                                      resourceYandexComputeDisk(),
+			"yandex_compute_disk_attachment":                           resourceYandexComputeDiskAttachment(),
 			"yandex_compute_disk_placement_group":                      resourceYandexComputeDiskPlacementGroup(),
 			"yandex_compute_filesystem":                                resourceYandexComputeFilesystem(),
 			"yandex_compute_gpu_cluster":                               resourceYandexComputeGpuCluster(),
+			"yandex_compute_host_group":                                resourceYandexComputeHostGroup(),
+			"yandex_compute_reserved_instance_pool":                    resourceYandexComputeReservedInstancePool(),
 			"yandex_compute_image":                                     resourceYandexComputeImage(),
 			"yandex_compute_instance":                                  resourceYandexComputeInstance(),
 			"yandex_compute_instance_group":                            resourceYandexComputeInstanceGroup(),
+			"yandex_compute_instance_network_interface":                resourceYandexComputeInstanceNetworkInterface(),
 			"yandex_compute_placement_group":                           resourceYandexComputePlacementGroup(),
 			"yandex_compute_snapshot":                                  resourceYandexComputeSnapshot(),
+			"yandex_compute_snapshot_copy":                             resourceYandexComputeSnapshotCopy(),
 			"yandex_compute_snapshot_schedule":                         resourceYandexComputeSnapshotSchedule(),
 			"yandex_dataproc_cluster":                                  resourceYandexDataprocCluster(),
 			"yandex_datatransfer_endpoint":                             resourceYandexDatatransferEndpoint(),
@@ -275,6 +289,7 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_dns_zone_iam_binding":                              resourceYandexDnsZoneIAMBinding(),
 			"yandex_dns_recordset":                                     resourceYandexDnsRecordSet(),
 			"yandex_dns_zone":                                          resourceYandexDnsZone(),
+			"yandex_dns_zone_records":                                  resourceYandexDnsZoneRecords(),
 			"yandex_serverless_eventrouter_bus":                        resourceYandexServerlessEventrouterBus(),
 			"yandex_serverless_eventrouter_connector":                  resourceYandexServerlessEventrouterConnector(),
 			"yandex_serverless_eventrouter_rule":                       resourceYandexServerlessEventrouterRule(),
@@ -310,6 +325,8 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_kubernetes_cluster_iam_member":                     resourceYandexKubernetesClusterIAMMember(),
 			"yandex_kubernetes_node_group":                             resourceYandexKubernetesNodeGroup(),
 			"yandex_lb_network_load_balancer":                          resourceYandexLBNetworkLoadBalancer(),
+			"yandex_lb_network_load_balancer_listener":                 resourceYandexLBNetworkLoadBalancerListener(),
+			"yandex_lb_network_load_balancer_target_group_attachment":  resourceYandexLBNetworkLoadBalancerTargetGroupAttachment(),
 			"yandex_lb_target_group":                                   resourceYandexLBTargetGroup(),
 			"yandex_loadtesting_agent":                                 resourceYandexLoadtestingAgent(),
 			"yandex_lockbox_secret":                                    resourceYandexLockboxSecret(),
@@ -359,11 +376,13 @@ func sdkProvider(emptyFolder bool) *schema.Provider {
 			"yandex_storage_bucket":                                    resourceYandexStorageBucket(),
 			"yandex_storage_object":                                    resourceYandexStorageObject(),
 			"yandex_vpc_address":                                       resourceYandexVPCAddress(),
+			"yandex_vpc_cidr_allocation":                               resourceYandexVPCCIDRAllocation(),
+			"yandex_vpc_cidr_pool":                                     resourceYandexVPCCIDRPool(),
 			"yandex_vpc_default_security_group":                        resourceYandexVPCDefaultSecurityGroup(),
 			"yandex_vpc_gateway":                                       resourceYandexVPCGateway(),
 			"yandex_vpc_network":                                       resourceYandexVPCNetwork(),
 			"yandex_vpc_route_table":                                   resourceYandexVPCRouteTable(),
-			"yandex_vpc_security_group":                                resourceYandexVPCSecurityGroup(),
+			"yandex_vpc_route_table_route":                             resourceYandexVPCRouteTableRoute(),
 			"yandex_vpc_subnet":                                        resourceYandexVPCSubnet(),
 			"yandex_vpc_private_endpoint":                              resourceYandexVPCPrivateEndpoint(),
 			"yandex_ydb_database_iam_binding":                          resourceYandexYDBDatabaseIAMBinding(),
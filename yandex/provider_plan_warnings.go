@@ -0,0 +1,57 @@
+package yandex
+
+import (
+	"context"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+// The SDK doesn't return warnings from CustomizeDiff functions, so they are collected in the context
+// of the plan and appended to its response by planWarningsProviderServer.
+type planWarningsKey struct{}
+
+type planWarnings struct {
+	mu          sync.Mutex
+	diagnostics []*tfprotov5.Diagnostic
+}
+
+// addPlanWarning adds a warning to the plan of the resource. It does nothing outside of the plan.
+func addPlanWarning(ctx context.Context, summary, detail string) {
+	warnings, ok := ctx.Value(planWarningsKey{}).(*planWarnings)
+	if !ok {
+		return
+	}
+
+	warnings.mu.Lock()
+	defer warnings.mu.Unlock()
+	warnings.diagnostics = append(warnings.diagnostics, &tfprotov5.Diagnostic{
+		Severity: tfprotov5.DiagnosticSeverityWarning,
+		Summary:  summary,
+		Detail:   detail,
+	})
+}
+
+type planWarningsProviderServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s *planWarningsProviderServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	warnings := &planWarnings{}
+	resp, err := s.ProviderServer.PlanResourceChange(context.WithValue(ctx, planWarningsKey{}, warnings), req)
+	if err != nil || resp == nil {
+		return resp, err
+	}
+
+	warnings.mu.Lock()
+	defer warnings.mu.Unlock()
+	resp.Diagnostics = append(resp.Diagnostics, warnings.diagnostics...)
+	return resp, nil
+}
+
+// NewSDKProviderServer returns the gRPC server of the SDK provider, which also reports the plan warnings of the resources.
+func NewSDKProviderServer() tfprotov5.ProviderServer {
+	return &planWarningsProviderServer{
+		ProviderServer: NewSDKProvider().GRPCProvider(),
+	}
+}
@@ -0,0 +1,40 @@
+package yandex
+
+import (
+	"context"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+)
+
+type testPlanProviderServer struct {
+	tfprotov5.ProviderServer
+}
+
+func (s *testPlanProviderServer) PlanResourceChange(ctx context.Context, req *tfprotov5.PlanResourceChangeRequest) (*tfprotov5.PlanResourceChangeResponse, error) {
+	addPlanWarning(ctx, "Test warning", req.TypeName)
+	return &tfprotov5.PlanResourceChangeResponse{}, nil
+}
+
+func TestPlanWarningsProviderServer(t *testing.T) {
+	server := &planWarningsProviderServer{ProviderServer: &testPlanProviderServer{}}
+
+	resp, err := server.PlanResourceChange(context.Background(), &tfprotov5.PlanResourceChangeRequest{
+		TypeName: "yandex_compute_instance",
+	})
+	if err != nil {
+		t.Fatalf("Unexpected error: %s", err)
+	}
+
+	if len(resp.Diagnostics) != 1 {
+		t.Fatalf("Expected 1 diagnostic, got %d", len(resp.Diagnostics))
+	}
+	if d := resp.Diagnostics[0]; d.Severity != tfprotov5.DiagnosticSeverityWarning || d.Summary != "Test warning" || d.Detail != "yandex_compute_instance" {
+		t.Fatalf("Unexpected diagnostic: %+v", d)
+	}
+}
+
+func TestAddPlanWarningOutsideOfPlan(t *testing.T) {
+	// must not panic without the collector in the context
+	addPlanWarning(context.Background(), "Test warning", "")
+}
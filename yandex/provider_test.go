@@ -8,8 +8,15 @@ import (
 	"strings"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-framework/providerserver"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov5"
+	"github.com/hashicorp/terraform-plugin-go/tfprotov6"
+	"github.com/hashicorp/terraform-plugin-mux/tf5to6server"
+	"github.com/hashicorp/terraform-plugin-mux/tf6muxserver"
 	terraform2 "github.com/hashicorp/terraform-plugin-sdk/v2/terraform"
 
+	yandex_framework "github.com/yandex-cloud/terraform-provider-yandex/yandex-framework/provider"
+
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
@@ -29,6 +36,10 @@ const providerDefaultValueEndpoint = "api.cloud.yandex.net:443"
 var testAccProviders map[string]*schema.Provider
 var testAccProviderFactories map[string]func() (*schema.Provider, error)
 
+// testAccMuxProviderFactories serve testAccProvider together with the framework provider, like the released
+// provider does. Tests, which configure resources of the framework provider, e.g. yandex_vpc_security_group, use them.
+var testAccMuxProviderFactories map[string]func() (tfprotov6.ProviderServer, error)
+
 // WARNING!!!! do not use testAccProviderEmptyFolder in tests, that use testAccCheck***Destroy functions.
 // testAccCheck***Destroy functions tend to use static testAccProvider
 var testAccProviderEmptyFolder map[string]*schema.Provider
@@ -76,6 +87,10 @@ func init() {
 		},
 	}
 
+	testAccMuxProviderFactories = map[string]func() (tfprotov6.ProviderServer, error){
+		"yandex": newTestAccMuxProviderServer,
+	}
+
 	testAccProviderEmptyFolder = map[string]*schema.Provider{
 		"yandex": emptyFolderProvider(),
 	}
@@ -87,6 +102,28 @@ func init() {
 	}
 }
 
+func newTestAccMuxProviderServer() (tfprotov6.ProviderServer, error) {
+	ctx := context.Background()
+	upgradedSdkProvider, err := tf5to6server.UpgradeServer(ctx, func() tfprotov5.ProviderServer {
+		return &planWarningsProviderServer{ProviderServer: testAccProvider.GRPCProvider()}
+	})
+	if err != nil {
+		return nil, err
+	}
+
+	muxServer, err := tf6muxserver.NewMuxServer(ctx,
+		providerserver.NewProtocol6(yandex_framework.NewFrameworkProvider()),
+		func() tfprotov6.ProviderServer {
+			return upgradedSdkProvider
+		},
+	)
+	if err != nil {
+		return nil, err
+	}
+
+	return muxServer.ProviderServer(), nil
+}
+
 func TestProvider(t *testing.T) {
 	if err := NewSDKProvider().InternalValidate(); err != nil {
 		t.Fatalf("err: %s", err)
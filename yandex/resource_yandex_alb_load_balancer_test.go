@@ -86,9 +86,9 @@ func TestAccALBLoadBalancer_basic(t *testing.T) {
 	folderID := getExampleFolderID()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccALBLoadBalancerBasic(balancerName, balancerDescription),
@@ -120,9 +120,9 @@ func TestAccALBLoadBalancer_streamListener(t *testing.T) {
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -162,9 +162,9 @@ func TestAccALBLoadBalancer_httpListenerWithHTTP2Options(t *testing.T) {
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -201,9 +201,9 @@ func TestAccALBLoadBalancer_httpListenerWithAllowHTTP10(t *testing.T) {
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -240,9 +240,9 @@ func TestAccALBLoadBalancer_httpListenerWithRewriteRequestID(t *testing.T) {
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -278,9 +278,9 @@ func TestAccALBLoadBalancer_httpListenerWithRedirects(t *testing.T) {
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -316,9 +316,9 @@ func TestAccALBLoadBalancer_tlsListenerWithStreamHandler(t *testing.T) {
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -364,9 +364,9 @@ func TestAccALBLoadBalancer_tlsListenerWithHTTP2Options(t *testing.T) {
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -409,9 +409,9 @@ func TestAccALBLoadBalancer_tlsListenerWithAllowHTTP10(t *testing.T) {
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -448,9 +448,9 @@ func TestAccALBLoadBalancer_tlsListenerWithRewriteRequestID(t *testing.T) {
 	listenerPath := ""
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -479,9 +479,9 @@ func TestAccALBLoadBalancer_update(t *testing.T) {
 	var alb apploadbalancer.LoadBalancer
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccALBLoadBalancerBasic(
@@ -522,9 +522,9 @@ func TestAccALBLoadBalancer_logOptions(t *testing.T) {
 	var rulesPath string
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckALBLoadBalancerDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckALBLoadBalancerDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testALBLoadBalancerConfig_basic(albResource),
@@ -7,6 +7,8 @@ import (
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"google.golang.org/genproto/protobuf/field_mask"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
@@ -14,6 +16,11 @@ import (
 
 const yandexALBTargetGroupDefaultTimeout = 5 * time.Minute
 
+const (
+	albTargetsManagementInline   = "inline"
+	albTargetsManagementExternal = "external"
+)
+
 func resourceYandexALBTargetGroup() *schema.Resource {
 	return &schema.Resource{
 		Description: "Creates a target group in the specified folder and adds the specified targets to it. For more information, see [the official documentation](https://yandex.cloud/docs/application-load-balancer/concepts/target-group).\n",
@@ -21,6 +28,9 @@ func resourceYandexALBTargetGroup() *schema.Resource {
 		Read:        resourceYandexALBTargetGroupRead,
 		Update:      resourceYandexALBTargetGroupUpdate,
 		Delete:      resourceYandexALBTargetGroupDelete,
+
+		CustomizeDiff: resourceYandexALBTargetGroupCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -66,24 +76,14 @@ func resourceYandexALBTargetGroup() *schema.Resource {
 				Type:        schema.TypeList,
 				Description: "A Target resource.",
 				Optional:    true,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"subnet_id": {
-							Type:        schema.TypeString,
-							Description: "ID of the subnet that targets are connected to. All targets in the target group must be connected to the same subnet within a single availability zone.",
-							Optional:    true,
-						},
-						"ip_address": {
-							Type:        schema.TypeString,
-							Description: "IP address of the target.",
-							Required:    true,
-						},
-						"private_ipv4_address": {
-							Type:     schema.TypeBool,
-							Optional: true,
-						},
-					},
-				},
+				Elem:        albTargetGroupTargetResource(),
+			},
+
+			"targets_management": {
+				Type:         schema.TypeString,
+				Description:  "How targets are managed. With `inline` (the default) they are declared with the `target` blocks and any other targets are removed. With `external` the blocks can't be used and the targets managed by `yandex_alb_target_group_target` resources are left intact.",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{albTargetsManagementInline, albTargetsManagementExternal}, false),
 			},
 
 			"created_at": {
@@ -96,6 +96,27 @@ func resourceYandexALBTargetGroup() *schema.Resource {
 
 }
 
+func albTargetGroupTargetResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the subnet that targets are connected to. All targets in the target group must be connected to the same subnet within a single availability zone.",
+				Optional:    true,
+			},
+			"ip_address": {
+				Type:        schema.TypeString,
+				Description: "IP address of the target.",
+				Required:    true,
+			},
+			"private_ipv4_address": {
+				Type:     schema.TypeBool,
+				Optional: true,
+			},
+		},
+	}
+}
+
 func resourceYandexALBTargetGroupCreate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -180,8 +201,10 @@ func resourceYandexALBTargetGroupRead(d *schema.ResourceData, meta interface{})
 	_ = d.Set("folder_id", tg.FolderId)
 	_ = d.Set("description", tg.Description)
 
-	if err := d.Set("target", targets); err != nil {
-		return err
+	if !isALBTargetsManagementExternal(d) {
+		if err := d.Set("target", targets); err != nil {
+			return err
+		}
 	}
 
 	log.Printf("[DEBUG] Finished reading Application Target Group %q", d.Id())
@@ -211,6 +234,12 @@ func resourceYandexALBTargetGroupUpdate(d *schema.ResourceData, meta interface{}
 		Targets:       targets,
 	}
 
+	if isALBTargetsManagementExternal(d) {
+		// leave the targets added by yandex_alb_target_group_target resources intact
+		req.Targets = nil
+		req.UpdateMask = &field_mask.FieldMask{Paths: []string{"name", "description", "labels"}}
+	}
+
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
@@ -228,6 +257,21 @@ func resourceYandexALBTargetGroupUpdate(d *schema.ResourceData, meta interface{}
 	return resourceYandexALBTargetGroupRead(d, meta)
 }
 
+func resourceYandexALBTargetGroupCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("targets_management").(string) != albTargetsManagementExternal {
+		return nil
+	}
+
+	if len(d.Get("target").([]interface{})) > 0 {
+		return fmt.Errorf("%q can't be used together with targets_management = %q", "target", albTargetsManagementExternal)
+	}
+	return nil
+}
+
+func isALBTargetsManagementExternal(d *schema.ResourceData) bool {
+	return d.Get("targets_management").(string) == albTargetsManagementExternal
+}
+
 func resourceYandexALBTargetGroupDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
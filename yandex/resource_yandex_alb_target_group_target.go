@@ -0,0 +1,189 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
+)
+
+func resourceYandexALBTargetGroupTarget() *schema.Resource {
+	targetSchema := recursivelyUpdateResource(albTargetGroupTargetResource(), func(s *schema.Schema) {
+		// targets can't be changed in place, they are removed and added again
+		if s.Required || s.Optional {
+			s.ForceNew = true
+		}
+	}).Schema
+
+	targetSchema["target_group_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "ID of the target group to add the target to.",
+		Required:    true,
+		ForceNew:    true,
+	}
+
+	return &schema.Resource{
+		Description: "Adds a target to an application load balancer target group. Use it with `targets_management = \"external\"` of the `yandex_alb_target_group`, so several configurations can share one target group. For more information, see [the official documentation](https://yandex.cloud/docs/application-load-balancer/concepts/target-group).\n\n" +
+			"~> Any change of the target recreates it.\n",
+
+		CreateContext: resourceYandexALBTargetGroupTargetCreate,
+		ReadContext:   resourceYandexALBTargetGroupTargetRead,
+		DeleteContext: resourceYandexALBTargetGroupTargetDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexALBTargetGroupTargetImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexALBTargetGroupDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexALBTargetGroupDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: targetSchema,
+	}
+}
+
+func resourceYandexALBTargetGroupTargetCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	tgID := d.Get("target_group_id").(string)
+	target, err := expandALBTarget(d, "")
+	if err != nil {
+		return diag.Errorf("Error expanding target while adding it to Application Target Group %q: %s", tgID, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(tgID)
+	defer mutexKV.Unlock(tgID)
+
+	log.Printf("[DEBUG] Adding target %q to Application Target Group %q", target.GetIpAddress(), tgID)
+
+	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().TargetGroup().AddTargets(ctx, &apploadbalancer.AddTargetsRequest{
+		TargetGroupId: tgID,
+		Targets:       []*apploadbalancer.Target{target},
+	}))
+	if err != nil {
+		return diag.Errorf("Error while requesting API to add target %q to Application Target Group %q: %s", target.GetIpAddress(), tgID, err)
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		return diag.Errorf("Error while waiting operation to add target %q to Application Target Group %q: %s", target.GetIpAddress(), tgID, err)
+	}
+
+	d.SetId(makeALBTargetGroupTargetID(tgID, target.GetIpAddress()))
+
+	return resourceYandexALBTargetGroupTargetRead(ctx, d, meta)
+}
+
+func resourceYandexALBTargetGroupTargetRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	tgID, ipAddress, err := parseALBTargetGroupTargetID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	tg, err := config.sdk.ApplicationLoadBalancer().TargetGroup().Get(ctx, &apploadbalancer.GetTargetGroupRequest{
+		TargetGroupId: tgID,
+	})
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("Application Target Group %q", tgID)))
+	}
+
+	target := findALBTarget(tg.GetTargets(), ipAddress, d.Get("subnet_id").(string))
+	if target == nil {
+		log.Printf("[WARN] Target %q is not found in Application Target Group %q, removing target from state", ipAddress, tgID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("target_group_id", tgID)
+	d.Set("ip_address", target.GetIpAddress())
+	d.Set("subnet_id", target.GetSubnetId())
+	d.Set("private_ipv4_address", target.GetPrivateIpv4Address())
+
+	return nil
+}
+
+func resourceYandexALBTargetGroupTargetDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	tgID, ipAddress, err := parseALBTargetGroupTargetID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	target, err := expandALBTarget(d, "")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(tgID)
+	defer mutexKV.Unlock(tgID)
+
+	log.Printf("[DEBUG] Removing target %q from Application Target Group %q", ipAddress, tgID)
+
+	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().TargetGroup().RemoveTargets(ctx, &apploadbalancer.RemoveTargetsRequest{
+		TargetGroupId: tgID,
+		Targets:       []*apploadbalancer.Target{target},
+	}))
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("Target %q of Application Target Group %q", ipAddress, tgID)))
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		return diag.Errorf("Error while waiting operation to remove target %q from Application Target Group %q: %s", ipAddress, tgID, err)
+	}
+
+	log.Printf("[DEBUG] Finished removing target %q from Application Target Group %q", ipAddress, tgID)
+	return nil
+}
+
+func resourceYandexALBTargetGroupTargetImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := parseALBTargetGroupTargetID(d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func makeALBTargetGroupTargetID(tgID, ipAddress string) string {
+	return tgID + "/" + ipAddress
+}
+
+func parseALBTargetGroupTargetID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid target ID %q, expected format <target_group_id>/<ip_address>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+// findALBTarget looks the target up by its IP address. The subnet is only compared if it's known,
+// the imported targets have no subnet in the state yet.
+func findALBTarget(targets []*apploadbalancer.Target, ipAddress, subnetID string) *apploadbalancer.Target {
+	for _, t := range targets {
+		if t.GetIpAddress() != ipAddress {
+			continue
+		}
+		if subnetID != "" && t.GetSubnetId() != subnetID {
+			continue
+		}
+		return t
+	}
+	return nil
+}
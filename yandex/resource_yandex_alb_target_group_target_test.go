@@ -0,0 +1,112 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+)
+
+func TestParseALBTargetGroupTargetID(t *testing.T) {
+	tgID, ipAddress, err := parseALBTargetGroupTargetID(makeALBTargetGroupTargetID("ds7", "10.0.0.5"))
+	if err != nil || tgID != "ds7" || ipAddress != "10.0.0.5" {
+		t.Fatalf("Unexpected result of parsing: %q, %q, %v", tgID, ipAddress, err)
+	}
+
+	for _, id := range []string{"", "ds7", "ds7/", "/10.0.0.5", "ds7/10.0.0.5/extra"} {
+		if _, _, err := parseALBTargetGroupTargetID(id); err == nil {
+			t.Errorf("Parsing of %q should fail", id)
+		}
+	}
+}
+
+func TestFindALBTarget(t *testing.T) {
+	targets := []*apploadbalancer.Target{
+		{SubnetId: "subnet-a", AddressType: &apploadbalancer.Target_IpAddress{IpAddress: "10.0.0.5"}},
+		{SubnetId: "subnet-b", AddressType: &apploadbalancer.Target_IpAddress{IpAddress: "10.0.0.5"}},
+		{PrivateIpv4Address: true, AddressType: &apploadbalancer.Target_IpAddress{IpAddress: "192.168.0.1"}},
+	}
+
+	if target := findALBTarget(targets, "10.0.0.5", "subnet-b"); target != targets[1] {
+		t.Errorf("Unexpected target found by IP address and subnet: %v", target)
+	}
+	if target := findALBTarget(targets, "10.0.0.5", ""); target != targets[0] {
+		t.Errorf("Unexpected target found by IP address: %v", target)
+	}
+	if target := findALBTarget(targets, "192.168.0.1", ""); target != targets[2] {
+		t.Errorf("Unexpected target found by private IP address: %v", target)
+	}
+	if target := findALBTarget(targets, "10.0.0.5", "subnet-c"); target != nil {
+		t.Errorf("No target should be found, got %v", target)
+	}
+}
+
+func TestAccALBTargetGroupTarget_basic(t *testing.T) {
+	t.Parallel()
+
+	var tg apploadbalancer.TargetGroup
+	tgName := acctest.RandomWithPrefix("tf-alb-target-group")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckALBTargetGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccALBTargetGroupTarget_basic(tgName, "10.0.1.10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckALBTargetGroupExists(albTGResource, &tg),
+					// targets of the target resources are not tracked by the target group
+					resource.TestCheckResourceAttr(albTGResource, "target.#", "0"),
+					resource.TestCheckResourceAttr("yandex_alb_target_group_target.first", "ip_address", "10.0.1.10"),
+					resource.TestCheckResourceAttrPair("yandex_alb_target_group_target.first", "subnet_id", "yandex_vpc_subnet.test-subnet", "id"),
+					resource.TestCheckResourceAttr("yandex_alb_target_group_target.second", "ip_address", "10.0.1.11"),
+				),
+			},
+			{
+				Config: testAccALBTargetGroupTarget_basic(tgName, "10.0.1.12"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckALBTargetGroupExists(albTGResource, &tg),
+					resource.TestCheckResourceAttr("yandex_alb_target_group_target.first", "ip_address", "10.0.1.12"),
+				),
+			},
+			{
+				ResourceName:      "yandex_alb_target_group_target.first",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+//revive:disable:var-naming
+func testAccALBTargetGroupTarget_basic(name, ipAddress string) string {
+	return fmt.Sprintf(`
+resource "yandex_vpc_network" "test-network" {}
+
+resource "yandex_vpc_subnet" "test-subnet" {
+  zone           = "ru-central1-a"
+  network_id     = "${yandex_vpc_network.test-network.id}"
+  v4_cidr_blocks = ["10.0.1.0/24"]
+}
+
+resource "yandex_alb_target_group" "test-tg" {
+  name               = "%s"
+  targets_management = "external"
+}
+
+resource "yandex_alb_target_group_target" "first" {
+  target_group_id = "${yandex_alb_target_group.test-tg.id}"
+  subnet_id       = "${yandex_vpc_subnet.test-subnet.id}"
+  ip_address      = "%s"
+}
+
+resource "yandex_alb_target_group_target" "second" {
+  target_group_id = "${yandex_alb_target_group.test-tg.id}"
+  subnet_id       = "${yandex_vpc_subnet.test-subnet.id}"
+  ip_address      = "10.0.1.11"
+}
+`, name, ipAddress)
+}
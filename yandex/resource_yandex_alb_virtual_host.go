@@ -10,10 +10,16 @@ import (
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
 )
 
 const yandexALBVirtualHostDefaultTimeout = 5 * time.Minute
 
+const (
+	albRoutesManagementInline   = "inline"
+	albRoutesManagementExternal = "external"
+)
+
 func resourceYandexALBVirtualHost() *schema.Resource {
 	return &schema.Resource{
 		Description: "Creates a virtual host that belongs to specified HTTP router and adds the specified routes to it. For more information, see [the official documentation](https://yandex.cloud/docs/application-load-balancer/concepts/http-router).\n",
@@ -21,6 +27,9 @@ func resourceYandexALBVirtualHost() *schema.Resource {
 		Read:        resourceYandexALBVirtualHostRead,
 		Update:      resourceYandexALBVirtualHostUpdate,
 		Delete:      resourceYandexALBVirtualHostDelete,
+
+		CustomizeDiff: resourceYandexALBVirtualHostCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -58,238 +67,248 @@ func resourceYandexALBVirtualHost() *schema.Resource {
 				Type:        schema.TypeList,
 				Description: routeSchemaDescription,
 				Optional:    true,
+				Elem:        albVirtualHostRouteResource(),
+			},
+			"routes_management": {
+				Type:         schema.TypeString,
+				Description:  "How routes are managed. With `inline` (the default) they are declared with the `route` blocks and any other routes are removed. With `external` the blocks can't be used and the routes managed by `yandex_alb_virtual_host_route` resources are left intact.",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{albRoutesManagementInline, albRoutesManagementExternal}, false),
+			},
+			"route_options": routeOptions(),
+		},
+	}
+}
+
+func albVirtualHostRouteResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: routeNameSchemaDescription,
+				Optional:    true,
+			},
+			"http_route": {
+				Type:        schema.TypeList,
+				Description: routeHTTPRouteSchemaDescription,
+				MaxItems:    1,
+				Optional:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"name": {
-							Type:        schema.TypeString,
-							Description: routeNameSchemaDescription,
+						"http_route_action": {
+							Type:        schema.TypeList,
+							Description: routeHTTPRouteActionSchemaDescription,
+							MaxItems:    1,
 							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"backend_group_id": {
+										Type:        schema.TypeString,
+										Description: routeHTTPRouteActionBackendGroupIDSchemaDescription,
+										Required:    true,
+									},
+									"timeout": {
+										Type:             schema.TypeString,
+										Description:      routeHTTPRouteActionTimeoutSchemaDescription,
+										Optional:         true,
+										ValidateFunc:     validateParsableValue(parseDuration),
+										DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
+									},
+									"idle_timeout": {
+										Type:             schema.TypeString,
+										Description:      routeHTTPRouteActionIdleTimeoutSchemaDescription,
+										Optional:         true,
+										ValidateFunc:     validateParsableValue(parseDuration),
+										DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
+									},
+									"prefix_rewrite": {
+										Type:        schema.TypeString,
+										Description: routeHTTPRouteActionPrefixRewriteSchemaDescription,
+										Optional:    true,
+									},
+									regexRewriteSchemaKey: regexRewrite(),
+									"upgrade_types": {
+										Type:        schema.TypeSet,
+										Description: routeHTTPRouteActionUpgradeTypesSchemaDescription,
+										Optional:    true,
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Set:         schema.HashString,
+									},
+									"host_rewrite": {
+										Type:        schema.TypeString,
+										Description: routeHTTPRouteActionHostRewriteSchemaDescription,
+										Optional:    true,
+									},
+									"auto_host_rewrite": {
+										Type:        schema.TypeBool,
+										Description: routeHTTPRouteActionAutoHostRewriteSchemaDescription,
+										Optional:    true,
+									},
+									rateLimitSchemaKey: rateLimit(),
+								},
+							},
 						},
-						"http_route": {
+						"redirect_action": {
 							Type:        schema.TypeList,
-							Description: routeHTTPRouteSchemaDescription,
+							Description: routeHTTPRedirectActionSchemaDescription,
 							MaxItems:    1,
 							Optional:    true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
-									"http_route_action": {
-										Type:        schema.TypeList,
-										Description: routeHTTPRouteActionSchemaDescription,
-										MaxItems:    1,
+									"replace_scheme": {
+										Type:        schema.TypeString,
+										Description: routeHTTPRedirectActionReplaceSchemeSchemaDescription,
 										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"backend_group_id": {
-													Type:        schema.TypeString,
-													Description: routeHTTPRouteActionBackendGroupIDSchemaDescription,
-													Required:    true,
-												},
-												"timeout": {
-													Type:             schema.TypeString,
-													Description:      routeHTTPRouteActionTimeoutSchemaDescription,
-													Optional:         true,
-													ValidateFunc:     validateParsableValue(parseDuration),
-													DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
-												},
-												"idle_timeout": {
-													Type:             schema.TypeString,
-													Description:      routeHTTPRouteActionIdleTimeoutSchemaDescription,
-													Optional:         true,
-													ValidateFunc:     validateParsableValue(parseDuration),
-													DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
-												},
-												"prefix_rewrite": {
-													Type:        schema.TypeString,
-													Description: routeHTTPRouteActionPrefixRewriteSchemaDescription,
-													Optional:    true,
-												},
-												regexRewriteSchemaKey: regexRewrite(),
-												"upgrade_types": {
-													Type:        schema.TypeSet,
-													Description: routeHTTPRouteActionUpgradeTypesSchemaDescription,
-													Optional:    true,
-													Elem:        &schema.Schema{Type: schema.TypeString},
-													Set:         schema.HashString,
-												},
-												"host_rewrite": {
-													Type:        schema.TypeString,
-													Description: routeHTTPRouteActionHostRewriteSchemaDescription,
-													Optional:    true,
-												},
-												"auto_host_rewrite": {
-													Type:        schema.TypeBool,
-													Description: routeHTTPRouteActionAutoHostRewriteSchemaDescription,
-													Optional:    true,
-												},
-												rateLimitSchemaKey: rateLimit(),
-											},
-										},
 									},
-									"redirect_action": {
-										Type:        schema.TypeList,
-										Description: routeHTTPRedirectActionSchemaDescription,
-										MaxItems:    1,
+									"replace_host": {
+										Type:        schema.TypeString,
+										Description: routeHTTPRedirectActionReplaceHostSchemaDescription,
 										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"replace_scheme": {
-													Type:        schema.TypeString,
-													Description: routeHTTPRedirectActionReplaceSchemeSchemaDescription,
-													Optional:    true,
-												},
-												"replace_host": {
-													Type:        schema.TypeString,
-													Description: routeHTTPRedirectActionReplaceHostSchemaDescription,
-													Optional:    true,
-												},
-												"replace_port": {
-													Type:        schema.TypeInt,
-													Description: routeHTTPRedirectActionReplacePortSchemaDescription,
-													Optional:    true,
-												},
-												"remove_query": {
-													Type:        schema.TypeBool,
-													Description: routeHTTPRedirectActionRemoveQuerySchemaDescription,
-													Optional:    true,
-												},
-												"response_code": {
-													Type:             schema.TypeString,
-													Description:      routeHTTPRedirectActionResponseCodeSchemaDescription,
-													Default:          "moved_permanently",
-													Optional:         true,
-													DiffSuppressFunc: CaseInsensitive,
-												},
-												"replace_path": {
-													Type:        schema.TypeString,
-													Description: routeHTTPRedirectActionReplacePathSchemaDescription,
-													Optional:    true,
-												},
-												"replace_prefix": {
-													Type:        schema.TypeString,
-													Description: routeHTTPRedirectActionReplacePrefixSchemaDescription,
-													Optional:    true,
-												},
-											},
-										},
 									},
-									"direct_response_action": {
-										Type:        schema.TypeList,
-										Description: routeHTTPDirectResponseActionSchemaDescription,
-										MaxItems:    1,
+									"replace_port": {
+										Type:        schema.TypeInt,
+										Description: routeHTTPRedirectActionReplacePortSchemaDescription,
 										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"status": {
-													Type:         schema.TypeInt,
-													Description:  routeHTTPDirectResponseActionStatusSchemaDescription,
-													ValidateFunc: validation.IntBetween(100, 599),
-													Optional:     true,
-												},
-												"body": {
-													Type:        schema.TypeString,
-													Description: routeHTTPDirectResponseActionBodySchemaDescription,
-													Optional:    true,
-												},
-											},
-										},
 									},
-									"http_match": {
-										Type:        schema.TypeList,
-										Description: routeHTTPMatchSchemaDescription,
+									"remove_query": {
+										Type:        schema.TypeBool,
+										Description: routeHTTPRedirectActionRemoveQuerySchemaDescription,
+										Optional:    true,
+									},
+									"response_code": {
+										Type:             schema.TypeString,
+										Description:      routeHTTPRedirectActionResponseCodeSchemaDescription,
+										Default:          "moved_permanently",
+										Optional:         true,
+										DiffSuppressFunc: CaseInsensitive,
+									},
+									"replace_path": {
+										Type:        schema.TypeString,
+										Description: routeHTTPRedirectActionReplacePathSchemaDescription,
+										Optional:    true,
+									},
+									"replace_prefix": {
+										Type:        schema.TypeString,
+										Description: routeHTTPRedirectActionReplacePrefixSchemaDescription,
 										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"http_method": {
-													Type:        schema.TypeSet,
-													Description: routeHTTPMatchMethodSchemaDescription,
-													Optional:    true,
-													Elem:        &schema.Schema{Type: schema.TypeString},
-													Set:         schema.HashString,
-												},
-												"path": stringMatch(),
-											},
-										},
 									},
 								},
 							},
 						},
-						"grpc_route": {
+						"direct_response_action": {
 							Type:        schema.TypeList,
-							Description: routeGRPCRouteSchemaDescription,
+							Description: routeHTTPDirectResponseActionSchemaDescription,
 							MaxItems:    1,
 							Optional:    true,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
-									"grpc_match": {
-										Type:        schema.TypeList,
-										Description: routeGRPCRouteMatchSchemaDescription,
+									"status": {
+										Type:         schema.TypeInt,
+										Description:  routeHTTPDirectResponseActionStatusSchemaDescription,
+										ValidateFunc: validation.IntBetween(100, 599),
+										Optional:     true,
+									},
+									"body": {
+										Type:        schema.TypeString,
+										Description: routeHTTPDirectResponseActionBodySchemaDescription,
 										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"fqmn": stringMatch(),
-											},
-										},
 									},
-									"grpc_route_action": {
-										Type:        schema.TypeList,
-										Description: routeGRPCRouteActionSchemaDescription,
-										MaxItems:    1,
+								},
+							},
+						},
+						"http_match": {
+							Type:        schema.TypeList,
+							Description: routeHTTPMatchSchemaDescription,
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"http_method": {
+										Type:        schema.TypeSet,
+										Description: routeHTTPMatchMethodSchemaDescription,
 										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"backend_group_id": {
-													Type:        schema.TypeString,
-													Description: routeGRPCRouteActionBackendGroupIDSchemaDescription,
-													Required:    true,
-												},
-												"max_timeout": {
-													Type:             schema.TypeString,
-													Description:      routeGRPCRouteActionMaxTimeoutSchemaDescription,
-													Optional:         true,
-													ValidateFunc:     validateParsableValue(parseDuration),
-													DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
-												},
-												"idle_timeout": {
-													Type:             schema.TypeString,
-													Description:      routeGRPCRouteActionIdleTimeoutSchemaDescription,
-													Optional:         true,
-													ValidateFunc:     validateParsableValue(parseDuration),
-													DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
-												},
-												"host_rewrite": {
-													Type:        schema.TypeString,
-													Description: routeGRPCRouteActionHostRewriteSchemaDescription,
-													Optional:    true,
-												},
-												"auto_host_rewrite": {
-													Type:        schema.TypeBool,
-													Description: routeGRPCRouteActionAutoHostRewriteSchemaDescription,
-													Optional:    true,
-												},
-												rateLimitSchemaKey: rateLimit(),
-											},
-										},
+										Elem:        &schema.Schema{Type: schema.TypeString},
+										Set:         schema.HashString,
 									},
-									"grpc_status_response_action": {
-										Type:        schema.TypeList,
-										Description: routeGRPCStatusResponseActionSchemaDescription,
-										MaxItems:    1,
+									"path": stringMatch(),
+								},
+							},
+						},
+					},
+				},
+			},
+			"grpc_route": {
+				Type:        schema.TypeList,
+				Description: routeGRPCRouteSchemaDescription,
+				MaxItems:    1,
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"grpc_match": {
+							Type:        schema.TypeList,
+							Description: routeGRPCRouteMatchSchemaDescription,
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"fqmn": stringMatch(),
+								},
+							},
+						},
+						"grpc_route_action": {
+							Type:        schema.TypeList,
+							Description: routeGRPCRouteActionSchemaDescription,
+							MaxItems:    1,
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"backend_group_id": {
+										Type:        schema.TypeString,
+										Description: routeGRPCRouteActionBackendGroupIDSchemaDescription,
+										Required:    true,
+									},
+									"max_timeout": {
+										Type:             schema.TypeString,
+										Description:      routeGRPCRouteActionMaxTimeoutSchemaDescription,
+										Optional:         true,
+										ValidateFunc:     validateParsableValue(parseDuration),
+										DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
+									},
+									"idle_timeout": {
+										Type:             schema.TypeString,
+										Description:      routeGRPCRouteActionIdleTimeoutSchemaDescription,
+										Optional:         true,
+										ValidateFunc:     validateParsableValue(parseDuration),
+										DiffSuppressFunc: shouldSuppressDiffForTimeDuration,
+									},
+									"host_rewrite": {
+										Type:        schema.TypeString,
+										Description: routeGRPCRouteActionHostRewriteSchemaDescription,
 										Optional:    true,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"status": {
-													Type:             schema.TypeString,
-													Description:      routeGRPCStatusResponseActionStatusSchemaDescription,
-													Optional:         true,
-													DiffSuppressFunc: CaseInsensitive,
-												},
-											},
-										},
+									},
+									"auto_host_rewrite": {
+										Type:        schema.TypeBool,
+										Description: routeGRPCRouteActionAutoHostRewriteSchemaDescription,
+										Optional:    true,
+									},
+									rateLimitSchemaKey: rateLimit(),
+								},
+							},
+						},
+						"grpc_status_response_action": {
+							Type:        schema.TypeList,
+							Description: routeGRPCStatusResponseActionSchemaDescription,
+							MaxItems:    1,
+							Optional:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"status": {
+										Type:             schema.TypeString,
+										Description:      routeGRPCStatusResponseActionStatusSchemaDescription,
+										Optional:         true,
+										DiffSuppressFunc: CaseInsensitive,
 									},
 								},
 							},
 						},
-						"route_options": routeOptions(),
 					},
 				},
 			},
@@ -573,8 +592,10 @@ func resourceYandexALBVirtualHostRead(d *schema.ResourceData, meta interface{})
 		return err
 	}
 
-	if err := d.Set("route", routes); err != nil {
-		return err
+	if !isALBRoutesManagementExternal(d) {
+		if err := d.Set("route", routes); err != nil {
+			return err
+		}
 	}
 
 	if err := d.Set("route_options", ro); err != nil {
@@ -602,6 +623,23 @@ func resourceYandexALBVirtualHostUpdate(d *schema.ResourceData, meta interface{}
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
+	if isALBRoutesManagementExternal(d) {
+		// The update replaces the whole list of routes, so the routes added by
+		// yandex_alb_virtual_host_route resources are read and sent back under the virtual host lock.
+		mutexKV := globallock.GetMutexKV()
+		mutexKV.Lock(d.Id())
+		defer mutexKV.Unlock(d.Id())
+
+		virtualHost, err := config.sdk.ApplicationLoadBalancer().VirtualHost().Get(ctx, &apploadbalancer.GetVirtualHostRequest{
+			HttpRouterId:    req.HttpRouterId,
+			VirtualHostName: req.VirtualHostName,
+		})
+		if err != nil {
+			return fmt.Errorf("Error while reading routes of Application Virtual Host %q: %w", d.Id(), err)
+		}
+		req.Routes = virtualHost.GetRoutes()
+	}
+
 	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().VirtualHost().Update(ctx, req))
 	if err != nil {
 		return fmt.Errorf("Error while requesting API to update Application Virtual Host %q: %w", d.Id(), err)
@@ -663,6 +701,21 @@ func buildALBVirtualHostUpdateRequest(d *schema.ResourceData) (*apploadbalancer.
 	return req, nil
 }
 
+func resourceYandexALBVirtualHostCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("routes_management").(string) != albRoutesManagementExternal {
+		return nil
+	}
+
+	if len(d.Get("route").([]interface{})) > 0 {
+		return fmt.Errorf("%q can't be used together with routes_management = %q", "route", albRoutesManagementExternal)
+	}
+	return nil
+}
+
+func isALBRoutesManagementExternal(d *schema.ResourceData) bool {
+	return d.Get("routes_management").(string) == albRoutesManagementExternal
+}
+
 func resourceYandexALBVirtualHostDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -0,0 +1,360 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
+)
+
+func resourceYandexALBVirtualHostRoute() *schema.Resource {
+	routeSchema := albVirtualHostRouteResource().Schema
+
+	routeSchema["name"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: routeNameSchemaDescription,
+		Required:    true,
+		ForceNew:    true,
+	}
+
+	routeSchema["virtual_host_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "ID of the virtual host to add the route to, in the form `<http_router_id>/<virtual_host_name>`.",
+		Required:    true,
+		ForceNew:    true,
+	}
+
+	routeSchema["before"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Description:   "Name of the route this route is placed right before.",
+		Optional:      true,
+		ConflictsWith: []string{"after"},
+	}
+
+	routeSchema["after"] = &schema.Schema{
+		Type:          schema.TypeString,
+		Description:   "Name of the route this route is placed right after.",
+		Optional:      true,
+		ConflictsWith: []string{"before"},
+	}
+
+	return &schema.Resource{
+		Description: "Adds a route to an application load balancer virtual host. Use it with `routes_management = \"external\"` of the `yandex_alb_virtual_host`, so several configurations can share one virtual host. For more information, see [the official documentation](https://yandex.cloud/docs/application-load-balancer/concepts/http-router).\n\n" +
+			"Routes are matched in order. A route is placed right after the `after` route, right before the `before` route, or at the end of the list if neither is set. Routes placed next to the same route, as well as the routes placed at the end, are ordered by name, so the resulting order doesn't depend on the order the routes are added in. If a route is moved away from its place, it's put back on the next apply.\n\n" +
+			"~> Routes ordered by name can end up after a catch-all route like http `/`. Use `before` with the catch-all route to keep a route ahead of it.\n",
+
+		CreateContext: resourceYandexALBVirtualHostRouteCreate,
+		ReadContext:   resourceYandexALBVirtualHostRouteRead,
+		UpdateContext: resourceYandexALBVirtualHostRouteUpdate,
+		DeleteContext: resourceYandexALBVirtualHostRouteDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexALBVirtualHostRouteImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexALBVirtualHostDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexALBVirtualHostDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexALBVirtualHostDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: routeSchema,
+	}
+}
+
+func resourceYandexALBVirtualHostRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	vhID := d.Get("virtual_host_id").(string)
+	route, err := expandALBRoute(d, "")
+	if err != nil {
+		return diag.Errorf("Error expanding route while adding it to Application Virtual Host %q: %s", vhID, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	log.Printf("[DEBUG] Adding route %q to Application Virtual Host %q", route.Name, vhID)
+
+	err = updateALBVirtualHostRoutes(ctx, config, vhID, func(routes []*apploadbalancer.Route) ([]*apploadbalancer.Route, error) {
+		if findALBRoute(routes, route.Name) >= 0 {
+			return nil, fmt.Errorf("route %q already exists in Application Virtual Host %q", route.Name, vhID)
+		}
+		return placeALBRoute(routes, route, d.Get("before").(string), d.Get("after").(string))
+	})
+	if err != nil {
+		return diag.Errorf("Error while adding route %q to Application Virtual Host %q: %s", route.Name, vhID, err)
+	}
+
+	d.SetId(makeALBVirtualHostRouteID(vhID, route.Name))
+
+	return resourceYandexALBVirtualHostRouteRead(ctx, d, meta)
+}
+
+func resourceYandexALBVirtualHostRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	routerID, vhName, name, err := parseALBVirtualHostRouteID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	vhID := makeALBVirtualHostID(routerID, vhName)
+
+	virtualHost, err := config.sdk.ApplicationLoadBalancer().VirtualHost().Get(ctx, &apploadbalancer.GetVirtualHostRequest{
+		HttpRouterId:    routerID,
+		VirtualHostName: vhName,
+	})
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("Application Virtual Host %q", vhID)))
+	}
+
+	routes := virtualHost.GetRoutes()
+	idx := findALBRoute(routes, name)
+	if idx < 0 {
+		log.Printf("[WARN] Route %q is not found in Application Virtual Host %q, removing route from state", name, vhID)
+		d.SetId("")
+		return nil
+	}
+
+	flRoutes, err := flattenALBRoutes(routes[idx : idx+1])
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("virtual_host_id", vhID)
+	for _, key := range []string{"name", "http_route", "grpc_route", "route_options"} {
+		if err := d.Set(key, flRoutes[0][key]); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	// clearing the anchors makes the next plan put the route back to its place
+	before, after := d.Get("before").(string), d.Get("after").(string)
+	if !isALBRoutePlaced(routes, name, before, after) {
+		log.Printf("[WARN] Route %q of Application Virtual Host %q is not placed according to before %q and after %q", name, vhID, before, after)
+		d.Set("before", "")
+		d.Set("after", "")
+	}
+
+	return nil
+}
+
+func resourceYandexALBVirtualHostRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	vhID := d.Get("virtual_host_id").(string)
+	route, err := expandALBRoute(d, "")
+	if err != nil {
+		return diag.Errorf("Error expanding route while updating it in Application Virtual Host %q: %s", vhID, err)
+	}
+	before, after := d.Get("before").(string), d.Get("after").(string)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	log.Printf("[DEBUG] Updating route %q of Application Virtual Host %q", route.Name, vhID)
+
+	err = updateALBVirtualHostRoutes(ctx, config, vhID, func(routes []*apploadbalancer.Route) ([]*apploadbalancer.Route, error) {
+		idx := findALBRoute(routes, route.Name)
+		if idx < 0 {
+			return nil, fmt.Errorf("route %q is not found in Application Virtual Host %q", route.Name, vhID)
+		}
+		if isALBRoutePlaced(routes, route.Name, before, after) {
+			routes[idx] = route
+			return routes, nil
+		}
+		return placeALBRoute(routes, route, before, after)
+	})
+	if err != nil {
+		return diag.Errorf("Error while updating route %q of Application Virtual Host %q: %s", route.Name, vhID, err)
+	}
+
+	return resourceYandexALBVirtualHostRouteRead(ctx, d, meta)
+}
+
+func resourceYandexALBVirtualHostRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	routerID, vhName, name, err := parseALBVirtualHostRouteID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+	vhID := makeALBVirtualHostID(routerID, vhName)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(vhID)
+	defer mutexKV.Unlock(vhID)
+
+	log.Printf("[DEBUG] Removing route %q from Application Virtual Host %q", name, vhID)
+
+	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().VirtualHost().RemoveRoute(ctx, &apploadbalancer.RemoveRouteRequest{
+		HttpRouterId:    routerID,
+		VirtualHostName: vhName,
+		RouteName:       name,
+	}))
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("Route %q of Application Virtual Host %q", name, vhID)))
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		return diag.Errorf("Error while waiting operation to remove route %q from Application Virtual Host %q: %s", name, vhID, err)
+	}
+
+	log.Printf("[DEBUG] Finished removing route %q from Application Virtual Host %q", name, vhID)
+	return nil
+}
+
+func resourceYandexALBVirtualHostRouteImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, _, err := parseALBVirtualHostRouteID(d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// updateALBVirtualHostRoutes replaces the routes of the virtual host with the result of modify.
+// There is no API call to add a route at a position, so the whole list is read and updated under the virtual host lock.
+func updateALBVirtualHostRoutes(ctx context.Context, config *Config, vhID string, modify func([]*apploadbalancer.Route) ([]*apploadbalancer.Route, error)) error {
+	routerID, vhName, err := parseALBVirtualHostID(vhID)
+	if err != nil {
+		return err
+	}
+
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(vhID)
+	defer mutexKV.Unlock(vhID)
+
+	virtualHost, err := config.sdk.ApplicationLoadBalancer().VirtualHost().Get(ctx, &apploadbalancer.GetVirtualHostRequest{
+		HttpRouterId:    routerID,
+		VirtualHostName: vhName,
+	})
+	if err != nil {
+		return err
+	}
+
+	routes, err := modify(virtualHost.GetRoutes())
+	if err != nil {
+		return err
+	}
+
+	op, err := config.sdk.WrapOperation(config.sdk.ApplicationLoadBalancer().VirtualHost().Update(ctx, &apploadbalancer.UpdateVirtualHostRequest{
+		HttpRouterId:          routerID,
+		VirtualHostName:       vhName,
+		Authority:             virtualHost.GetAuthority(),
+		Routes:                routes,
+		ModifyRequestHeaders:  virtualHost.GetModifyRequestHeaders(),
+		ModifyResponseHeaders: virtualHost.GetModifyResponseHeaders(),
+		RouteOptions:          virtualHost.GetRouteOptions(),
+		RateLimit:             virtualHost.GetRateLimit(),
+	}))
+	if err != nil {
+		return err
+	}
+
+	return op.Wait(ctx)
+}
+
+func findALBRoute(routes []*apploadbalancer.Route, name string) int {
+	for i, r := range routes {
+		if r.GetName() == name {
+			return i
+		}
+	}
+	return -1
+}
+
+// placeALBRoute puts the route right after the `after` route, right before the `before` route or at the end.
+// Neighbours of the same anchor and the routes at the end are kept ordered by name, so the order doesn't depend on the order the routes are placed in.
+func placeALBRoute(routes []*apploadbalancer.Route, route *apploadbalancer.Route, before, after string) ([]*apploadbalancer.Route, error) {
+	rest := make([]*apploadbalancer.Route, 0, len(routes)+1)
+	for _, r := range routes {
+		if r.GetName() != route.GetName() {
+			rest = append(rest, r)
+		}
+	}
+
+	var pos int
+	switch {
+	case after != "":
+		idx := findALBRoute(rest, after)
+		if idx < 0 {
+			return nil, fmt.Errorf("route %q to place route %q after is not found", after, route.GetName())
+		}
+		pos = idx + 1
+		for pos < len(rest) && rest[pos].GetName() < route.GetName() {
+			pos++
+		}
+	case before != "":
+		idx := findALBRoute(rest, before)
+		if idx < 0 {
+			return nil, fmt.Errorf("route %q to place route %q before is not found", before, route.GetName())
+		}
+		pos = idx
+		for pos > 0 && rest[pos-1].GetName() > route.GetName() {
+			pos--
+		}
+	default:
+		pos = len(rest)
+		for pos > 0 && rest[pos-1].GetName() > route.GetName() {
+			pos--
+		}
+	}
+
+	rest = append(rest, nil)
+	copy(rest[pos+1:], rest[pos:])
+	rest[pos] = route
+	return rest, nil
+}
+
+// isALBRoutePlaced reports whether the route is after the `after` route and before the `before` route.
+func isALBRoutePlaced(routes []*apploadbalancer.Route, name, before, after string) bool {
+	idx := findALBRoute(routes, name)
+	if idx < 0 {
+		return false
+	}
+	if after != "" {
+		if anchor := findALBRoute(routes, after); anchor < 0 || anchor > idx {
+			return false
+		}
+	}
+	if before != "" {
+		if anchor := findALBRoute(routes, before); anchor < 0 || anchor < idx {
+			return false
+		}
+	}
+	return true
+}
+
+func makeALBVirtualHostID(routerID, vhName string) string {
+	return routerID + "/" + vhName
+}
+
+func parseALBVirtualHostID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid virtual host ID %q, expected format <http_router_id>/<virtual_host_name>", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func makeALBVirtualHostRouteID(vhID, name string) string {
+	return vhID + "/" + name
+}
+
+func parseALBVirtualHostRouteID(id string) (string, string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 3 || parts[0] == "" || parts[1] == "" || parts[2] == "" {
+		return "", "", "", fmt.Errorf("invalid route ID %q, expected format <http_router_id>/<virtual_host_name>/<route_name>", id)
+	}
+	return parts[0], parts[1], parts[2], nil
+}
@@ -0,0 +1,281 @@
+package yandex
+
+import (
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/apploadbalancer/v1"
+)
+
+func TestParseALBVirtualHostRouteID(t *testing.T) {
+	routerID, vhName, name, err := parseALBVirtualHostRouteID(makeALBVirtualHostRouteID(makeALBVirtualHostID("ds7", "vh"), "api"))
+	if err != nil || routerID != "ds7" || vhName != "vh" || name != "api" {
+		t.Fatalf("Unexpected result of parsing: %q, %q, %q, %v", routerID, vhName, name, err)
+	}
+
+	for _, id := range []string{"", "ds7", "ds7/vh", "ds7/vh/", "ds7//api", "/vh/api", "ds7/vh/api/extra"} {
+		if _, _, _, err := parseALBVirtualHostRouteID(id); err == nil {
+			t.Errorf("Parsing of %q should fail", id)
+		}
+	}
+}
+
+func TestPlaceALBRoute(t *testing.T) {
+	routes := func(names ...string) []*apploadbalancer.Route {
+		var result []*apploadbalancer.Route
+		for _, name := range names {
+			result = append(result, &apploadbalancer.Route{Name: name})
+		}
+		return result
+	}
+	names := func(routes []*apploadbalancer.Route) []string {
+		var result []string
+		for _, r := range routes {
+			result = append(result, r.GetName())
+		}
+		return result
+	}
+
+	cases := []struct {
+		name     string
+		routes   []string
+		route    string
+		before   string
+		after    string
+		expected []string
+		err      bool
+	}{
+		{
+			name:     "at the end",
+			routes:   []string{"a", "b"},
+			route:    "c",
+			expected: []string{"a", "b", "c"},
+		},
+		{
+			name:     "at the end ordered by name",
+			routes:   []string{"a", "d"},
+			route:    "c",
+			expected: []string{"a", "c", "d"},
+		},
+		{
+			name:     "after",
+			routes:   []string{"a", "y", "z"},
+			route:    "x",
+			after:    "a",
+			expected: []string{"a", "x", "y", "z"},
+		},
+		{
+			name:     "after ordered by name",
+			routes:   []string{"a", "b", "z"},
+			route:    "c",
+			after:    "a",
+			expected: []string{"a", "b", "c", "z"},
+		},
+		{
+			name:     "before",
+			routes:   []string{"a", "b", "c"},
+			route:    "x",
+			before:   "c",
+			expected: []string{"a", "b", "x", "c"},
+		},
+		{
+			name:     "before ordered by name",
+			routes:   []string{"a", "y", "z"},
+			route:    "b",
+			before:   "z",
+			expected: []string{"a", "b", "y", "z"},
+		},
+		{
+			name:     "moves existing route",
+			routes:   []string{"x", "a", "b"},
+			route:    "x",
+			after:    "b",
+			expected: []string{"a", "b", "x"},
+		},
+		{
+			name:   "missing anchor",
+			routes: []string{"a"},
+			route:  "x",
+			after:  "b",
+			err:    true,
+		},
+		{
+			name:   "anchored to itself",
+			routes: []string{"a", "x"},
+			route:  "x",
+			before: "x",
+			err:    true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result, err := placeALBRoute(routes(tc.routes...), &apploadbalancer.Route{Name: tc.route}, tc.before, tc.after)
+			if tc.err {
+				if err == nil {
+					t.Fatalf("Placing should fail, got %v", names(result))
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if !reflect.DeepEqual(names(result), tc.expected) {
+				t.Fatalf("Expected %v, got %v", tc.expected, names(result))
+			}
+			if !isALBRoutePlaced(result, tc.route, tc.before, tc.after) {
+				t.Fatalf("Route %q is not placed in %v", tc.route, names(result))
+			}
+		})
+	}
+}
+
+func TestPlaceALBRouteOrderIsDeterministic(t *testing.T) {
+	type placement struct {
+		name, before, after string
+	}
+	placements := []placement{
+		{name: "default"},
+		{name: "api-v2", before: "default"},
+		{name: "api-v1", before: "default"},
+		{name: "static", after: "api-v2"},
+		{name: "health", after: "api-v2"},
+	}
+
+	var orders [][]string
+	for _, perm := range [][]int{{0, 1, 2, 3, 4}, {0, 2, 1, 4, 3}} {
+		var routes []*apploadbalancer.Route
+		for _, i := range perm {
+			p := placements[i]
+			var err error
+			routes, err = placeALBRoute(routes, &apploadbalancer.Route{Name: p.name}, p.before, p.after)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+		}
+
+		var order []string
+		for _, r := range routes {
+			order = append(order, r.GetName())
+		}
+		orders = append(orders, order)
+	}
+
+	if !reflect.DeepEqual(orders[0], orders[1]) {
+		t.Fatalf("Order depends on the order the routes are placed in: %v and %v", orders[0], orders[1])
+	}
+}
+
+func TestAccALBVirtualHostRoute_basic(t *testing.T) {
+	t.Parallel()
+
+	var vh apploadbalancer.VirtualHost
+	routerName := acctest.RandomWithPrefix("tf-router")
+	vhName := acctest.RandomWithPrefix("tf-virtual-host")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckALBVirtualHostDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccALBVirtualHostRoute_basic(routerName, vhName, 418),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckALBVirtualHostExists(albVHResource, &vh),
+					// routes of the route resources are not tracked by the virtual host
+					resource.TestCheckResourceAttr(albVHResource, "route.#", "0"),
+					testAccCheckALBVirtualHostRouteOrder(&vh, "api", "static", "default"),
+					resource.TestCheckResourceAttr("yandex_alb_virtual_host_route.api", "http_route.0.direct_response_action.0.status", "418"),
+				),
+			},
+			{
+				Config: testAccALBVirtualHostRoute_basic(routerName, vhName, 404),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckALBVirtualHostExists(albVHResource, &vh),
+					testAccCheckALBVirtualHostRouteOrder(&vh, "api", "static", "default"),
+					resource.TestCheckResourceAttr("yandex_alb_virtual_host_route.api", "http_route.0.direct_response_action.0.status", "404"),
+				),
+			},
+			{
+				ResourceName:            "yandex_alb_virtual_host_route.api",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"before", "after"},
+			},
+		},
+	})
+}
+
+func testAccCheckALBVirtualHostRouteOrder(vh *apploadbalancer.VirtualHost, names ...string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		var order []string
+		for _, r := range vh.GetRoutes() {
+			order = append(order, r.GetName())
+		}
+		if !reflect.DeepEqual(order, names) {
+			return fmt.Errorf("expected routes %v, got %v", names, order)
+		}
+		return nil
+	}
+}
+
+//revive:disable:var-naming
+func testAccALBVirtualHostRoute_basic(routerName, vhName string, status int) string {
+	return testAccALBGeneralHTTPRouterTemplate(routerName, "") + fmt.Sprintf(`
+resource "yandex_alb_virtual_host" "test-vh" {
+  http_router_id    = yandex_alb_http_router.test-router.id
+  name              = "%s"
+  routes_management = "external"
+}
+
+resource "yandex_alb_virtual_host_route" "default" {
+  virtual_host_id = yandex_alb_virtual_host.test-vh.id
+  name            = "default"
+
+  http_route {
+    direct_response_action {
+      status = 404
+    }
+  }
+}
+
+resource "yandex_alb_virtual_host_route" "api" {
+  virtual_host_id = yandex_alb_virtual_host.test-vh.id
+  name            = "api"
+  before          = yandex_alb_virtual_host_route.default.name
+
+  http_route {
+    http_match {
+      path {
+        prefix = "/api/"
+      }
+    }
+    direct_response_action {
+      status = %d
+    }
+  }
+}
+
+resource "yandex_alb_virtual_host_route" "static" {
+  virtual_host_id = yandex_alb_virtual_host.test-vh.id
+  name            = "static"
+  after           = yandex_alb_virtual_host_route.api.name
+
+  http_route {
+    http_match {
+      path {
+        prefix = "/static/"
+      }
+    }
+    direct_response_action {
+      status = 200
+      body   = "static"
+    }
+  }
+}
+`, vhName, status)
+}
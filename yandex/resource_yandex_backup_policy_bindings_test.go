@@ -23,9 +23,9 @@ func TestAccResourceBackupPolicyBindingsBasic(t *testing.T) {
 
 	var application backuppb.PolicyApplication
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckBackupPolicyBindingsDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckBackupPolicyBindingsDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: config,
@@ -0,0 +1,217 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
+)
+
+const yandexComputeDiskAttachmentDefaultTimeout = 5 * time.Minute
+
+func resourceYandexComputeDiskAttachment() *schema.Resource {
+	return &schema.Resource{
+		Description: "Attaches a secondary disk to a VM instance. The disk is attached and detached without stopping the instance. For more information, see [the official documentation](https://yandex.cloud/docs/compute/concepts/disk#attach-detach).\n\n" +
+			"~> Set `external_secondary_disks = true` on the `yandex_compute_instance`, otherwise the instance detaches the disks attached with this resource. Don't declare the same disk in both places.\n",
+
+		CreateContext: resourceYandexComputeDiskAttachmentCreate,
+		ReadContext:   resourceYandexComputeDiskAttachmentRead,
+		DeleteContext: resourceYandexComputeDiskAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexComputeDiskAttachmentImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexComputeDiskAttachmentDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexComputeDiskAttachmentDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the instance to attach the disk to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"disk_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the disk to attach.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"device_name": {
+				Type:        schema.TypeString,
+				Description: "Name that can be used to access an attached disk under `/dev/disk/by-id/`. Generated if not set.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+
+			"mode": {
+				Type:         schema.TypeString,
+				Description:  "Type of access to the disk resource. By default, a disk is attached in `READ_WRITE` mode.",
+				Optional:     true,
+				Default:      "READ_WRITE",
+				ForceNew:     true,
+				ValidateFunc: validation.StringInSlice([]string{"READ_WRITE", "READ_ONLY"}, false),
+			},
+
+			"auto_delete": {
+				Type:        schema.TypeBool,
+				Description: "Whether the disk is auto-deleted when the instance is deleted. The default value is `false`.",
+				Optional:    true,
+				Default:     false,
+				ForceNew:    true,
+			},
+		},
+	}
+}
+
+func makeComputeDiskAttachmentID(instanceID, diskID string) string {
+	return instanceID + "/" + diskID
+}
+
+func parseComputeDiskAttachmentID(id string) (string, string, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" || parts[1] == "" {
+		return "", "", fmt.Errorf("invalid disk attachment ID %q, expected format is instance_id/disk_id", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceYandexComputeDiskAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	instanceID := d.Get("instance_id").(string)
+	diskSpec, err := expandSecondaryDiskSpec(map[string]interface{}{
+		"disk_id":     d.Get("disk_id"),
+		"device_name": d.Get("device_name"),
+		"mode":        d.Get("mode"),
+		"auto_delete": d.Get("auto_delete"),
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	// Operations with disks of the same instance can't be run concurrently
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(instanceID)
+	defer mutexKV.Unlock(instanceID)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	log.Printf("[DEBUG] Attaching Disk %q to Instance %q", diskSpec.GetDiskId(), instanceID)
+
+	op, err := config.sdk.WrapOperation(config.sdk.Compute().Instance().AttachDisk(ctx, &compute.AttachInstanceDiskRequest{
+		InstanceId:       instanceID,
+		AttachedDiskSpec: diskSpec,
+	}))
+	if err != nil {
+		return diag.Errorf("Error while requesting API to attach Disk %q to Instance %q: %s", diskSpec.GetDiskId(), instanceID, err)
+	}
+
+	d.SetId(makeComputeDiskAttachmentID(instanceID, diskSpec.GetDiskId()))
+
+	if err = op.Wait(ctx); err != nil {
+		d.SetId("")
+		return diag.Errorf("Error while waiting operation to attach Disk %q to Instance %q: %s", diskSpec.GetDiskId(), instanceID, err)
+	}
+
+	return resourceYandexComputeDiskAttachmentRead(ctx, d, meta)
+}
+
+func resourceYandexComputeDiskAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	instanceID, diskID, err := parseComputeDiskAttachmentID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instance, err := config.sdk.Compute().Instance().Get(ctx, &compute.GetInstanceRequest{
+		InstanceId: instanceID,
+	})
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("Instance %q", instanceID)))
+	}
+
+	attachedDisk := findInstanceSecondaryDisk(instance, diskID)
+	if attachedDisk == nil {
+		log.Printf("[WARN] Disk %q is not attached to Instance %q, removing attachment from state", diskID, instanceID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("disk_id", attachedDisk.GetDiskId())
+	d.Set("device_name", attachedDisk.GetDeviceName())
+	d.Set("mode", attachedDisk.GetMode().String())
+	d.Set("auto_delete", attachedDisk.GetAutoDelete())
+
+	return nil
+}
+
+func resourceYandexComputeDiskAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	instanceID, diskID, err := parseComputeDiskAttachmentID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(instanceID)
+	defer mutexKV.Unlock(instanceID)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	log.Printf("[DEBUG] Detaching Disk %q from Instance %q", diskID, instanceID)
+
+	op, err := config.sdk.WrapOperation(config.sdk.Compute().Instance().DetachDisk(ctx, &compute.DetachInstanceDiskRequest{
+		InstanceId: instanceID,
+		Disk: &compute.DetachInstanceDiskRequest_DiskId{
+			DiskId: diskID,
+		},
+	}))
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("Disk %q attached to Instance %q", diskID, instanceID)))
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		return diag.Errorf("Error while waiting operation to detach Disk %q from Instance %q: %s", diskID, instanceID, err)
+	}
+
+	log.Printf("[DEBUG] Finished detaching Disk %q from Instance %q", diskID, instanceID)
+	return nil
+}
+
+func resourceYandexComputeDiskAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := parseComputeDiskAttachmentID(d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func findInstanceSecondaryDisk(instance *compute.Instance, diskID string) *compute.AttachedDisk {
+	for _, disk := range instance.GetSecondaryDisks() {
+		if disk.GetDiskId() == diskID {
+			return disk
+		}
+	}
+	return nil
+}
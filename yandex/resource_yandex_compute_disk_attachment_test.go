@@ -0,0 +1,145 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+const computeDiskAttachmentResource = "yandex_compute_disk_attachment.foobar"
+
+func TestParseComputeDiskAttachmentID(t *testing.T) {
+	instanceID, diskID, err := parseComputeDiskAttachmentID(makeComputeDiskAttachmentID("fhm1", "fhm2"))
+	if err != nil || instanceID != "fhm1" || diskID != "fhm2" {
+		t.Fatalf("Unexpected result of parsing: %q, %q, %v", instanceID, diskID, err)
+	}
+
+	for _, id := range []string{"", "fhm1", "fhm1/", "/fhm2", "fhm1/fhm2/fhm3"} {
+		if _, _, err := parseComputeDiskAttachmentID(id); err == nil {
+			t.Errorf("Parsing of %q should fail", id)
+		}
+	}
+}
+
+func TestAccComputeDiskAttachment_basic(t *testing.T) {
+	t.Parallel()
+
+	var instance compute.Instance
+	instanceName := fmt.Sprintf("instance-test-%s", acctest.RandString(10))
+	diskName := fmt.Sprintf("disk-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeDiskAttachment_basic(diskName, instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(instanceResource, &instance),
+					testAccCheckComputeDiskAttachmentAttached(&instance, diskName),
+					resource.TestCheckResourceAttr(computeDiskAttachmentResource, "device_name", "data"),
+					resource.TestCheckResourceAttr(computeDiskAttachmentResource, "mode", "READ_WRITE"),
+					// the disk is managed by the attachment, so the instance doesn't see it
+					resource.TestCheckResourceAttr(instanceResource, "secondary_disk.#", "0"),
+				),
+			},
+			{
+				ResourceName:      computeDiskAttachmentResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				// the instance without the attachment shouldn't have any secondary disks
+				Config: testAccComputeDiskAttachment_detached(diskName, instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(instanceResource, &instance),
+					resource.TestCheckResourceAttr(instanceResource, "secondary_disk.#", "0"),
+					func(s *terraform.State) error {
+						if len(instance.SecondaryDisks) != 0 {
+							return fmt.Errorf("disk should be detached from the instance, got %v", instance.SecondaryDisks)
+						}
+						return nil
+					},
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeDiskAttachmentAttached(instance *compute.Instance, diskName string) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources["yandex_compute_disk.foobar"]
+		if !ok {
+			return fmt.Errorf("Not found disk %s", diskName)
+		}
+
+		if findInstanceSecondaryDisk(instance, rs.Primary.ID) == nil {
+			return fmt.Errorf("Disk %s is not attached to the instance", diskName)
+		}
+		return nil
+	}
+}
+
+func testAccComputeDiskAttachment_instance(disk, instance string) string {
+	return fmt.Sprintf(`
+data "yandex_compute_image" "ubuntu" {
+  family = "ubuntu-1804-lts"
+}
+
+resource "yandex_compute_disk" "foobar" {
+  name = "%s"
+  size = 10
+  zone = "ru-central1-a"
+}
+
+resource "yandex_compute_instance" "foobar" {
+  name        = "%s"
+  zone        = "ru-central1-a"
+  platform_id = "standard-v2"
+
+  external_secondary_disks = true
+
+  resources {
+    cores  = 2
+    memory = 2
+  }
+
+  boot_disk {
+    initialize_params {
+      image_id = "${data.yandex_compute_image.ubuntu.id}"
+    }
+  }
+
+  network_interface {
+    subnet_id = "${yandex_vpc_subnet.inst-test-subnet.id}"
+  }
+}
+
+resource "yandex_vpc_network" "inst-test-network" {}
+
+resource "yandex_vpc_subnet" "inst-test-subnet" {
+  zone           = "ru-central1-a"
+  network_id     = "${yandex_vpc_network.inst-test-network.id}"
+  v4_cidr_blocks = ["192.168.0.0/24"]
+}
+`, disk, instance)
+}
+
+func testAccComputeDiskAttachment_basic(disk, instance string) string {
+	return testAccComputeDiskAttachment_instance(disk, instance) + `
+resource "yandex_compute_disk_attachment" "foobar" {
+  instance_id = "${yandex_compute_instance.foobar.id}"
+  disk_id     = "${yandex_compute_disk.foobar.id}"
+  device_name = "data"
+}
+`
+}
+
+func testAccComputeDiskAttachment_detached(disk, instance string) string {
+	return testAccComputeDiskAttachment_instance(disk, instance)
+}
@@ -0,0 +1,395 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"slices"
+	"strings"
+	"time"
+
+	"google.golang.org/grpc/codes"
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+)
+
+const yandexComputeHostGroupDefaultTimeout = 15 * time.Minute
+
+// hostAffinityKeyHostGroupID is the reserved key of host affinity rules which places instances to host groups.
+const hostAffinityKeyHostGroupID = "yc.hostGroupId"
+
+func resourceYandexComputeHostGroup() *schema.Resource {
+	return &schema.Resource{
+		Description: "A group of dedicated hosts, which run only the VM instances of your folder. The instances are placed to the hosts of the group with the `host_affinity_rules` of their `placement_policy`. For more information, see [the official documentation](https://yandex.cloud/docs/compute/concepts/dedicated-host).\n",
+
+		CreateContext: resourceYandexComputeHostGroupCreate,
+		ReadContext:   resourceYandexComputeHostGroupRead,
+		UpdateContext: resourceYandexComputeHostGroupUpdate,
+		DeleteContext: resourceYandexComputeHostGroupDelete,
+
+		SchemaVersion: 0,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexComputeHostGroupDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexComputeHostGroupDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexComputeHostGroupDefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"folder_id": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["folder_id"],
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["created_at"],
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["name"],
+				Optional:    true,
+				Default:     "",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["description"],
+				Optional:    true,
+				Default:     "",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Description: common.ResourceDescriptions["labels"],
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["zone"],
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"type_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the host type, it defines the resources provided by each host of the group, e.g. `intel-6338-c108-m704-n3200x6`.",
+				Required:    true,
+				ForceNew:    true,
+			},
+			"maintenance_policy": {
+				Type:         schema.TypeString,
+				Description:  "Behavior on maintenance events. Can be: `unspecified`, `migrate`, `restart`. The default is `unspecified`.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"unspecified", "migrate", "restart"}, false),
+			},
+			"scale_policy": {
+				Type:        schema.TypeList,
+				Description: "The scaling policy of the host group.",
+				MaxItems:    1,
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"fixed_scale": {
+							Type:        schema.TypeList,
+							Description: "The fixed scaling policy of the host group.",
+							MaxItems:    1,
+							Required:    true,
+							Elem: &schema.Resource{
+								Schema: map[string]*schema.Schema{
+									"size": {
+										Type:         schema.TypeInt,
+										Description:  "The number of hosts in the group.",
+										Required:     true,
+										ValidateFunc: validation.IntAtLeast(1),
+									},
+								},
+							},
+						},
+					},
+				},
+			},
+			"status": {
+				Type:        schema.TypeString,
+				Description: "The status of the host group.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceYandexComputeHostGroupCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return diag.Errorf("Error getting zone while creating host group: %s", err)
+	}
+
+	folderID, err := getFolderID(d, config)
+	if err != nil {
+		return diag.Errorf("Error getting folder ID while creating host group: %s", err)
+	}
+
+	labels, err := expandLabels(d.Get("labels"))
+	if err != nil {
+		return diag.Errorf("Error expanding labels while creating host group: %s", err)
+	}
+
+	maintenancePolicy, err := expandMaintenancePolicy(d)
+	if err != nil {
+		return diag.Errorf("Error expanding maintenance policy while creating host group: %s", err)
+	}
+
+	req := compute.CreateHostGroupRequest{
+		FolderId:          folderID,
+		Name:              d.Get("name").(string),
+		Description:       d.Get("description").(string),
+		Labels:            labels,
+		ZoneId:            zone,
+		TypeId:            d.Get("type_id").(string),
+		MaintenancePolicy: maintenancePolicy,
+		ScalePolicy:       expandHostGroupScalePolicy(d),
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	op, err := config.sdk.WrapOperation(config.sdk.Compute().HostGroup().Create(ctx, &req))
+	if err != nil {
+		return diag.Errorf("Error while requesting API for create host group: %s", err)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return diag.Errorf("Error while get host group create operation metadata: %s", err)
+	}
+
+	md, ok := protoMetadata.(*compute.CreateHostGroupMetadata)
+	if !ok {
+		return diag.Errorf("could not get host group ID from create operation metadata")
+	}
+
+	d.SetId(md.GetHostGroupId())
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return diag.Errorf("Error while waiting operation to create host group: %s", err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return diag.Errorf("Host group creation failed: %s", err)
+	}
+
+	return resourceYandexComputeHostGroupRead(ctx, d, meta)
+}
+
+func resourceYandexComputeHostGroupRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	hostGroup, err := config.sdk.Compute().HostGroup().Get(ctx, &compute.GetHostGroupRequest{
+		HostGroupId: d.Id(),
+	})
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("Host group %q", d.Id())))
+	}
+
+	d.Set("folder_id", hostGroup.FolderId)
+	d.Set("created_at", getTimestamp(hostGroup.CreatedAt))
+	d.Set("name", hostGroup.Name)
+	d.Set("description", hostGroup.Description)
+	d.Set("zone", hostGroup.ZoneId)
+	d.Set("type_id", hostGroup.TypeId)
+	d.Set("status", strings.ToLower(hostGroup.Status.String()))
+
+	if hostGroup.MaintenancePolicy != compute.MaintenancePolicy_MAINTENANCE_POLICY_UNSPECIFIED {
+		d.Set("maintenance_policy", strings.ToLower(hostGroup.MaintenancePolicy.String()))
+	}
+
+	if err := d.Set("labels", hostGroup.Labels); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("scale_policy", flattenHostGroupScalePolicy(hostGroup.ScalePolicy)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceYandexComputeHostGroupUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var resourceComputeHostGroupUpdateFieldsMap = map[string]string{
+		"name":               "name",
+		"description":        "description",
+		"labels":             "labels",
+		"maintenance_policy": "maintenance_policy",
+		"scale_policy":       "scale_policy",
+	}
+
+	d.Partial(true)
+
+	labels, err := expandLabels(d.Get("labels"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	maintenancePolicy, err := expandMaintenancePolicy(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := compute.UpdateHostGroupRequest{
+		HostGroupId:       d.Id(),
+		Name:              d.Get("name").(string),
+		Description:       d.Get("description").(string),
+		Labels:            labels,
+		MaintenancePolicy: maintenancePolicy,
+		ScalePolicy:       expandHostGroupScalePolicy(d),
+	}
+
+	paths := generateFieldMasks(d, resourceComputeHostGroupUpdateFieldsMap)
+	if len(paths) > 0 {
+		req.UpdateMask = &fieldmaskpb.FieldMask{Paths: paths}
+		if err := updateHostGroup(ctx, &req, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.Partial(false)
+
+	return resourceYandexComputeHostGroupRead(ctx, d, meta)
+}
+
+func resourceYandexComputeHostGroupDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	op, err := config.sdk.WrapOperation(config.sdk.Compute().HostGroup().Delete(
+		ctx, &compute.DeleteHostGroupRequest{
+			HostGroupId: d.Id(),
+		}))
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("Host group %q", d.Id())))
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = op.Response()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func updateHostGroup(ctx context.Context, req *compute.UpdateHostGroupRequest, d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	op, err := config.sdk.WrapOperation(config.sdk.Compute().HostGroup().Update(ctx, req))
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to update host group %q: %s", d.Id(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error updating host group %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandHostGroupScalePolicy(d *schema.ResourceData) *compute.ScalePolicy {
+	return &compute.ScalePolicy{
+		ScaleType: &compute.ScalePolicy_FixedScale_{
+			FixedScale: &compute.ScalePolicy_FixedScale{
+				Size: int64(d.Get("scale_policy.0.fixed_scale.0.size").(int)),
+			},
+		},
+	}
+}
+
+func flattenHostGroupScalePolicy(policy *compute.ScalePolicy) []map[string]interface{} {
+	if policy.GetFixedScale() == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{{
+		"fixed_scale": []map[string]interface{}{{
+			"size": int(policy.GetFixedScale().GetSize()),
+		}},
+	}}
+}
+
+// validateHostAffinityRules checks the host affinity rules of an instance placed to one of the zones.
+// Host groups are looked up to check that they are in these zones, the rules which are not known yet are skipped.
+func validateHostAffinityRules(ctx context.Context, config *Config, rules []interface{}, zones []string) error {
+	keys := map[string]bool{}
+	for _, r := range rules {
+		rule, ok := r.(map[string]interface{})
+		if !ok {
+			continue
+		}
+
+		key := rule["key"].(string)
+		if key == "" {
+			continue
+		}
+		if keys[key] {
+			return fmt.Errorf("host affinity rule with key %q is specified more than once", key)
+		}
+		keys[key] = true
+
+		if config == nil || key != hostAffinityKeyHostGroupID || rule["op"].(string) != compute.PlacementPolicy_HostAffinityRule_IN.String() {
+			continue
+		}
+
+		values, _ := rule["values"].([]interface{})
+		for _, v := range values {
+			hostGroupID, _ := v.(string)
+			if hostGroupID == "" {
+				continue
+			}
+
+			hostGroup, err := config.sdk.Compute().HostGroup().Get(ctx, &compute.GetHostGroupRequest{
+				HostGroupId: hostGroupID,
+			})
+			if err != nil {
+				if isStatusWithCode(err, codes.NotFound) {
+					return fmt.Errorf("host group %q referenced by the host affinity rule is not found", hostGroupID)
+				}
+				log.Printf("[WARN] Unable to check host group %q of the host affinity rule: %s", hostGroupID, err)
+				continue
+			}
+
+			if len(zones) > 0 && !slices.Contains(zones, hostGroup.ZoneId) {
+				return fmt.Errorf(
+					"host group %q is in zone %q, but the instances are placed to %s",
+					hostGroupID, hostGroup.ZoneId, strings.Join(zones, ", "),
+				)
+			}
+		}
+	}
+
+	return nil
+}
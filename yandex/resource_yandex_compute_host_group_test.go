@@ -0,0 +1,234 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"strings"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+const computeHostGroupResource = "yandex_compute_host_group.foobar"
+
+func init() {
+	resource.AddTestSweepers("yandex_compute_host_group", &resource.Sweeper{
+		Name: "yandex_compute_host_group",
+		F:    testSweepComputeHostGroup,
+		Dependencies: []string{
+			"yandex_compute_instance",
+			"yandex_compute_instance_group",
+		},
+	})
+}
+
+func testSweepComputeHostGroup(_ string) error {
+	conf, err := configForSweepers()
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	req := &compute.ListHostGroupsRequest{FolderId: conf.FolderID}
+	it := conf.sdk.Compute().HostGroup().HostGroupIterator(conf.Context(), req)
+	result := &multierror.Error{}
+	for it.Next() {
+		id := it.Value().GetId()
+		if !sweepComputeHostGroup(conf, id) {
+			result = multierror.Append(result, fmt.Errorf("failed to sweep Compute Host Group %q", id))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func sweepComputeHostGroup(conf *Config, id string) bool {
+	return sweepWithRetry(sweepComputeHostGroupOnce, conf, "Compute Host Group", id)
+}
+
+func sweepComputeHostGroupOnce(conf *Config, id string) error {
+	ctx, cancel := conf.ContextWithTimeout(yandexComputeHostGroupDefaultTimeout)
+	defer cancel()
+
+	op, err := conf.sdk.Compute().HostGroup().Delete(ctx, &compute.DeleteHostGroupRequest{
+		HostGroupId: id,
+	})
+	return handleSweepOperation(ctx, conf, op, err)
+}
+
+func TestFlattenHostGroupScalePolicy(t *testing.T) {
+	policy := &compute.ScalePolicy{
+		ScaleType: &compute.ScalePolicy_FixedScale_{
+			FixedScale: &compute.ScalePolicy_FixedScale{Size: 2},
+		},
+	}
+	expected := []map[string]interface{}{{
+		"fixed_scale": []map[string]interface{}{{"size": 2}},
+	}}
+
+	if result := flattenHostGroupScalePolicy(policy); !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", result, expected)
+	}
+	if result := flattenHostGroupScalePolicy(nil); result != nil {
+		t.Fatalf("Empty scale policy should be flattened to nil, got %#v", result)
+	}
+}
+
+func TestValidateHostAffinityRules(t *testing.T) {
+	rule := func(key, op string, values ...interface{}) interface{} {
+		return map[string]interface{}{"key": key, "op": op, "values": values}
+	}
+
+	cases := []struct {
+		name          string
+		rules         []interface{}
+		expectedError string
+	}{
+		{
+			name: "valid rules",
+			rules: []interface{}{
+				rule("yc.hostGroupId", "IN", "hg-1"),
+				rule("yc.hostId", "NOT_IN", "host-1"),
+			},
+		},
+		{
+			name: "duplicate keys",
+			rules: []interface{}{
+				rule("yc.hostGroupId", "IN", "hg-1"),
+				rule("yc.hostGroupId", "IN", "hg-2"),
+			},
+			expectedError: `host affinity rule with key "yc.hostGroupId" is specified more than once`,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			// host groups are not looked up without the provider config
+			err := validateHostAffinityRules(context.Background(), nil, tc.rules, []string{"ru-central1-a"})
+			if tc.expectedError == "" {
+				if err != nil {
+					t.Fatalf("Unexpected error: %s", err)
+				}
+				return
+			}
+			if err == nil || !strings.Contains(err.Error(), tc.expectedError) {
+				t.Fatalf("Expected error %q, got %v", tc.expectedError, err)
+			}
+		})
+	}
+}
+
+func TestAccComputeHostGroup_basic(t *testing.T) {
+	t.Parallel()
+
+	hostGroupName := acctest.RandomWithPrefix("tf-test")
+	var hostGroup compute.HostGroup
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckComputeHostGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeHostGroup_basic(hostGroupName, "", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeHostGroupExists(computeHostGroupResource, &hostGroup),
+					resource.TestCheckResourceAttr(computeHostGroupResource, "name", hostGroupName),
+					resource.TestCheckResourceAttr(computeHostGroupResource, "zone", "ru-central1-a"),
+					resource.TestCheckResourceAttr(computeHostGroupResource, "scale_policy.0.fixed_scale.0.size", "1"),
+					resource.TestCheckResourceAttr(computeHostGroupResource, "labels.my-label", "my-label-value"),
+					testAccCheckCreatedAtAttr(computeHostGroupResource),
+				),
+			},
+			{
+				ResourceName:      computeHostGroupResource,
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+			{
+				Config: testAccComputeHostGroup_basic(hostGroupName, "new description", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeHostGroupExists(computeHostGroupResource, &hostGroup),
+					resource.TestCheckResourceAttr(computeHostGroupResource, "description", "new description"),
+					resource.TestCheckResourceAttr(computeHostGroupResource, "scale_policy.0.fixed_scale.0.size", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeHostGroupDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_compute_host_group" {
+			continue
+		}
+
+		_, err := config.sdk.Compute().HostGroup().Get(context.Background(), &compute.GetHostGroupRequest{
+			HostGroupId: rs.Primary.ID,
+		})
+		if err == nil {
+			return fmt.Errorf("Host group still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckComputeHostGroupExists(n string, hostGroup *compute.HostGroup) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		found, err := config.sdk.Compute().HostGroup().Get(context.Background(), &compute.GetHostGroupRequest{
+			HostGroupId: rs.Primary.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if found.Id != rs.Primary.ID {
+			return fmt.Errorf("Host group not found")
+		}
+
+		*hostGroup = *found
+
+		return nil
+	}
+}
+
+//revive:disable:var-naming
+func testAccComputeHostGroup_basic(name, desc string, size int) string {
+	return fmt.Sprintf(`
+resource "yandex_compute_host_group" "foobar" {
+  name        = "%s"
+  description = "%s"
+  zone        = "ru-central1-a"
+  type_id     = "intel-6338-c108-m704-n3200x6"
+
+  scale_policy {
+    fixed_scale {
+      size = %d
+    }
+  }
+
+  labels = {
+    my-label = "my-label-value"
+  }
+}
+`, name, desc, size)
+}
@@ -4,6 +4,7 @@ import (
 	"context"
 	"fmt"
 	"log"
+	"path/filepath"
 	"strings"
 	"time"
 
@@ -19,7 +20,7 @@ const StandardImagesFolderID = "standard-images"
 
 func resourceYandexComputeImage() *schema.Resource {
 	return &schema.Resource{
-		Description: "Creates a virtual machine image resource for the Yandex Compute Cloud service from an existing tarball. For more information, see [the official documentation](https://yandex.cloud/docs/compute/concepts/image).\n\n~> One of `source_family`, `source_image`, `source_snapshot`, `source_disk` or `source_url` must be specified.\n",
+		Description: "Creates a virtual machine image resource for the Yandex Compute Cloud service from an existing tarball. For more information, see [the official documentation](https://yandex.cloud/docs/compute/concepts/image).\n\n~> One of `source_family`, `source_image`, `source_snapshot`, `source_disk`, `source_url` or `source_file` must be specified.\n",
 
 		Create: resourceYandexComputeImageCreate,
 		Read:   resourceYandexComputeImageRead,
@@ -103,7 +104,7 @@ func resourceYandexComputeImage() *schema.Resource {
 				Computed:      true,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_snapshot", "source_disk", "source_url", "source_image"},
+				ConflictsWith: []string{"source_snapshot", "source_disk", "source_url", "source_image", "source_file"},
 			},
 
 			"source_image": {
@@ -112,7 +113,7 @@ func resourceYandexComputeImage() *schema.Resource {
 				Computed:      true,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_snapshot", "source_disk", "source_url", "source_family"},
+				ConflictsWith: []string{"source_snapshot", "source_disk", "source_url", "source_family", "source_file"},
 			},
 
 			"source_snapshot": {
@@ -121,7 +122,7 @@ func resourceYandexComputeImage() *schema.Resource {
 				Computed:      true,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_image", "source_disk", "source_url", "source_family"},
+				ConflictsWith: []string{"source_image", "source_disk", "source_url", "source_family", "source_file"},
 			},
 
 			"source_disk": {
@@ -130,7 +131,7 @@ func resourceYandexComputeImage() *schema.Resource {
 				Computed:      true,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_image", "source_snapshot", "source_url", "source_family"},
+				ConflictsWith: []string{"source_image", "source_snapshot", "source_url", "source_family", "source_file"},
 			},
 
 			"source_url": {
@@ -139,7 +140,56 @@ func resourceYandexComputeImage() *schema.Resource {
 				Computed:      true,
 				Optional:      true,
 				ForceNew:      true,
-				ConflictsWith: []string{"source_image", "source_snapshot", "source_disk", "source_family"},
+				ConflictsWith: []string{"source_image", "source_snapshot", "source_disk", "source_family", "source_file"},
+			},
+
+			"source_file": {
+				Type:          schema.TypeString,
+				Description:   "The path to a local image file, e.g. a `qcow2` image built by Packer. The file is uploaded to the `source_file_staging` bucket with the storage credentials of the provider and the image is created from the uploaded object. Changing the path forces a new resource to be created.",
+				Optional:      true,
+				ForceNew:      true,
+				ConflictsWith: []string{"source_image", "source_snapshot", "source_disk", "source_family", "source_url"},
+				RequiredWith:  []string{"source_file_staging"},
+			},
+
+			"source_file_hash": {
+				Type:         schema.TypeString,
+				Description:  "The hash of the `source_file`, e.g. `filesha256(\"image.qcow2\")`. Changing the hash forces a new resource to be created, so the image is recreated when the file content changes.",
+				Optional:     true,
+				ForceNew:     true,
+				RequiredWith: []string{"source_file"},
+			},
+
+			"source_file_staging": {
+				Type:         schema.TypeList,
+				Description:  "The Object Storage location to upload the `source_file` to.",
+				Optional:     true,
+				ForceNew:     true,
+				MaxItems:     1,
+				RequiredWith: []string{"source_file"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"bucket": {
+							Type:        schema.TypeString,
+							Description: "The name of the bucket.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"key": {
+							Type:        schema.TypeString,
+							Description: "The key of the uploaded object. The default is the name of the `source_file`.",
+							Optional:    true,
+							ForceNew:    true,
+						},
+						"keep_object": {
+							Type:        schema.TypeBool,
+							Description: "Keep the uploaded object after the image is created. The default is `false`.",
+							Optional:    true,
+							ForceNew:    true,
+							Default:     false,
+						},
+					},
+				},
 			},
 
 			"product_ids": {
@@ -259,13 +309,17 @@ func resourceYandexComputeImageCreate(d *schema.ResourceData, meta interface{})
 		HardwareGeneration: hardwareGeneration,
 	}
 
-	err = prepareSourceForImage(&req, d, meta)
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	err = prepareSourceForImage(ctx, &req, d, meta)
 	if err != nil {
 		return fmt.Errorf("Error while prepare request to create image: %s", err)
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
-	defer cancel()
+	if _, ok := d.GetOk("source_file"); ok && !d.Get("source_file_staging.0.keep_object").(bool) {
+		defer deleteImageSourceFileStagingObject(d, config)
+	}
 
 	op, err := config.sdk.WrapOperation(config.sdk.Compute().Image().Create(ctx, &req))
 	if err != nil {
@@ -444,8 +498,8 @@ func resourceYandexComputeImageDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
-func prepareSourceForImage(req *compute.CreateImageRequest, d *schema.ResourceData, meta interface{}) error {
-	sourceAttrs := []string{"source_family", "source_disk", "source_image", "source_snapshot", "source_url"}
+func prepareSourceForImage(ctx context.Context, req *compute.CreateImageRequest, d *schema.ResourceData, meta interface{}) error {
+	sourceAttrs := []string{"source_family", "source_disk", "source_image", "source_snapshot", "source_url", "source_file"}
 	var selectedSourceAttr string
 	var selectedSourceValue string
 
@@ -464,7 +518,6 @@ func prepareSourceForImage(req *compute.CreateImageRequest, d *schema.ResourceDa
 	switch selectedSourceAttr {
 	case "source_family":
 		config := meta.(*Config)
-		ctx := config.Context()
 		familyName := d.Get("source_family").(string)
 		img, err := config.sdk.Compute().Image().GetLatestByFamily(ctx, &compute.GetImageLatestByFamilyRequest{
 			FolderId: StandardImagesFolderID,
@@ -492,6 +545,14 @@ func prepareSourceForImage(req *compute.CreateImageRequest, d *schema.ResourceDa
 		req.Source = &compute.CreateImageRequest_Uri{
 			Uri: selectedSourceValue,
 		}
+	case "source_file":
+		uri, err := uploadImageSourceFile(ctx, d, meta.(*Config))
+		if err != nil {
+			return err
+		}
+		req.Source = &compute.CreateImageRequest_Uri{
+			Uri: uri,
+		}
 	default:
 		// should not occur: validation must be done at Schema level
 		return fmt.Errorf("selected source attr %s not one from %s", selectedSourceAttr, sourceAttrs)
@@ -500,6 +561,46 @@ func prepareSourceForImage(req *compute.CreateImageRequest, d *schema.ResourceDa
 	return nil
 }
 
+// uploadImageSourceFile uploads the source file to the staging bucket and returns the URL to create the image from.
+func uploadImageSourceFile(ctx context.Context, d *schema.ResourceData, config *Config) (string, error) {
+	s3Client, err := getS3ClientByKeys(ctx, "", "", config)
+	if err != nil {
+		return "", err
+	}
+
+	bucket := d.Get("source_file_staging.0.bucket").(string)
+	key := imageSourceFileStagingKey(d)
+
+	if err := s3Client.UploadFile(ctx, bucket, key, d.Get("source_file").(string)); err != nil {
+		return "", err
+	}
+
+	return s3Client.PresignGetObject(bucket, key, d.Timeout(schema.TimeoutCreate))
+}
+
+func deleteImageSourceFileStagingObject(d *schema.ResourceData, config *Config) {
+	ctx, cancel := context.WithTimeout(config.Context(), yandexComputeImageDefaultTimeout)
+	defer cancel()
+
+	bucket := d.Get("source_file_staging.0.bucket").(string)
+	key := imageSourceFileStagingKey(d)
+
+	s3Client, err := getS3ClientByKeys(ctx, "", "", config)
+	if err == nil {
+		err = s3Client.DeleteObject(ctx, bucket, key)
+	}
+	if err != nil {
+		log.Printf("[WARN] Failed to delete staging object %q in bucket %q of Image %q: %s", key, bucket, d.Id(), err)
+	}
+}
+
+func imageSourceFileStagingKey(d *schema.ResourceData) string {
+	if key, ok := d.GetOk("source_file_staging.0.key"); ok {
+		return key.(string)
+	}
+	return filepath.Base(d.Get("source_file").(string))
+}
+
 func makeImageUpdateRequest(req *compute.UpdateImageRequest, d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
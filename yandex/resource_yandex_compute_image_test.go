@@ -3,8 +3,10 @@ package yandex
 import (
 	"context"
 	"fmt"
+	"os"
 	"testing"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
 	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
 	"github.com/hashicorp/terraform-plugin-testing/terraform"
@@ -67,6 +69,74 @@ func TestAccComputeImage_productID(t *testing.T) {
 	})
 }
 
+func TestAccComputeImage_sourceFile(t *testing.T) {
+	sourceFile := os.Getenv("YC_TEST_IMAGE_SOURCE_FILE")
+	if sourceFile == "" {
+		t.Skip("Required var YC_TEST_IMAGE_SOURCE_FILE is not set.")
+	}
+	t.Parallel()
+
+	var image compute.Image
+
+	name := "image-test-" + acctest.RandString(8)
+	randInt := acctest.RandInt()
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeImageDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeImage_sourceFile(name, sourceFile, randInt),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeImageExists(
+						"yandex_compute_image.foobar", &image),
+					resource.TestCheckResourceAttr("yandex_compute_image.foobar", "status", "ready"),
+					resource.TestCheckResourceAttrSet("yandex_compute_image.foobar", "size"),
+				),
+			},
+		},
+	})
+}
+
+func TestImageSourceFileStagingKey(t *testing.T) {
+	cases := []struct {
+		name     string
+		raw      map[string]interface{}
+		expected string
+	}{
+		{
+			name: "default key",
+			raw: map[string]interface{}{
+				"source_file": "~/packer/output/ubuntu.qcow2",
+				"source_file_staging": []interface{}{
+					map[string]interface{}{"bucket": "images"},
+				},
+			},
+			expected: "ubuntu.qcow2",
+		},
+		{
+			name: "custom key",
+			raw: map[string]interface{}{
+				"source_file": "~/packer/output/ubuntu.qcow2",
+				"source_file_staging": []interface{}{
+					map[string]interface{}{"bucket": "images", "key": "staging/ubuntu-v2.qcow2"},
+				},
+			},
+			expected: "staging/ubuntu-v2.qcow2",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			d := schema.TestResourceDataRaw(t, resourceYandexComputeImage().Schema, tc.raw)
+			if result := imageSourceFileStagingKey(d); result != tc.expected {
+				t.Errorf("imageSourceFileStagingKey() = %q, want %q", result, tc.expected)
+			}
+		})
+	}
+}
+
 func TestAccComputeImage_update(t *testing.T) {
 	t.Parallel()
 
@@ -289,6 +359,25 @@ resource "yandex_compute_image" "foobar" {
 `, name)
 }
 
+func testAccComputeImage_sourceFile(name, sourceFile string, randInt int) string {
+	return newBucketConfigBuilder(randInt).
+		withDisabledAccessKeys().
+		withFolderID(testFolderID).
+		asEditor().
+		render() + fmt.Sprintf(`
+resource "yandex_compute_image" "foobar" {
+  name             = "%s"
+  source_file      = "%s"
+  source_file_hash = filesha256("%[2]s")
+  os_type          = "linux"
+
+  source_file_staging {
+    bucket = yandex_storage_bucket.test.bucket
+  }
+}
+`, name, sourceFile)
+}
+
 func testAccComputeImage_productID(name string) string {
 	return fmt.Sprintf(`
 resource "yandex_compute_image" "foobar" {
@@ -9,6 +9,7 @@ import (
 	"strings"
 	"time"
 
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/customdiff"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/mitchellh/hashstructure"
@@ -26,6 +27,11 @@ const (
 	yandexComputeInstanceMoveTimeout          = 1 * time.Minute
 )
 
+const (
+	instanceDesiredStatusRunning = "running"
+	instanceDesiredStatusStopped = "stopped"
+)
+
 func resourceYandexComputeInstance() *schema.Resource {
 	return &schema.Resource{
 		Description: "A VM instance resource. For more information, see [the official documentation](https://yandex.cloud/docs/compute/concepts/vm).\n",
@@ -35,9 +41,15 @@ func resourceYandexComputeInstance() *schema.Resource {
 		Update: resourceYandexComputeInstanceUpdate,
 		Delete: resourceYandexComputeInstanceDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceYandexComputeInstanceImportState,
 		},
 
+		CustomizeDiff: customdiff.All(
+			resourceYandexComputeInstanceUpdateImpactDiff,
+			resourceYandexComputeInstanceHostAffinityDiff,
+			resourceYandexComputeInstanceDesiredStatusDiff,
+		),
+
 		Timeouts: &schema.ResourceTimeout{
 			Create: schema.DefaultTimeout(yandexComputeInstanceDefaultTimeout),
 			Update: schema.DefaultTimeout(yandexComputeInstanceDefaultTimeout),
@@ -221,7 +233,7 @@ func resourceYandexComputeInstance() *schema.Resource {
 
 			"network_interface": {
 				Type:        schema.TypeList,
-				Description: "Networks to attach to the instance. This can be specified multiple times.",
+				Description: "Networks to attach to the instance. This can be specified multiple times. Network interfaces attached with the `yandex_compute_instance_network_interface` resource are detached by this resource unless [`external_network_interfaces`](#external_network_interfaces) is `true`.",
 				Required:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -453,9 +465,21 @@ func resourceYandexComputeInstance() *schema.Resource {
 				Default:     "standard-v1",
 			},
 
+			"external_secondary_disks": {
+				Type:        schema.TypeBool,
+				Description: "If `true`, the secondary disks which are not declared in `secondary_disk` are managed by `yandex_compute_disk_attachment` resources. They are neither shown in `secondary_disk` nor detached by this resource. Switching it on doesn't detach any disks.",
+				Optional:    true,
+			},
+
+			"external_network_interfaces": {
+				Type:        schema.TypeBool,
+				Description: "If `true`, the network interfaces which are not declared in `network_interface` are managed by `yandex_compute_instance_network_interface` resources. They are neither shown in `network_interface` nor detached by this resource. Switching it on doesn't detach any interfaces.",
+				Optional:    true,
+			},
+
 			"allow_stopping_for_update": {
 				Type:        schema.TypeBool,
-				Description: "If `true`, allows Terraform to stop the instance in order to update its properties. If you try to update a property that requires stopping the instance without setting this field, the update will fail.",
+				Description: "If `true`, allows Terraform to stop the instance in order to update its properties. If you try to update a property that requires stopping the instance without setting this field, the plan will fail and list the properties which require stopping. The plan of the update also warns which changes require stopping or recreating the instance.",
 				Optional:    true,
 			},
 
@@ -464,9 +488,40 @@ func resourceYandexComputeInstance() *schema.Resource {
 				Optional: true,
 			},
 
+			"desired_status": {
+				Type:         schema.TypeString,
+				Description:  "Desired status of the instance: `running` or `stopped`. The instance is started or stopped on apply to match it, the status changed outside of Terraform is shown in the plan as a change of `status`. If not set, the instance keeps its current status, updates which require stopping the instance don't start a stopped instance.",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{instanceDesiredStatusRunning, instanceDesiredStatusStopped}, false),
+			},
+
+			"wait_for": {
+				Type:        schema.TypeList,
+				Description: "Condition to wait for after the instance is created, e.g. the end of cloud-init. The creation fails if the condition is not met before the timeout. It is checked only on creation, changes of this block don't affect existing instances.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"serial_output_regex": {
+							Type:         schema.TypeString,
+							Description:  "Regular expression to wait for in the serial port output of the instance, e.g. `Cloud-init .* finished`.",
+							Required:     true,
+							ValidateFunc: validation.StringIsValidRegExp,
+						},
+						"timeout": {
+							Type:         schema.TypeString,
+							Description:  "Maximum time to wait for the condition, e.g. `15m`. The default is `10m`.",
+							Optional:     true,
+							Default:      yandexComputeInstanceWaitForDefaultTimeout,
+							ValidateFunc: validateParsableValue(parsePositiveDuration),
+						},
+					},
+				},
+			},
+
 			"secondary_disk": {
 				Type:        schema.TypeSet,
-				Description: "A set of disks to attach to the instance. The structure is documented below.\n\n~> The [`allow_stopping_for_update`](#allow_stopping_for_update) property must be set to `true` in order to update this structure.",
+				Description: "A set of disks to attach to the instance. The structure is documented below.\n\n~> Disks attached with the `yandex_compute_disk_attachment` resource are detached by this resource unless [`external_secondary_disks`](#external_secondary_disks) is `true`.\n\n~> The [`allow_stopping_for_update`](#allow_stopping_for_update) property must be set to `true` in order to update this structure.",
 				Set:         hashInstanceSecondaryDisks,
 				Optional:    true,
 				Elem: &schema.Resource{
@@ -694,6 +749,12 @@ func resourceYandexComputeInstance() *schema.Resource {
 				ForceNew:    true,
 			},
 
+			"reserved_instance_pool_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the reserved instance pool that the instance should use. The platform and resources of the instance must match the pool.",
+				Optional:    true,
+			},
+
 			"maintenance_policy": {
 				Type:        schema.TypeString,
 				Description: "Behavior on maintenance events. Can be: `unspecified`, `migrate`, `restart`. The default is `unspecified`.",
@@ -777,6 +838,16 @@ func resourceYandexComputeInstanceCreate(d *schema.ResourceData, meta interface{
 		return fmt.Errorf("Instance creation failed: %s", err)
 	}
 
+	if err := waitForInstanceSerialOutput(d, config); err != nil {
+		return err
+	}
+
+	if d.Get("desired_status").(string) == instanceDesiredStatusStopped {
+		if err := makeInstanceActionRequest(instanceActionStop, d, meta); err != nil {
+			return err
+		}
+	}
+
 	return resourceYandexComputeInstanceRead(d, meta)
 }
 
@@ -809,6 +880,10 @@ func resourceYandexComputeInstanceRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
+	if d.Get("external_secondary_disks").(bool) {
+		// Disks attached by yandex_compute_disk_attachment resources are not tracked by the instance
+		secondaryDisks = filterManagedInstanceSecondaryDisks(secondaryDisks, d.Get("secondary_disk").(*schema.Set))
+	}
 
 	schedulingPolicy, err := flattenInstanceSchedulingPolicy(instance)
 	if err != nil {
@@ -824,6 +899,10 @@ func resourceYandexComputeInstanceRead(d *schema.ResourceData, meta interface{})
 	if err != nil {
 		return err
 	}
+	if d.Get("external_network_interfaces").(bool) {
+		// Interfaces attached by yandex_compute_instance_network_interface resources are not tracked by the instance
+		networkInterfaces = filterManagedInstanceNetworkInterfaces(networkInterfaces, d.Get("network_interface").([]interface{}))
+	}
 
 	localDisks := flattenLocalDisks(instance)
 
@@ -911,6 +990,8 @@ func resourceYandexComputeInstanceRead(d *schema.ResourceData, meta interface{})
 		d.Set("gpu_cluster_id", instance.GpuSettings.GpuClusterId)
 	}
 
+	d.Set("reserved_instance_pool_id", instance.ReservedInstancePoolId)
+
 	if instance.MaintenancePolicy != compute.MaintenancePolicy_MAINTENANCE_POLICY_UNSPECIFIED {
 		if err := d.Set("maintenance_policy", strings.ToLower(instance.MaintenancePolicy.String())); err != nil {
 			return err
@@ -945,17 +1026,21 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 
 	d.Partial(true)
 
+	// Instances which are stopped by the update are started only if they should be running after it
+	instanceStopped := instance.Status == compute.Instance_STOPPED
+	instanceRunningAfterUpdate := instanceShouldBeRunning(d, instance)
+
 	folderPropName := "folder_id"
 	if d.HasChange(folderPropName) {
 		if !d.Get("allow_recreate").(bool) {
-			if err := ensureAllowStoppingForUpdate(d, folderPropName); err != nil {
-				return err
-			}
-
-			if instance.Status != compute.Instance_STOPPED {
+			if !instanceStopped {
+				if err := ensureAllowStoppingForUpdate(d, folderPropName); err != nil {
+					return err
+				}
 				if err := makeInstanceActionRequest(instanceActionStop, d, meta); err != nil {
 					return err
 				}
+				instanceStopped = true
 			}
 
 			req := &compute.MoveInstanceRequest{
@@ -967,8 +1052,11 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 				return err
 			}
 
-			if err := makeInstanceActionRequest(instanceActionStart, d, meta); err != nil {
-				return err
+			if instanceRunningAfterUpdate {
+				if err := makeInstanceActionRequest(instanceActionStart, d, meta); err != nil {
+					return err
+				}
+				instanceStopped = false
 			}
 
 		} else {
@@ -978,6 +1066,7 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 			if err := resourceYandexComputeInstanceCreate(d, meta); err != nil {
 				return err
 			}
+			instanceStopped = d.Get("desired_status").(string) == instanceDesiredStatusStopped
 		}
 	}
 
@@ -1135,20 +1224,17 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 	var attachInterfaceRequests []*compute.AttachInstanceNetworkInterfaceRequest
 	var detachInterfaceRequests []*compute.DetachInstanceNetworkInterfaceRequest
 	if d.HasChange(networkInterfacesPropName) {
-		o, n := d.GetChange(networkInterfacesPropName)
-		oldList := o.([]interface{})
-		newList := n.([]interface{})
+		oldList, newList := instanceNetworkInterfacesChange(d)
 
 		if len(oldList) != len(newList) {
-			log.Printf("[DEBUG] Number of network interfaces has changed, processing attach/detach interfaces. " +
-				"Instance will be stopped")
-			needUpdateInterfacesOnStoppedInstance = true
+			log.Printf("[DEBUG] Number of network interfaces has changed, processing attach/detach interfaces")
 
-			attachInterfaceRequests, detachInterfaceRequests, err = getSpecsForAttachDetachNetworkInterfaces(newList,
+			attachInterfaceRequests, detachInterfaceRequests, err = getSpecsForAttachDetachNetworkInterfaces(oldList, newList,
 				d.Id(), instance.NetworkInterfaces)
 			if err != nil {
 				return err
 			}
+			needUpdateInterfacesOnStoppedInstance = len(attachInterfaceRequests) > 0 || len(detachInterfaceRequests) > 0
 
 		} else {
 			updateInterfaceRequests, needUpdateInterfacesOnStoppedInstance, err = getSpecsForUpdateNetworkInterfaces(d, networkInterfacesPropName, oldList, newList)
@@ -1185,7 +1271,7 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 
 	secDiskPropName := "secondary_disk"
 	if d.HasChange(secDiskPropName) {
-		_, n := d.GetChange(secDiskPropName)
+		o, n := d.GetChange(secDiskPropName)
 
 		// Keep track of disks currently in the instance. Because the yandex_compute_disk resource
 		// can detach disks, it's possible that there are fewer disks currently attached than there
@@ -1226,9 +1312,17 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 			}
 		}
 
-		// Detach disks that are not in new config
+		// Detach disks that are not in new config. With external_secondary_disks the disks which were not
+		// in the old config are attached by yandex_compute_disk_attachment resources and must be kept,
+		// switching it on hands all the undeclared disks over to them.
+		externalDisks := d.Get("external_secondary_disks").(bool)
+		externalDisksSwitched := externalDisks && d.HasChange("external_secondary_disks")
+		oDisks := map[string]bool{}
+		for _, disk := range o.(*schema.Set).List() {
+			oDisks[disk.(map[string]interface{})["disk_id"].(string)] = true
+		}
 		for diskID := range currDisks {
-			if _, ok := nDisks[diskID]; !ok {
+			if _, ok := nDisks[diskID]; !ok && (!externalDisks || (oDisks[diskID] && !externalDisksSwitched)) {
 				detach = append(detach, &compute.DetachInstanceDiskRequest{
 					InstanceId: d.Id(),
 					Disk: &compute.DetachInstanceDiskRequest_DiskId{
@@ -1343,6 +1437,7 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 	networkAccelerationTypePropName := "network_acceleration_type"
 	schedulingPolicyName := "scheduling_policy"
 	placementPolicyPropName := "placement_policy"
+	reservedInstancePoolIDPropName := "reserved_instance_pool_id"
 
 	properties := []string{
 		resourcesPropName,
@@ -1350,21 +1445,26 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 		networkAccelerationTypePropName,
 		schedulingPolicyName,
 		placementPolicyPropName,
+		reservedInstancePoolIDPropName,
 	}
 	if d.HasChange(resourcesPropName) || d.HasChange(platformIDPropName) || d.HasChange(networkAccelerationTypePropName) ||
-		needUpdateInterfacesOnStoppedInstance || d.HasChange(schedulingPolicyName) || d.HasChange(placementPolicyPropName) {
-		if err := ensureAllowStoppingForUpdate(d, properties...); err != nil {
-			return err
-		}
-		if err := makeInstanceActionRequest(instanceActionStop, d, meta); err != nil {
-			return err
+		needUpdateInterfacesOnStoppedInstance || d.HasChange(schedulingPolicyName) || d.HasChange(placementPolicyPropName) ||
+		d.HasChange(reservedInstancePoolIDPropName) {
+		if !instanceStopped {
+			if err := ensureAllowStoppingForUpdate(d, properties...); err != nil {
+				return err
+			}
+			if err := makeInstanceActionRequest(instanceActionStop, d, meta); err != nil {
+				return err
+			}
+			instanceStopped = true
 		}
 
 		instanceStoppedAt := time.Now()
 
 		// update platform, resources, network_settings and maintenance_policy in one request
 		if d.HasChange(resourcesPropName) || d.HasChange(platformIDPropName) || d.HasChange(networkAccelerationTypePropName) ||
-			d.HasChange(placementPolicyPropName) || d.HasChange(schedulingPolicyName) {
+			d.HasChange(placementPolicyPropName) || d.HasChange(schedulingPolicyName) || d.HasChange(reservedInstancePoolIDPropName) {
 			req := &compute.UpdateInstanceRequest{
 				InstanceId: d.Id(),
 				UpdateMask: &field_mask.FieldMask{
@@ -1413,6 +1513,11 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 				req.UpdateMask.Paths = append(req.UpdateMask.Paths, paths...)
 			}
 
+			if d.HasChange(reservedInstancePoolIDPropName) {
+				req.ReservedInstancePoolId = d.Get(reservedInstancePoolIDPropName).(string)
+				req.UpdateMask.Paths = append(req.UpdateMask.Paths, reservedInstancePoolIDPropName)
+			}
+
 			err = makeInstanceUpdateRequest(req, d, meta)
 			if err != nil {
 				return err
@@ -1464,11 +1569,18 @@ func resourceYandexComputeInstanceUpdate(d *schema.ResourceData, meta interface{
 
 		}
 
-		if err := makeInstanceActionRequest(instanceActionStart, d, meta); err != nil {
-			return err
+		if instanceRunningAfterUpdate {
+			if err := makeInstanceActionRequest(instanceActionStart, d, meta); err != nil {
+				return err
+			}
+			instanceStopped = false
 		}
 	}
 
+	if err := applyInstanceDesiredStatus(d, meta, instanceStopped); err != nil {
+		return err
+	}
+
 	d.Partial(false)
 
 	return resourceYandexComputeInstanceRead(d, meta)
@@ -1505,6 +1617,38 @@ func resourceYandexComputeInstanceDelete(d *schema.ResourceData, meta interface{
 	return nil
 }
 
+// resourceYandexComputeInstanceImportState imports all secondary disks and network interfaces of the instance.
+// The ones of yandex_compute_disk_attachment and yandex_compute_instance_network_interface resources are dropped
+// from the state once external_secondary_disks and external_network_interfaces are set.
+func resourceYandexComputeInstanceImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	instance, err := config.sdk.Compute().Instance().Get(config.Context(), &compute.GetInstanceRequest{
+		InstanceId: d.Id(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error while requesting API to get Instance %q: %s", d.Id(), err)
+	}
+
+	secondaryDisks, err := flattenInstanceSecondaryDisks(instance)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("secondary_disk", secondaryDisks); err != nil {
+		return nil, err
+	}
+
+	networkInterfaces, _, _, err := flattenInstanceNetworkInterfaces(instance)
+	if err != nil {
+		return nil, err
+	}
+	if err := d.Set("network_interface", networkInterfaces); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func prepareCreateInstanceRequest(d *schema.ResourceData, meta *Config) (*compute.CreateInstanceRequest, error) {
 	zone, err := getZone(d, meta)
 	if err != nil {
@@ -1606,6 +1750,7 @@ func prepareCreateInstanceRequest(d *schema.ResourceData, meta *Config) (*comput
 		MetadataOptions:        metadataOptions,
 		FilesystemSpecs:        filesystemSpecs,
 		GpuSettings:            gpuSettingsSpec,
+		ReservedInstancePoolId: d.Get("reserved_instance_pool_id").(string),
 		MaintenancePolicy:      maintenancePolicy,
 		MaintenanceGracePeriod: maintenanceGracePeriod,
 	}
@@ -1835,12 +1980,15 @@ func makeInstanceDetachNetworkInterfaceRequest(req *compute.DetachInstanceNetwor
 }
 
 func makeInstanceActionRequest(action instanceAction, d *schema.ResourceData, meta interface{}) error {
+	return makeInstanceActionRequestByID(action, d.Id(), d.Timeout(schema.TimeoutUpdate), meta)
+}
+
+func makeInstanceActionRequestByID(action instanceAction, instanceID string, timeout time.Duration, meta interface{}) error {
 	config := meta.(*Config)
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
+	ctx, cancel := context.WithTimeout(config.Context(), timeout)
 	defer cancel()
 
-	instanceID := d.Id()
 	var err error
 	var op *operation.Operation
 
@@ -1987,12 +2135,33 @@ func differentRecordSpec(r1, r2 *compute.DnsRecordSpec) bool {
 
 func generateHostAffinityRuleOperators() []string {
 	operators := make([]string, 0, len(compute.PlacementPolicy_HostAffinityRule_Operator_value))
-	for operatorName := range compute.PlacementPolicy_HostAffinityRule_Operator_value {
+	for operatorName, operator := range compute.PlacementPolicy_HostAffinityRule_Operator_value {
+		if operator == int32(compute.PlacementPolicy_HostAffinityRule_OPERATOR_UNSPECIFIED) {
+			continue
+		}
 		operators = append(operators, operatorName)
 	}
 	return operators
 }
 
+func resourceYandexComputeInstanceHostAffinityDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChanges("placement_policy", "zone") {
+		return nil
+	}
+
+	config, _ := meta.(*Config)
+
+	var zones []string
+	if zone := d.Get("zone").(string); zone != "" {
+		zones = append(zones, zone)
+	} else if config != nil && config.Zone != "" {
+		zones = append(zones, config.Zone)
+	}
+
+	rules, _ := d.Get("placement_policy.0.host_affinity_rules").([]interface{})
+	return validateHostAffinityRules(ctx, config, rules, zones)
+}
+
 func preparePlacementPolicyForUpdateRequest(d *schema.ResourceData) (*compute.PlacementPolicy, []string) {
 	var placementPolicy compute.PlacementPolicy
 	var paths []string
@@ -2013,7 +2182,61 @@ func preparePlacementPolicyForUpdateRequest(d *schema.ResourceData) (*compute.Pl
 	return &placementPolicy, paths
 }
 
-func ensureAllowStoppingForUpdate(d *schema.ResourceData, propNames ...string) error {
+// instanceShouldBeRunning returns whether the instance should be running after the update.
+// Without desired_status the instance keeps its current status.
+func instanceShouldBeRunning(d *schema.ResourceData, instance *compute.Instance) bool {
+	switch d.Get("desired_status").(string) {
+	case instanceDesiredStatusRunning:
+		return true
+	case instanceDesiredStatusStopped:
+		return false
+	default:
+		return instance.Status != compute.Instance_STOPPED
+	}
+}
+
+// instanceStatusDrifted reports whether the instance was started or stopped outside of Terraform.
+// Instances in the transitional statuses are left alone.
+func instanceStatusDrifted(status, desiredStatus string) bool {
+	switch desiredStatus {
+	case instanceDesiredStatusRunning:
+		return status == instanceDesiredStatusStopped
+	case instanceDesiredStatusStopped:
+		return status == instanceDesiredStatusRunning
+	}
+	return false
+}
+
+// resourceYandexComputeInstanceDesiredStatusDiff plans the change of the status if it differs from the desired_status,
+// so the apply starts or stops the instance even if nothing else is changed.
+func resourceYandexComputeInstanceDesiredStatusDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	desiredStatus := d.Get("desired_status").(string)
+	if instanceStatusDrifted(d.Get("status").(string), desiredStatus) {
+		return d.SetNew("status", desiredStatus)
+	}
+	return nil
+}
+
+// applyInstanceDesiredStatus starts or stops the instance to match the desired_status.
+func applyInstanceDesiredStatus(d *schema.ResourceData, meta interface{}, instanceStopped bool) error {
+	switch d.Get("desired_status").(string) {
+	case instanceDesiredStatusRunning:
+		if instanceStopped {
+			return makeInstanceActionRequest(instanceActionStart, d, meta)
+		}
+	case instanceDesiredStatusStopped:
+		if !instanceStopped {
+			return makeInstanceActionRequest(instanceActionStop, d, meta)
+		}
+	}
+	return nil
+}
+
+func ensureAllowStoppingForUpdate(d instanceChangeGetter, propNames ...string) error {
 	message := fmt.Sprintf("Changing the %s in an instance requires stopping it. ", strings.Join(propNames, ", "))
 	if !d.Get("allow_stopping_for_update").(bool) {
 		return fmt.Errorf(message + "To acknowledge this action, please set allow_stopping_for_update = true in your config file.")
@@ -2021,11 +2244,45 @@ func ensureAllowStoppingForUpdate(d *schema.ResourceData, propNames ...string) e
 	return nil
 }
 
-func getSpecsForAttachDetachNetworkInterfaces(newList []interface{}, instanceId string, instanceNetworkInterfaces []*compute.NetworkInterface) (attachInterfaceRequests []*compute.AttachInstanceNetworkInterfaceRequest, detachInterfaceRequests []*compute.DetachInstanceNetworkInterfaceRequest, err error) {
+// instanceNetworkInterfacesChange returns the old and the new network interfaces of the instance. Switching
+// external_network_interfaces on hands the interfaces which are not declared anymore over to the
+// yandex_compute_instance_network_interface resources, so they are excluded from the old ones and stay attached.
+func instanceNetworkInterfacesChange(d instanceChangeGetter) ([]interface{}, []interface{}) {
+	o, n := d.GetChange("network_interface")
+	oldList := o.([]interface{})
+	newList := n.([]interface{})
+	if !d.Get("external_network_interfaces").(bool) || !d.HasChange("external_network_interfaces") {
+		return oldList, newList
+	}
+
+	declared := managedInstanceNetworkInterfaceIndexes(newList)
+	var retained []interface{}
+	for i, raw := range oldList {
+		iface, _ := raw.(map[string]interface{})
+		index, _ := iface["index"].(int)
+		if index == 0 {
+			index = i
+		}
+		if declared[index] {
+			retained = append(retained, raw)
+		}
+	}
+	return retained, newList
+}
+
+func getSpecsForAttachDetachNetworkInterfaces(oldList, newList []interface{}, instanceId string, instanceNetworkInterfaces []*compute.NetworkInterface) (attachInterfaceRequests []*compute.AttachInstanceNetworkInterfaceRequest, detachInterfaceRequests []*compute.DetachInstanceNetworkInterfaceRequest, err error) {
+	attachedIfaces := make(map[string]bool, len(instanceNetworkInterfaces))
 	curIfaces := make(map[string]*compute.NetworkInterface, len(instanceNetworkInterfaces))
 	newIfaces := make(map[string]*compute.NetworkInterfaceSpec)
 
+	// Interfaces attached with yandex_compute_instance_network_interface must stay attached
+	managedIndexes := managedInstanceNetworkInterfaceIndexes(oldList)
 	for _, iface := range instanceNetworkInterfaces {
+		attachedIfaces[iface.Index] = true
+		index, err := strconv.Atoi(iface.Index)
+		if err != nil || !managedIndexes[index] {
+			continue
+		}
 		curIfaces[iface.Index] = iface
 	}
 	for ifaceIndex := 0; ifaceIndex < len(newList); ifaceIndex++ {
@@ -2041,15 +2298,20 @@ func getSpecsForAttachDetachNetworkInterfaces(newList []interface{}, instanceId
 			return nil, nil, fmt.Errorf("Failed to process NIC number: #%d: %s", ifaceIndex, err)
 		}
 		newIfaces[index] = iface
-		if _, ok := curIfaces[index]; !ok {
-			attachInterfaceRequests = append(attachInterfaceRequests, &compute.AttachInstanceNetworkInterfaceRequest{
-				InstanceId:            instanceId,
-				NetworkInterfaceIndex: index,
-				PrimaryV4AddressSpec:  iface.PrimaryV4AddressSpec,
-				SecurityGroupIds:      iface.SecurityGroupIds,
-				SubnetId:              iface.SubnetId,
-			})
+		if _, ok := curIfaces[index]; ok {
+			continue
+		}
+		if attachedIfaces[index] {
+			return nil, nil, fmt.Errorf("NIC with index %s is already attached to the instance, probably by a "+
+				"yandex_compute_instance_network_interface resource, it can't be declared in network_interface too", index)
 		}
+		attachInterfaceRequests = append(attachInterfaceRequests, &compute.AttachInstanceNetworkInterfaceRequest{
+			InstanceId:            instanceId,
+			NetworkInterfaceIndex: index,
+			PrimaryV4AddressSpec:  iface.PrimaryV4AddressSpec,
+			SecurityGroupIds:      iface.SecurityGroupIds,
+			SubnetId:              iface.SubnetId,
+		})
 	}
 	for index := range curIfaces {
 		if _, ok := newIfaces[index]; !ok {
@@ -26,6 +26,8 @@ func resourceYandexComputeInstanceGroup() *schema.Resource {
 		Read:   resourceYandexComputeInstanceGroupRead,
 		Update: resourceYandexComputeInstanceGroupUpdate,
 		Delete: resourceYandexComputeInstanceGroupDelete,
+
+		CustomizeDiff: resourceYandexComputeInstanceGroupHostAffinityDiff,
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -540,7 +542,36 @@ func resourceYandexComputeInstanceGroup() *schema.Resource {
 									"placement_group_id": {
 										Type:        schema.TypeString,
 										Description: "Specifies the id of the Placement Group to assign to the instances.",
-										Required:    true,
+										Optional:    true,
+									},
+									"host_affinity_rules": {
+										Type:        schema.TypeList,
+										Description: "List of host affinity rules to place the instances to dedicated hosts, e.g. of a `yandex_compute_host_group`.",
+										Optional:    true,
+										Elem: &schema.Resource{
+											Schema: map[string]*schema.Schema{
+												"key": {
+													Type:        schema.TypeString,
+													Description: "Affinity label or one of reserved values - `yc.hostId`, `yc.hostGroupId`.",
+													Required:    true,
+												},
+												"op": {
+													Type:         schema.TypeString,
+													Description:  "Affinity action. Can be `IN` or `NOT_IN`.",
+													Required:     true,
+													ValidateFunc: validation.StringInSlice(generateHostAffinityRuleOperators(), false),
+												},
+												"values": {
+													Type:        schema.TypeList,
+													Description: "List of values (host IDs or host group IDs).",
+													Required:    true,
+													MinItems:    1,
+													Elem: &schema.Schema{
+														Type: schema.TypeString,
+													},
+												},
+											},
+										},
 									},
 								},
 							},
@@ -1259,6 +1290,60 @@ func resourceYandexComputeInstanceGroup() *schema.Resource {
 				Optional:    true,
 				Default:     false,
 			},
+
+			"wait_for_rollout": {
+				Type:        schema.TypeList,
+				Description: "Wait after the creation and every update until all instances run the current instance template and pass the health checks. The apply fails with the IDs and status messages of the instances that are not up to date if the rollout is not completed before the timeout.",
+				Optional:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"timeout": {
+							Type:         schema.TypeString,
+							Description:  "Maximum time to wait for the rollout, e.g. `15m`. The default is `30m`.",
+							Optional:     true,
+							Default:      yandexComputeInstanceGroupRolloutDefaultTimeout,
+							ValidateFunc: validateParsableValue(parsePositiveDuration),
+						},
+					},
+				},
+			},
+
+			"rollout_status": {
+				Type:        schema.TypeList,
+				Description: "Summary of the rollout of the instance template to the managed instances.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"target_size": {
+							Type:        schema.TypeInt,
+							Description: "Target number of instances in the group.",
+							Computed:    true,
+						},
+						"running_actual_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of running instances that match the current instance template.",
+							Computed:    true,
+						},
+						"running_outdated_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of running instances that don't match the current instance template.",
+							Computed:    true,
+						},
+						"processing_count": {
+							Type:        schema.TypeInt,
+							Description: "Number of instances that are being created, updated, checked or deleted.",
+							Computed:    true,
+						},
+						"status_counts": {
+							Type:        schema.TypeMap,
+							Description: "Number of managed instances by their status.",
+							Computed:    true,
+							Elem:        &schema.Schema{Type: schema.TypeInt},
+						},
+					},
+				},
+			},
 		},
 	}
 }
@@ -1296,6 +1381,10 @@ func resourceYandexComputeInstanceGroupCreate(d *schema.ResourceData, meta inter
 
 	d.SetId(instanceGroup.Id)
 
+	if err := waitForInstanceGroupRollout(d, config); err != nil {
+		return err
+	}
+
 	return resourceYandexComputeInstanceGroupRead(d, meta)
 }
 
@@ -1413,12 +1502,21 @@ func flattenInstanceGroup(d *schema.ResourceData, instanceGroup *instancegroup.I
 		return err
 	}
 
+	if err := d.Set("rollout_status", flattenInstanceGroupRolloutStatus(instanceGroup, instances)); err != nil {
+		return err
+	}
+
 	return d.Set("health_check", healthChecks)
 }
 
 func resourceYandexComputeInstanceGroupUpdate(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
+	// wait_for_rollout affects only the provider
+	if !d.HasChangeExcept("wait_for_rollout") {
+		return resourceYandexComputeInstanceGroupRead(d, meta)
+	}
+
 	req, err := prepareUpdateInstanceGroupRequest(d, config)
 	if err != nil {
 		return err
@@ -1429,6 +1527,10 @@ func resourceYandexComputeInstanceGroupUpdate(d *schema.ResourceData, meta inter
 		return err
 	}
 
+	if err := waitForInstanceGroupRollout(d, config); err != nil {
+		return err
+	}
+
 	return resourceYandexComputeInstanceGroupRead(d, meta)
 }
 
@@ -1660,3 +1762,20 @@ func makeInstanceGroupUpdateRequest(req *instancegroup.UpdateInstanceGroupReques
 
 	return nil
 }
+
+func resourceYandexComputeInstanceGroupHostAffinityDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() != "" && !d.HasChanges("instance_template.0.placement_policy", "allocation_policy.0.zones") {
+		return nil
+	}
+
+	var zones []string
+	if set, ok := d.Get("allocation_policy.0.zones").(*schema.Set); ok {
+		for _, zone := range set.List() {
+			zones = append(zones, zone.(string))
+		}
+	}
+
+	config, _ := meta.(*Config)
+	rules, _ := d.Get("instance_template.0.placement_policy.0.host_affinity_rules").([]interface{})
+	return validateHostAffinityRules(ctx, config, rules, zones)
+}
@@ -0,0 +1,139 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1/instancegroup"
+)
+
+const (
+	yandexComputeInstanceGroupRolloutDefaultTimeout = "30m"
+	yandexComputeInstanceGroupRolloutPollInterval   = 15 * time.Second
+)
+
+// waitForInstanceGroupRollout polls the managed instances of the group until all of them
+// run the current instance template and pass the health checks.
+func waitForInstanceGroupRollout(d *schema.ResourceData, config *Config) error {
+	if _, ok := d.GetOk("wait_for_rollout"); !ok {
+		return nil
+	}
+
+	timeout, err := time.ParseDuration(d.Get("wait_for_rollout.0.timeout").(string))
+	if err != nil {
+		return fmt.Errorf("Error parsing 'wait_for_rollout.0.timeout': %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(config.Context(), timeout)
+	defer cancel()
+
+	log.Printf("[DEBUG] Waiting for rollout of Instance group %q", d.Id())
+
+	var pending []*instancegroup.ManagedInstance
+	for {
+		instanceGroup, instances, err := getInstanceGroupWithInstances(ctx, config, d.Id())
+		if err == nil {
+			pending = instanceGroupRolloutPendingInstances(instances)
+			runningActual := int64(len(instances) - len(pending))
+
+			switch instanceGroup.GetStatus() {
+			case instancegroup.InstanceGroup_STOPPED, instancegroup.InstanceGroup_PAUSED:
+				return fmt.Errorf("Rollout of Instance group %q can't be completed, the group is %s%s",
+					d.Id(), instanceGroup.GetStatus(), formatInstanceGroupRolloutFailures(pending))
+			case instancegroup.InstanceGroup_ACTIVE:
+				if len(pending) == 0 && runningActual >= instanceGroup.GetManagedInstancesState().GetTargetSize() {
+					log.Printf("[DEBUG] Rollout of Instance group %q is completed", d.Id())
+					return nil
+				}
+			}
+
+			log.Printf("[DEBUG] Rollout of Instance group %q is in progress: %d of %d instances are up to date",
+				d.Id(), runningActual, instanceGroup.GetManagedInstancesState().GetTargetSize())
+		} else if ctx.Err() == nil {
+			log.Printf("[WARN] Error while requesting API to get Instance group %q: %s", d.Id(), err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("Timeout while waiting for rollout of Instance group %q%s",
+				d.Id(), formatInstanceGroupRolloutFailures(pending))
+		case <-time.After(yandexComputeInstanceGroupRolloutPollInterval):
+		}
+	}
+}
+
+func getInstanceGroupWithInstances(ctx context.Context, config *Config, instanceGroupID string) (*instancegroup.InstanceGroup, []*instancegroup.ManagedInstance, error) {
+	instanceGroup, err := config.sdk.InstanceGroup().InstanceGroup().Get(ctx, &instancegroup.GetInstanceGroupRequest{
+		InstanceGroupId: instanceGroupID,
+	})
+	if err != nil {
+		return nil, nil, err
+	}
+
+	instances, err := config.sdk.InstanceGroup().InstanceGroup().InstanceGroupInstancesIterator(ctx, &instancegroup.ListInstanceGroupInstancesRequest{
+		InstanceGroupId: instanceGroupID,
+	}).TakeAll()
+	if err != nil {
+		return nil, nil, err
+	}
+
+	return instanceGroup, instances, nil
+}
+
+// instanceGroupRolloutPendingInstances returns the instances which don't run the current template
+// or haven't passed the health checks yet.
+func instanceGroupRolloutPendingInstances(instances []*instancegroup.ManagedInstance) []*instancegroup.ManagedInstance {
+	var pending []*instancegroup.ManagedInstance
+	for _, instance := range instances {
+		if instance.GetStatus() != instancegroup.ManagedInstance_RUNNING_ACTUAL {
+			pending = append(pending, instance)
+		}
+	}
+	return pending
+}
+
+func formatInstanceGroupRolloutFailures(instances []*instancegroup.ManagedInstance) string {
+	if len(instances) == 0 {
+		return ""
+	}
+
+	var b strings.Builder
+	b.WriteString(", instances not up to date:")
+	for _, instance := range instances {
+		id := instance.GetInstanceId()
+		if id == "" {
+			id = instance.GetName()
+		}
+		fmt.Fprintf(&b, "\n  %s (%s)", id, instance.GetStatus())
+		if instance.GetStatusMessage() != "" {
+			fmt.Fprintf(&b, ": %s", instance.GetStatusMessage())
+		}
+	}
+	return b.String()
+}
+
+func flattenInstanceGroupRolloutStatus(instanceGroup *instancegroup.InstanceGroup, instances []*instancegroup.ManagedInstance) []map[string]interface{} {
+	state := instanceGroup.GetManagedInstancesState()
+
+	statusCounts := make(map[string]interface{})
+	for _, instance := range instances {
+		status := instance.GetStatus().String()
+		if count, ok := statusCounts[status]; ok {
+			statusCounts[status] = count.(int) + 1
+		} else {
+			statusCounts[status] = 1
+		}
+	}
+
+	return []map[string]interface{}{{
+		"target_size":            int(state.GetTargetSize()),
+		"running_actual_count":   int(state.GetRunningActualCount()),
+		"running_outdated_count": int(state.GetRunningOutdatedCount()),
+		"processing_count":       int(state.GetProcessingCount()),
+		"status_counts":          statusCounts,
+	}}
+}
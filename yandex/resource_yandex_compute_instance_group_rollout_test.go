@@ -0,0 +1,71 @@
+package yandex
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1/instancegroup"
+)
+
+func testRolloutInstances() []*instancegroup.ManagedInstance {
+	return []*instancegroup.ManagedInstance{
+		{InstanceId: "instance-1", Status: instancegroup.ManagedInstance_RUNNING_ACTUAL},
+		{InstanceId: "instance-2", Status: instancegroup.ManagedInstance_RUNNING_OUTDATED},
+		{Name: "instance-3", Status: instancegroup.ManagedInstance_CHECKING_HEALTH, StatusMessage: "Health check failed"},
+		{InstanceId: "instance-4", Status: instancegroup.ManagedInstance_RUNNING_ACTUAL},
+	}
+}
+
+func TestInstanceGroupRolloutPendingInstances(t *testing.T) {
+	pending := instanceGroupRolloutPendingInstances(testRolloutInstances())
+	if len(pending) != 2 {
+		t.Fatalf("instanceGroupRolloutPendingInstances() returned %d instances, want 2", len(pending))
+	}
+	if pending[0].GetInstanceId() != "instance-2" || pending[1].GetName() != "instance-3" {
+		t.Errorf("instanceGroupRolloutPendingInstances() returned unexpected instances: %v", pending)
+	}
+
+	if pending := instanceGroupRolloutPendingInstances(nil); len(pending) != 0 {
+		t.Errorf("instanceGroupRolloutPendingInstances(nil) = %v, want empty", pending)
+	}
+}
+
+func TestFormatInstanceGroupRolloutFailures(t *testing.T) {
+	if result := formatInstanceGroupRolloutFailures(nil); result != "" {
+		t.Errorf("formatInstanceGroupRolloutFailures(nil) = %q, want empty", result)
+	}
+
+	expected := ", instances not up to date:\n  instance-2 (RUNNING_OUTDATED)\n  instance-3 (CHECKING_HEALTH): Health check failed"
+	result := formatInstanceGroupRolloutFailures(instanceGroupRolloutPendingInstances(testRolloutInstances()))
+	if result != expected {
+		t.Errorf("formatInstanceGroupRolloutFailures() = %q, want %q", result, expected)
+	}
+}
+
+func TestFlattenInstanceGroupRolloutStatus(t *testing.T) {
+	instanceGroup := &instancegroup.InstanceGroup{
+		ManagedInstancesState: &instancegroup.ManagedInstancesState{
+			TargetSize:           4,
+			RunningActualCount:   2,
+			RunningOutdatedCount: 1,
+			ProcessingCount:      1,
+		},
+	}
+
+	expected := []map[string]interface{}{{
+		"target_size":            4,
+		"running_actual_count":   2,
+		"running_outdated_count": 1,
+		"processing_count":       1,
+		"status_counts": map[string]interface{}{
+			"RUNNING_ACTUAL":   2,
+			"RUNNING_OUTDATED": 1,
+			"CHECKING_HEALTH":  1,
+		},
+	}}
+
+	result := flattenInstanceGroupRolloutStatus(instanceGroup, testRolloutInstances())
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("flattenInstanceGroupRolloutStatus() = %v, want %v", result, expected)
+	}
+}
@@ -3,6 +3,7 @@ package yandex
 import (
 	"context"
 	"fmt"
+	"strings"
 	"testing"
 
 	"github.com/hashicorp/go-multierror"
@@ -157,9 +158,10 @@ func updateComputeInstanceGroupWithSweeperDeps(conf *Config, status instancegrou
 
 func computeInstanceGroupImportStep() resource.TestStep {
 	return resource.TestStep{
-		ResourceName:      "yandex_compute_instance_group.group1",
-		ImportState:       true,
-		ImportStateVerify: true,
+		ResourceName:            "yandex_compute_instance_group.group1",
+		ImportState:             true,
+		ImportStateVerify:       true,
+		ImportStateVerifyIgnore: []string{"wait_for_rollout"},
 	}
 }
 
@@ -188,6 +190,43 @@ func TestAccComputeInstanceGroup_basic(t *testing.T) {
 
 }
 
+func TestAccComputeInstanceGroup_waitForRollout(t *testing.T) {
+	t.Parallel()
+
+	var ig instancegroup.InstanceGroup
+
+	name := acctest.RandomWithPrefix("tf-test")
+	saName := acctest.RandomWithPrefix("tf-test")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceGroupDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstanceGroupConfigWaitForRollout(name, saName, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceGroupExists("yandex_compute_instance_group.group1", &ig),
+					resource.TestCheckResourceAttr("yandex_compute_instance_group.group1", "rollout_status.0.target_size", "2"),
+					resource.TestCheckResourceAttr("yandex_compute_instance_group.group1", "rollout_status.0.running_actual_count", "2"),
+					resource.TestCheckResourceAttr("yandex_compute_instance_group.group1", "rollout_status.0.status_counts.RUNNING_ACTUAL", "2"),
+				),
+			},
+			{
+				Config: testAccComputeInstanceGroupConfigWaitForRollout(name, saName, 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceGroupExists("yandex_compute_instance_group.group1", &ig),
+					resource.TestCheckResourceAttr("yandex_compute_instance_group.group1", "rollout_status.0.running_actual_count", "2"),
+					resource.TestCheckResourceAttr("yandex_compute_instance_group.group1", "rollout_status.0.running_outdated_count", "0"),
+					resource.TestCheckResourceAttr("yandex_compute_instance_group.group1", "instances.0.status", "RUNNING_ACTUAL"),
+					resource.TestCheckResourceAttr("yandex_compute_instance_group.group1", "instances.1.status", "RUNNING_ACTUAL"),
+				),
+			},
+			computeInstanceGroupImportStep(),
+		},
+	})
+}
+
 func TestAccComputeInstanceGroup_Gpus(t *testing.T) {
 	var ig instancegroup.InstanceGroup
 
@@ -305,9 +344,9 @@ func TestAccComputeInstanceGroup_full(t *testing.T) {
 	fsName2 := acctest.RandomWithPrefix("tf-test")
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckComputeInstanceGroupDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckComputeInstanceGroupDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccComputeInstanceGroupConfigFull(name, saName, sgName, fsName1, fsName2),
@@ -791,6 +830,16 @@ resource "yandex_resourcemanager_folder_iam_member" "test_account" {
 `, getExampleFolderID(), igName, saName)
 }
 
+func testAccComputeInstanceGroupConfigWaitForRollout(igName string, saName string, memory int) string {
+	config := testAccComputeInstanceGroupConfigMain(igName, saName)
+	config = strings.Replace(config, "memory = 2", fmt.Sprintf("memory = %d", memory), 1)
+	return strings.Replace(config, "  deploy_policy {", `  wait_for_rollout {
+    timeout = "20m"
+  }
+
+  deploy_policy {`, 1)
+}
+
 func testAccComputeInstanceGroupConfigWithFilesystemSpecs(igName string, saName string, fs1Name string, fs2Name string) string {
 	return fmt.Sprintf(`
 data "yandex_compute_image" "ubuntu" {
@@ -0,0 +1,461 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"strconv"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"google.golang.org/genproto/protobuf/field_mask"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
+)
+
+const yandexComputeInstanceNetworkInterfaceDefaultTimeout = 10 * time.Minute
+
+func resourceYandexComputeInstanceNetworkInterface() *schema.Resource {
+	return &schema.Resource{
+		Description: "Attaches an additional network interface to a VM instance. The instance must be stopped to attach or detach a network interface. For more information, see [the official documentation](https://yandex.cloud/docs/compute/concepts/network).\n\n" +
+			"~> Set `external_network_interfaces = true` on the `yandex_compute_instance`, otherwise the instance detaches the interfaces attached with this resource. Don't declare the same interface index in both places.\n",
+
+		CreateContext: resourceYandexComputeInstanceNetworkInterfaceCreate,
+		ReadContext:   resourceYandexComputeInstanceNetworkInterfaceRead,
+		UpdateContext: resourceYandexComputeInstanceNetworkInterfaceUpdate,
+		DeleteContext: resourceYandexComputeInstanceNetworkInterfaceDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexComputeInstanceNetworkInterfaceImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexComputeInstanceNetworkInterfaceDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexComputeInstanceNetworkInterfaceDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexComputeInstanceNetworkInterfaceDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"instance_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the instance to attach the network interface to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"index": {
+				Type:         schema.TypeInt,
+				Description:  "Index of the network interface. The first interface (index `0`) always belongs to the instance.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IntAtLeast(1),
+			},
+
+			"subnet_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the subnet to attach this interface to. The subnet must exist in the same zone as the instance.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"ipv4": {
+				Type:        schema.TypeBool,
+				Description: "Allocate an IPv4 address for the interface. The default value is `true`.",
+				Optional:    true,
+				Default:     true,
+				ForceNew:    true,
+			},
+
+			"ip_address": {
+				Type:        schema.TypeString,
+				Description: "The private IP address to assign to the interface. If empty, the address will be automatically assigned from the specified subnet.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+
+			"nat": {
+				Type:        schema.TypeBool,
+				Description: "Provide a public address to access the internet over NAT.",
+				Optional:    true,
+				Default:     false,
+			},
+
+			"nat_ip_address": {
+				Type:        schema.TypeString,
+				Description: "Public address to use for NAT. Address should be already reserved.",
+				Optional:    true,
+				Computed:    true,
+			},
+
+			"nat_ip_version": {
+				Type:        schema.TypeString,
+				Description: "IP version of the public address.",
+				Computed:    true,
+			},
+
+			"security_group_ids": {
+				Type:        schema.TypeSet,
+				Description: "Security Group (SG) IDs for the network interface.",
+				Optional:    true,
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+
+			"dns_record": {
+				Type:        schema.TypeList,
+				Description: "List of configurations for creating ipv4 DNS records.",
+				Optional:    true,
+				Elem:        computeInstanceNetworkInterfaceDnsRecordSchema(),
+			},
+
+			"nat_dns_record": {
+				Type:        schema.TypeList,
+				Description: "List of configurations for creating ipv4 NAT DNS records.",
+				Optional:    true,
+				Elem:        computeInstanceNetworkInterfaceDnsRecordSchema(),
+			},
+
+			"mac_address": {
+				Type:        schema.TypeString,
+				Description: "MAC address of the network interface.",
+				Computed:    true,
+			},
+
+			"allow_stopping_for_update": {
+				Type:        schema.TypeBool,
+				Description: "If `true`, allows Terraform to stop the running instance to attach or detach the network interface and start it afterwards. Otherwise the instance must be stopped.",
+				Optional:    true,
+				Default:     false,
+			},
+		},
+	}
+}
+
+func computeInstanceNetworkInterfaceDnsRecordSchema() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"fqdn": {
+				Type:        schema.TypeString,
+				Description: "DNS record FQDN (must have a dot at the end).",
+				Required:    true,
+			},
+			"dns_zone_id": {
+				Type:        schema.TypeString,
+				Description: "DNS zone ID (if not set, private zone used).",
+				Optional:    true,
+			},
+			"ttl": {
+				Type:        schema.TypeInt,
+				Description: "DNS record TTL in seconds.",
+				Optional:    true,
+			},
+			"ptr": {
+				Type:        schema.TypeBool,
+				Description: "When set to `true`, also create a PTR DNS record.",
+				Optional:    true,
+			},
+		},
+	}
+}
+
+func makeComputeInstanceNetworkInterfaceID(instanceID string, index int) string {
+	return fmt.Sprintf("%s/%d", instanceID, index)
+}
+
+func parseComputeInstanceNetworkInterfaceID(id string) (string, int, error) {
+	parts := strings.Split(id, "/")
+	if len(parts) != 2 || parts[0] == "" {
+		return "", 0, fmt.Errorf("invalid network interface ID %q, expected format is instance_id/index", id)
+	}
+	index, err := strconv.Atoi(parts[1])
+	if err != nil || index < 1 {
+		return "", 0, fmt.Errorf("invalid network interface ID %q, index must be a positive number", id)
+	}
+	return parts[0], index, nil
+}
+
+func expandComputeInstanceNetworkInterfaceConfig(d *schema.ResourceData) map[string]interface{} {
+	return map[string]interface{}{
+		"subnet_id":          d.Get("subnet_id"),
+		"ipv4":               d.Get("ipv4"),
+		"ip_address":         d.Get("ip_address"),
+		"ipv6":               false,
+		"ipv6_address":       "",
+		"nat":                d.Get("nat"),
+		"nat_ip_address":     d.Get("nat_ip_address"),
+		"security_group_ids": d.Get("security_group_ids"),
+		"dns_record":         d.Get("dns_record"),
+		"nat_dns_record":     d.Get("nat_dns_record"),
+	}
+}
+
+func resourceYandexComputeInstanceNetworkInterfaceCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	instanceID := d.Get("instance_id").(string)
+	index := d.Get("index").(int)
+
+	spec, err := expandNetworkInterfaceSpec(expandComputeInstanceNetworkInterfaceConfig(d))
+	if err != nil {
+		return diag.Errorf("Error expanding network interface for Instance %q: %s", instanceID, err)
+	}
+
+	// Operations with network interfaces of the same instance can't be run concurrently
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(instanceID)
+	defer mutexKV.Unlock(instanceID)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	req := &compute.AttachInstanceNetworkInterfaceRequest{
+		InstanceId:            instanceID,
+		NetworkInterfaceIndex: strconv.Itoa(index),
+		SubnetId:              spec.GetSubnetId(),
+		PrimaryV4AddressSpec:  spec.GetPrimaryV4AddressSpec(),
+		SecurityGroupIds:      spec.GetSecurityGroupIds(),
+	}
+
+	err = runOnStoppedInstance(ctx, d, meta, instanceID, d.Timeout(schema.TimeoutCreate), func() error {
+		log.Printf("[DEBUG] Attaching network interface #%d to Instance %q", index, instanceID)
+
+		op, err := config.sdk.WrapOperation(config.sdk.Compute().Instance().AttachNetworkInterface(ctx, req))
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to attach network interface #%d to Instance %q: %s", index, instanceID, err)
+		}
+
+		d.SetId(makeComputeInstanceNetworkInterfaceID(instanceID, index))
+
+		if err = op.Wait(ctx); err != nil {
+			d.SetId("")
+			return fmt.Errorf("Error while waiting operation to attach network interface #%d to Instance %q: %s", index, instanceID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceYandexComputeInstanceNetworkInterfaceRead(ctx, d, meta)
+}
+
+func resourceYandexComputeInstanceNetworkInterfaceRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	instanceID, index, err := parseComputeInstanceNetworkInterfaceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	instance, err := config.sdk.Compute().Instance().Get(ctx, &compute.GetInstanceRequest{
+		InstanceId: instanceID,
+	})
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("Instance %q", instanceID)))
+	}
+
+	nics, _, _, err := flattenInstanceNetworkInterfaces(instance)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	var nic map[string]interface{}
+	for _, n := range nics {
+		if n["index"].(int) == index {
+			nic = n
+			break
+		}
+	}
+	if nic == nil {
+		log.Printf("[WARN] Network interface #%d is not attached to Instance %q, removing it from state", index, instanceID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("instance_id", instanceID)
+	d.Set("index", index)
+	d.Set("subnet_id", nic["subnet_id"])
+	d.Set("ipv4", nic["ipv4"])
+	d.Set("ip_address", nic["ip_address"])
+	d.Set("nat", nic["nat"])
+	d.Set("nat_ip_address", nic["nat_ip_address"])
+	d.Set("nat_ip_version", nic["nat_ip_version"])
+	d.Set("mac_address", nic["mac_address"])
+
+	if err := d.Set("security_group_ids", nic["security_group_ids"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("dns_record", nic["dns_record"]); err != nil {
+		return diag.FromErr(err)
+	}
+	if err := d.Set("nat_dns_record", nic["nat_dns_record"]); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceYandexComputeInstanceNetworkInterfaceUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	instanceID, index, err := parseComputeInstanceNetworkInterfaceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(instanceID)
+	defer mutexKV.Unlock(instanceID)
+
+	newV4Spec, err := expandPrimaryV4AddressSpec(expandComputeInstanceNetworkInterfaceConfig(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	if d.HasChanges("nat", "nat_ip_address") {
+		if oldNat, _ := d.GetChange("nat"); oldNat.(bool) {
+			err := makeInstanceRemoveOneToOneNatRequest(&compute.RemoveInstanceOneToOneNatRequest{
+				InstanceId:            instanceID,
+				NetworkInterfaceIndex: strconv.Itoa(index),
+			}, d, meta)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+
+		if newV4Spec.GetOneToOneNatSpec() != nil {
+			err := makeInstanceAddOneToOneNatRequest(&compute.AddInstanceOneToOneNatRequest{
+				InstanceId:            instanceID,
+				NetworkInterfaceIndex: strconv.Itoa(index),
+				OneToOneNatSpec:       newV4Spec.GetOneToOneNatSpec(),
+			}, d, meta)
+			if err != nil {
+				return diag.FromErr(err)
+			}
+		}
+	}
+
+	req := &compute.UpdateInstanceNetworkInterfaceRequest{
+		InstanceId:            instanceID,
+		NetworkInterfaceIndex: strconv.Itoa(index),
+		PrimaryV4AddressSpec:  newV4Spec,
+		UpdateMask: &field_mask.FieldMask{
+			Paths: []string{},
+		},
+	}
+
+	if d.HasChange("dns_record") {
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "primary_v4_address_spec.dns_record_specs")
+	}
+
+	// NAT DNS records of a re-created NAT are already set by the add request
+	if d.HasChange("nat_dns_record") && !d.HasChanges("nat", "nat_ip_address") {
+		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "primary_v4_address_spec.one_to_one_nat_spec.dns_record_specs")
+	}
+
+	if d.HasChange("security_group_ids") {
+		oldSgs, newSgs := d.GetChange("security_group_ids")
+		if !reflect.DeepEqual(expandSecurityGroupIds(oldSgs), expandSecurityGroupIds(newSgs)) {
+			req.SecurityGroupIds = expandSecurityGroupIds(newSgs)
+			req.UpdateMask.Paths = append(req.UpdateMask.Paths, "security_group_ids")
+		}
+	}
+
+	if len(req.UpdateMask.Paths) > 0 {
+		if err := makeInstanceUpdateNetworkInterfaceRequest(req, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return resourceYandexComputeInstanceNetworkInterfaceRead(ctx, d, meta)
+}
+
+func resourceYandexComputeInstanceNetworkInterfaceDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	instanceID, index, err := parseComputeInstanceNetworkInterfaceID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(instanceID)
+	defer mutexKV.Unlock(instanceID)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	err = runOnStoppedInstance(ctx, d, meta, instanceID, d.Timeout(schema.TimeoutDelete), func() error {
+		log.Printf("[DEBUG] Detaching network interface #%d from Instance %q", index, instanceID)
+
+		op, err := config.sdk.WrapOperation(config.sdk.Compute().Instance().DetachNetworkInterface(ctx, &compute.DetachInstanceNetworkInterfaceRequest{
+			InstanceId:            instanceID,
+			NetworkInterfaceIndex: strconv.Itoa(index),
+		}))
+		if err != nil {
+			return handleNotFoundError(err, d, fmt.Sprintf("Network interface #%d of Instance %q", index, instanceID))
+		}
+
+		if err = op.Wait(ctx); err != nil {
+			return fmt.Errorf("Error while waiting operation to detach network interface #%d from Instance %q: %s", index, instanceID, err)
+		}
+		return nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	log.Printf("[DEBUG] Finished detaching network interface #%d from Instance %q", index, instanceID)
+	return nil
+}
+
+func resourceYandexComputeInstanceNetworkInterfaceImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := parseComputeInstanceNetworkInterfaceID(d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+// runOnStoppedInstance runs fn on the stopped instance. The running instance is stopped before and
+// started after fn, even a failed one, if allow_stopping_for_update is set, otherwise an error is returned.
+func runOnStoppedInstance(ctx context.Context, d *schema.ResourceData, meta interface{}, instanceID string, timeout time.Duration, fn func() error) error {
+	config := meta.(*Config)
+
+	instance, err := config.sdk.Compute().Instance().Get(ctx, &compute.GetInstanceRequest{
+		InstanceId: instanceID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to get Instance %q: %s", instanceID, err)
+	}
+
+	running := instance.GetStatus() == compute.Instance_RUNNING
+	if running {
+		if !d.Get("allow_stopping_for_update").(bool) {
+			return fmt.Errorf("Instance %q must be stopped to attach or detach a network interface, "+
+				"set allow_stopping_for_update = true to let Terraform stop it", instanceID)
+		}
+		if err := makeInstanceActionRequestByID(instanceActionStop, instanceID, timeout, meta); err != nil {
+			return err
+		}
+	}
+
+	err = fn()
+
+	// The instance is started even if fn failed, so it isn't left stopped
+	if running {
+		if startErr := makeInstanceActionRequestByID(instanceActionStart, instanceID, timeout, meta); startErr != nil {
+			if err != nil {
+				return fmt.Errorf("%s, then failed to start Instance %q: %s", err, instanceID, startErr)
+			}
+			return startErr
+		}
+	}
+	return err
+}
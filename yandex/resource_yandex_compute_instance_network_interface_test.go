@@ -0,0 +1,144 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+const computeInstanceNetworkInterfaceResource = "yandex_compute_instance_network_interface.foobar"
+
+func TestParseComputeInstanceNetworkInterfaceID(t *testing.T) {
+	instanceID, index, err := parseComputeInstanceNetworkInterfaceID(makeComputeInstanceNetworkInterfaceID("fhm1", 2))
+	if err != nil || instanceID != "fhm1" || index != 2 {
+		t.Fatalf("Unexpected result of parsing: %q, %d, %v", instanceID, index, err)
+	}
+
+	for _, id := range []string{"", "fhm1", "fhm1/", "/1", "fhm1/0", "fhm1/-1", "fhm1/a", "fhm1/1/2"} {
+		if _, _, err := parseComputeInstanceNetworkInterfaceID(id); err == nil {
+			t.Errorf("Parsing of %q should fail", id)
+		}
+	}
+}
+
+func TestAccComputeInstanceNetworkInterface_basic(t *testing.T) {
+	t.Parallel()
+
+	var instance compute.Instance
+	instanceName := fmt.Sprintf("instance-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstanceNetworkInterface_basic(instanceName, false),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(instanceResource, &instance),
+					testAccCheckComputeInstanceNetworkInterfaceCount(&instance, 2),
+					resource.TestCheckResourceAttr(computeInstanceNetworkInterfaceResource, "index", "1"),
+					resource.TestCheckResourceAttr(computeInstanceNetworkInterfaceResource, "ip_address", "192.168.1.10"),
+					resource.TestCheckResourceAttr(computeInstanceNetworkInterfaceResource, "nat", "false"),
+					resource.TestCheckResourceAttrSet(computeInstanceNetworkInterfaceResource, "mac_address"),
+					// the interface is managed by the attachment, so the instance doesn't see it
+					resource.TestCheckResourceAttr(instanceResource, "network_interface.#", "1"),
+				),
+			},
+			{
+				Config: testAccComputeInstanceNetworkInterface_basic(instanceName, true),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr(computeInstanceNetworkInterfaceResource, "nat", "true"),
+					resource.TestCheckResourceAttrSet(computeInstanceNetworkInterfaceResource, "nat_ip_address"),
+					resource.TestCheckResourceAttr(instanceResource, "network_interface.#", "1"),
+				),
+			},
+			{
+				ResourceName:            computeInstanceNetworkInterfaceResource,
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"allow_stopping_for_update"},
+			},
+			{
+				Config: testAccComputeInstanceNetworkInterface_instance(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(instanceResource, &instance),
+					testAccCheckComputeInstanceNetworkInterfaceCount(&instance, 1),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeInstanceNetworkInterfaceCount(instance *compute.Instance, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(instance.NetworkInterfaces) != count {
+			return fmt.Errorf("Instance should have %d network interfaces, got %d", count, len(instance.NetworkInterfaces))
+		}
+		return nil
+	}
+}
+
+//revive:disable:var-naming
+func testAccComputeInstanceNetworkInterface_instance(instance string) string {
+	return fmt.Sprintf(`
+data "yandex_compute_image" "ubuntu" {
+  family = "ubuntu-1804-lts"
+}
+
+resource "yandex_compute_instance" "foobar" {
+  name        = "%s"
+  zone        = "ru-central1-a"
+  platform_id = "standard-v2"
+
+  external_network_interfaces = true
+
+  resources {
+    cores  = 2
+    memory = 2
+  }
+
+  boot_disk {
+    initialize_params {
+      image_id = "${data.yandex_compute_image.ubuntu.id}"
+    }
+  }
+
+  network_interface {
+    subnet_id = "${yandex_vpc_subnet.inst-test-subnet.id}"
+  }
+}
+
+resource "yandex_vpc_network" "inst-test-network" {}
+
+resource "yandex_vpc_subnet" "inst-test-subnet" {
+  zone           = "ru-central1-a"
+  network_id     = "${yandex_vpc_network.inst-test-network.id}"
+  v4_cidr_blocks = ["192.168.0.0/24"]
+}
+
+resource "yandex_vpc_subnet" "inst-test-subnet-2" {
+  zone           = "ru-central1-a"
+  network_id     = "${yandex_vpc_network.inst-test-network.id}"
+  v4_cidr_blocks = ["192.168.1.0/24"]
+}
+`, instance)
+}
+
+func testAccComputeInstanceNetworkInterface_basic(instance string, nat bool) string {
+	return testAccComputeInstanceNetworkInterface_instance(instance) + fmt.Sprintf(`
+resource "yandex_compute_instance_network_interface" "foobar" {
+  instance_id = "${yandex_compute_instance.foobar.id}"
+  index       = 1
+  subnet_id   = "${yandex_vpc_subnet.inst-test-subnet-2.id}"
+  ip_address  = "192.168.1.10"
+  nat         = %t
+
+  allow_stopping_for_update = true
+}
+`, nat)
+}
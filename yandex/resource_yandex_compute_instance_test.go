@@ -5,6 +5,7 @@ import (
 	"fmt"
 	"log"
 	"os"
+	"reflect"
 	"regexp"
 	"strconv"
 	"testing"
@@ -74,7 +75,7 @@ func computeInstanceImportStep() resource.TestStep {
 		ResourceName:            instanceResource,
 		ImportState:             true,
 		ImportStateVerify:       true,
-		ImportStateVerifyIgnore: []string{"allow_stopping_for_update"},
+		ImportStateVerifyIgnore: []string{"allow_stopping_for_update", "desired_status", "wait_for"},
 	}
 }
 
@@ -265,9 +266,9 @@ func TestAccComputeInstance_SecurityGroups(t *testing.T) {
 	var instanceName = fmt.Sprintf("instance-test-%s", acctest.RandString(10))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckComputeInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccComputeInstance_SecurityGroups(instanceName),
@@ -568,9 +569,9 @@ func TestAccComputeInstance_update(t *testing.T) {
 	var instanceName = fmt.Sprintf("instance-test-%s", acctest.RandString(10))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckComputeInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccComputeInstance_basic(instanceName),
@@ -731,9 +732,9 @@ func TestAccComputeInstance_stopInstanceToUpdateAttachDetachNetworkIfaces(t *tes
 	var instanceName = fmt.Sprintf("instance-test-%s", acctest.RandString(10))
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckComputeInstanceDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccComputeInstance_stopInstanceToUpdate_attach_detach_NetworkInterfaces(instanceName),
@@ -1084,6 +1085,228 @@ func TestAccComputeInstance_nat(t *testing.T) {
 	})
 }
 
+func TestAccComputeInstance_desiredStatus(t *testing.T) {
+	t.Parallel()
+
+	var instance compute.Instance
+	var instanceName = fmt.Sprintf("instance-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstance_desiredStatus(instanceName, "stopped", 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(instanceResource, &instance),
+					resource.TestCheckResourceAttr(instanceResource, "status", "stopped"),
+				),
+			},
+			{
+				// updates which require stopping shouldn't start the stopped instance
+				Config: testAccComputeInstance_desiredStatus(instanceName, "stopped", 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(instanceResource, &instance),
+					testAccCheckComputeInstanceHasResources(&instance, 2, 100, 4),
+					resource.TestCheckResourceAttr(instanceResource, "status", "stopped"),
+				),
+			},
+			{
+				Config: testAccComputeInstance_desiredStatus(instanceName, "running", 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(instanceResource, &instance),
+					resource.TestCheckResourceAttr(instanceResource, "status", "running"),
+				),
+			},
+			{
+				// the instance stopped outside of Terraform is started by the apply without other changes
+				PreConfig: testAccStopComputeInstance(t, &instance),
+				Config:    testAccComputeInstance_desiredStatus(instanceName, "running", 4),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(instanceResource, &instance),
+					resource.TestCheckResourceAttr(instanceResource, "status", "running"),
+				),
+			},
+			computeInstanceImportStep(),
+		},
+	})
+}
+
+func TestAccComputeInstance_waitFor(t *testing.T) {
+	t.Parallel()
+
+	var instance compute.Instance
+	var instanceName = fmt.Sprintf("instance-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeInstanceDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeInstance_waitFor(instanceName),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeInstanceExists(instanceResource, &instance),
+					resource.TestCheckResourceAttr(instanceResource, "wait_for.0.timeout", "10m"),
+					resource.TestCheckResourceAttr(instanceResource, "status", "running"),
+				),
+			},
+			computeInstanceImportStep(),
+		},
+	})
+}
+
+func TestSerialOutputTail(t *testing.T) {
+	cases := []struct {
+		name     string
+		output   string
+		lines    int
+		expected string
+	}{
+		{
+			name:     "empty output",
+			output:   "",
+			lines:    2,
+			expected: "",
+		},
+		{
+			name:     "short output",
+			output:   "first\nsecond\n",
+			lines:    5,
+			expected: "first\nsecond",
+		},
+		{
+			name:     "long output",
+			output:   "first\nsecond\nthird\r\n",
+			lines:    2,
+			expected: "second\nthird",
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if result := serialOutputTail(tc.output, tc.lines); result != tc.expected {
+				t.Errorf("serialOutputTail() = %q, want %q", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestComputeInstanceShouldBeRunning(t *testing.T) {
+	cases := []struct {
+		name          string
+		desiredStatus string
+		status        compute.Instance_Status
+		expected      bool
+	}{
+		{name: "running without desired status", status: compute.Instance_RUNNING, expected: true},
+		{name: "stopped without desired status", status: compute.Instance_STOPPED, expected: false},
+		{name: "stopped instance desired running", desiredStatus: "running", status: compute.Instance_STOPPED, expected: true},
+		{name: "running instance desired stopped", desiredStatus: "stopped", status: compute.Instance_RUNNING, expected: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			raw := map[string]interface{}{}
+			if tc.desiredStatus != "" {
+				raw["desired_status"] = tc.desiredStatus
+			}
+			d := schema.TestResourceDataRaw(t, resourceYandexComputeInstance().Schema, raw)
+
+			if actual := instanceShouldBeRunning(d, &compute.Instance{Status: tc.status}); actual != tc.expected {
+				t.Fatalf("Got %t, expected %t", actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestComputeInstanceStatusDrifted(t *testing.T) {
+	cases := []struct {
+		name          string
+		status        string
+		desiredStatus string
+		expected      bool
+	}{
+		{name: "without desired status", status: "stopped", expected: false},
+		{name: "stopped instance desired running", status: "stopped", desiredStatus: "running", expected: true},
+		{name: "running instance desired stopped", status: "running", desiredStatus: "stopped", expected: true},
+		{name: "running instance desired running", status: "running", desiredStatus: "running", expected: false},
+		{name: "starting instance desired running", status: "starting", desiredStatus: "running", expected: false},
+		{name: "stopping instance desired running", status: "stopping", desiredStatus: "running", expected: false},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			if actual := instanceStatusDrifted(tc.status, tc.desiredStatus); actual != tc.expected {
+				t.Fatalf("Got %t, expected %t", actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestComputeInstanceAttachDetachNetworkInterfaces(t *testing.T) {
+	iface := func(index int) map[string]interface{} {
+		return map[string]interface{}{"index": index, "subnet_id": "subnet-a", "ipv4": true, "ip_address": "", "ipv6_address": ""}
+	}
+	// The interface 2 is attached with yandex_compute_instance_network_interface
+	attached := []*compute.NetworkInterface{{Index: "0"}, {Index: "1"}, {Index: "2"}}
+
+	cases := []struct {
+		name           string
+		oldList        []interface{}
+		newList        []interface{}
+		expectedAttach []string
+		expectedDetach []string
+		expectError    bool
+	}{
+		{
+			name:           "detach managed interface",
+			oldList:        []interface{}{iface(0), iface(1)},
+			newList:        []interface{}{iface(0)},
+			expectedDetach: []string{"1"},
+		},
+		{
+			name:           "attach new interface",
+			oldList:        []interface{}{iface(0), iface(1)},
+			newList:        []interface{}{iface(0), iface(1), iface(3)},
+			expectedAttach: []string{"3"},
+		},
+		{
+			name:        "interface of another resource",
+			oldList:     []interface{}{iface(0), iface(1)},
+			newList:     []interface{}{iface(0), iface(1), iface(2)},
+			expectError: true,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			attach, detach, err := getSpecsForAttachDetachNetworkInterfaces(tc.oldList, tc.newList, "instance-id", attached)
+			if tc.expectError {
+				if err == nil {
+					t.Fatalf("Expected error, got attach %v, detach %v", attach, detach)
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %s", err)
+			}
+
+			var actualAttach, actualDetach []string
+			for _, req := range attach {
+				actualAttach = append(actualAttach, req.NetworkInterfaceIndex)
+			}
+			for _, req := range detach {
+				actualDetach = append(actualDetach, req.NetworkInterfaceIndex)
+			}
+			if !reflect.DeepEqual(actualAttach, tc.expectedAttach) || !reflect.DeepEqual(actualDetach, tc.expectedDetach) {
+				t.Fatalf("Got attach %v, detach %v, expected attach %v, detach %v", actualAttach, actualDetach, tc.expectedAttach, tc.expectedDetach)
+			}
+		})
+	}
+}
+
 func TestComputeInstancePlacementPolicyRequest(t *testing.T) {
 	rawInstanceID := "test-instance-id"
 	rawInstance := map[string]interface{}{
@@ -1590,6 +1813,15 @@ func testAccCheckComputeInstanceDestroy(s *terraform.State) error {
 	return nil
 }
 
+func testAccStopComputeInstance(t *testing.T, instance *compute.Instance) func() {
+	return func() {
+		err := makeInstanceActionRequestByID(instanceActionStop, instance.Id, yandexComputeInstanceDefaultTimeout, testAccProvider.Meta())
+		if err != nil {
+			t.Fatalf("Error stopping Instance %q: %s", instance.Id, err)
+		}
+	}
+}
+
 func testAccCheckComputeInstanceExists(n string, instance *compute.Instance) resource.TestCheckFunc {
 	return func(s *terraform.State) error {
 		rs, ok := s.RootModule().Resources[n]
@@ -2142,6 +2374,87 @@ func testAccCheckComputeInstanceGpuCluster(instance *compute.Instance, expectedI
 }
 
 //revive:disable:var-naming
+func testAccComputeInstance_desiredStatus(instance, desiredStatus string, memory int) string {
+	return fmt.Sprintf(`
+data "yandex_compute_image" "ubuntu" {
+  family = "ubuntu-1804-lts"
+}
+
+resource "yandex_compute_instance" "foobar" {
+  name        = "%s"
+  platform_id = "standard-v2"
+  zone        = "ru-central1-a"
+
+  desired_status            = "%s"
+  allow_stopping_for_update = true
+
+  resources {
+    cores  = 2
+    memory = %d
+  }
+
+  boot_disk {
+    initialize_params {
+      image_id = "${data.yandex_compute_image.ubuntu.id}"
+    }
+  }
+
+  network_interface {
+    subnet_id = "${yandex_vpc_subnet.inst-test-subnet.id}"
+  }
+}
+
+resource "yandex_vpc_network" "inst-test-network" {}
+
+resource "yandex_vpc_subnet" "inst-test-subnet" {
+  zone           = "ru-central1-a"
+  network_id     = "${yandex_vpc_network.inst-test-network.id}"
+  v4_cidr_blocks = ["192.168.0.0/24"]
+}
+`, instance, desiredStatus, memory)
+}
+
+func testAccComputeInstance_waitFor(instance string) string {
+	return fmt.Sprintf(`
+data "yandex_compute_image" "ubuntu" {
+  family = "ubuntu-2004-lts"
+}
+
+resource "yandex_compute_instance" "foobar" {
+  name        = "%s"
+  platform_id = "standard-v2"
+  zone        = "ru-central1-a"
+
+  resources {
+    cores  = 2
+    memory = 2
+  }
+
+  boot_disk {
+    initialize_params {
+      image_id = "${data.yandex_compute_image.ubuntu.id}"
+    }
+  }
+
+  network_interface {
+    subnet_id = "${yandex_vpc_subnet.inst-test-subnet.id}"
+  }
+
+  wait_for {
+    serial_output_regex = "Cloud-init .* finished"
+  }
+}
+
+resource "yandex_vpc_network" "inst-test-network" {}
+
+resource "yandex_vpc_subnet" "inst-test-subnet" {
+  zone           = "ru-central1-a"
+  network_id     = "${yandex_vpc_network.inst-test-network.id}"
+  v4_cidr_blocks = ["192.168.0.0/24"]
+}
+`, instance)
+}
+
 func testAccComputeInstance_basic(instance string) string {
 	return fmt.Sprintf(`
 data "yandex_compute_image" "ubuntu" {
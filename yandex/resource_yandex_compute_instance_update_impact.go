@@ -0,0 +1,158 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+// instanceChangeGetter is implemented by both schema.ResourceDiff and schema.ResourceData.
+type instanceChangeGetter interface {
+	Get(key string) interface{}
+	GetChange(key string) (interface{}, interface{})
+	HasChange(key string) bool
+}
+
+// instanceUpdateImpact groups the changed attributes of an instance by the way they are applied.
+type instanceUpdateImpact struct {
+	hotUpdate        []string
+	requiresStop     []string
+	requiresRecreate []string
+}
+
+// classifyInstanceUpdate mirrors the decisions of resourceYandexComputeInstanceUpdate,
+// so the downtime caused by the update is known at plan time. instanceNetworkInterfaces are
+// the interfaces attached to the instance, they are needed only if the number of interfaces is changed.
+func classifyInstanceUpdate(d instanceChangeGetter, instanceNetworkInterfaces []*compute.NetworkInterface) instanceUpdateImpact {
+	var impact instanceUpdateImpact
+
+	for _, name := range []string{"boot_disk", "gpu_cluster_id"} {
+		if d.HasChange(name) {
+			impact.requiresRecreate = append(impact.requiresRecreate, name)
+		}
+	}
+
+	if d.HasChange("folder_id") {
+		if d.Get("allow_recreate").(bool) {
+			impact.requiresRecreate = append(impact.requiresRecreate, "folder_id")
+		} else {
+			impact.requiresStop = append(impact.requiresStop, "folder_id")
+		}
+	}
+
+	for _, name := range []string{"resources", "platform_id", "network_acceleration_type", "scheduling_policy", "placement_policy", "reserved_instance_pool_id"} {
+		if d.HasChange(name) {
+			impact.requiresStop = append(impact.requiresStop, name)
+		}
+	}
+
+	if d.HasChange("network_interface") {
+		o, n := instanceNetworkInterfacesChange(d)
+		if networkInterfacesRequireStop(o, n, instanceNetworkInterfaces) {
+			impact.requiresStop = append(impact.requiresStop, "network_interface")
+		} else {
+			impact.hotUpdate = append(impact.hotUpdate, "network_interface")
+		}
+	}
+
+	for _, name := range []string{"secondary_disk", "filesystem"} {
+		if d.HasChange(name) {
+			impact.hotUpdate = append(impact.hotUpdate, name)
+		}
+	}
+
+	return impact
+}
+
+// networkInterfacesRequireStop reports whether interfaces are attached, detached, moved to another subnet or change
+// their primary addresses, as getSpecsForAttachDetachNetworkInterfaces and getSpecsForUpdateNetworkInterfaces do.
+// Other changes are applied to the running instance.
+func networkInterfacesRequireStop(oldList, newList []interface{}, instanceNetworkInterfaces []*compute.NetworkInterface) bool {
+	if len(oldList) != len(newList) {
+		attach, detach, err := getSpecsForAttachDetachNetworkInterfaces(oldList, newList, "", instanceNetworkInterfaces)
+		return err != nil || len(attach) > 0 || len(detach) > 0
+	}
+
+	for i := range oldList {
+		oldIface, _ := oldList[i].(map[string]interface{})
+		newIface, _ := newList[i].(map[string]interface{})
+		if oldIface["subnet_id"] != newIface["subnet_id"] {
+			return true
+		}
+
+		for _, expand := range []func(map[string]interface{}) (*compute.PrimaryAddressSpec, error){expandPrimaryV4AddressSpec, expandPrimaryV6AddressSpec} {
+			oldSpec, err := expand(oldIface)
+			if err != nil {
+				return true
+			}
+			newSpec, err := expand(newIface)
+			if err != nil {
+				return true
+			}
+			if needToRestartDueToAddressChange(oldSpec, newSpec) {
+				return true
+			}
+		}
+	}
+	return false
+}
+
+// warning explains the impact of the update in the plan, instanceStopped tells whether the instance is already stopped.
+func (impact instanceUpdateImpact) warning(instanceStopped bool) string {
+	var lines []string
+	if len(impact.requiresRecreate) > 0 {
+		lines = append(lines, fmt.Sprintf("Changes of %s require recreating the instance.", strings.Join(impact.requiresRecreate, ", ")))
+	}
+	if len(impact.requiresStop) > 0 {
+		if instanceStopped {
+			lines = append(lines, fmt.Sprintf("Changes of %s are applied to the stopped instance.", strings.Join(impact.requiresStop, ", ")))
+		} else {
+			lines = append(lines, fmt.Sprintf("Changes of %s require stopping the instance.", strings.Join(impact.requiresStop, ", ")))
+		}
+	}
+	if len(impact.hotUpdate) > 0 {
+		lines = append(lines, fmt.Sprintf("Changes of %s are applied without stopping the instance.", strings.Join(impact.hotUpdate, ", ")))
+	}
+	return strings.Join(lines, "\n")
+}
+
+func resourceYandexComputeInstanceUpdateImpactDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" {
+		return nil
+	}
+
+	var instanceNetworkInterfaces []*compute.NetworkInterface
+	if o, n := instanceNetworkInterfacesChange(d); len(o) != len(n) {
+		// The interfaces of yandex_compute_instance_network_interface resources can't be declared in the instance
+		config := meta.(*Config)
+		instance, err := config.sdk.Compute().Instance().Get(ctx, &compute.GetInstanceRequest{
+			InstanceId: d.Id(),
+		})
+		if err != nil {
+			return fmt.Errorf("Error while requesting API to get Instance %q: %s", d.Id(), err)
+		}
+		instanceNetworkInterfaces = instance.GetNetworkInterfaces()
+		if _, _, err := getSpecsForAttachDetachNetworkInterfaces(o, n, d.Id(), instanceNetworkInterfaces); err != nil {
+			return err
+		}
+	}
+
+	impact := classifyInstanceUpdate(d, instanceNetworkInterfaces)
+
+	// Stopped instances are updated without any additional downtime
+	instanceStopped := d.Get("status").(string) == instanceDesiredStatusStopped
+	if len(impact.requiresStop) > 0 && !instanceStopped {
+		if err := ensureAllowStoppingForUpdate(d, impact.requiresStop...); err != nil {
+			return err
+		}
+	}
+
+	if warning := impact.warning(instanceStopped); warning != "" {
+		addPlanWarning(ctx, fmt.Sprintf("Update of Instance %q", d.Id()), warning)
+	}
+
+	return nil
+}
@@ -0,0 +1,183 @@
+package yandex
+
+import (
+	"reflect"
+	"testing"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+type testInstanceChanges struct {
+	values  map[string]interface{}
+	changes map[string][2]interface{}
+}
+
+func (c *testInstanceChanges) Get(key string) interface{} {
+	if change, ok := c.changes[key]; ok {
+		return change[1]
+	}
+	if v, ok := c.values[key]; ok {
+		return v
+	}
+	return false
+}
+
+func (c *testInstanceChanges) GetChange(key string) (interface{}, interface{}) {
+	change := c.changes[key]
+	return change[0], change[1]
+}
+
+func (c *testInstanceChanges) HasChange(key string) bool {
+	_, ok := c.changes[key]
+	return ok
+}
+
+func testNetworkInterface(subnet, ipAddress string, nat bool) map[string]interface{} {
+	return map[string]interface{}{"index": 0, "subnet_id": subnet, "ipv4": true, "ip_address": ipAddress, "ipv6_address": "", "nat": nat}
+}
+
+func testNetworkInterfaces(subnets ...string) []interface{} {
+	var ifaces []interface{}
+	for i, subnet := range subnets {
+		iface := testNetworkInterface(subnet, "", false)
+		iface["index"] = i
+		ifaces = append(ifaces, iface)
+	}
+	return ifaces
+}
+
+func TestClassifyInstanceUpdate(t *testing.T) {
+	cases := []struct {
+		name              string
+		changes           *testInstanceChanges
+		networkInterfaces []*compute.NetworkInterface
+		expected          instanceUpdateImpact
+	}{
+		{
+			name:    "no changes",
+			changes: &testInstanceChanges{},
+		},
+		{
+			name: "hot update",
+			changes: &testInstanceChanges{changes: map[string][2]interface{}{
+				"secondary_disk": {nil, nil},
+				"filesystem":     {nil, nil},
+				"network_interface": {
+					testNetworkInterfaces("subnet-a"),
+					[]interface{}{testNetworkInterface("subnet-a", "", true)},
+				},
+			}},
+			expected: instanceUpdateImpact{hotUpdate: []string{"network_interface", "secondary_disk", "filesystem"}},
+		},
+		{
+			name: "requires stop",
+			changes: &testInstanceChanges{changes: map[string][2]interface{}{
+				"resources":         {nil, nil},
+				"platform_id":       {"standard-v2", "standard-v3"},
+				"network_interface": {testNetworkInterfaces("subnet-a"), testNetworkInterfaces("subnet-b")},
+			}},
+			expected: instanceUpdateImpact{requiresStop: []string{"resources", "platform_id", "network_interface"}},
+		},
+		{
+			name: "change primary address",
+			changes: &testInstanceChanges{changes: map[string][2]interface{}{
+				"network_interface": {
+					[]interface{}{testNetworkInterface("subnet-a", "192.168.0.10", false)},
+					[]interface{}{testNetworkInterface("subnet-a", "192.168.0.20", false)},
+				},
+			}},
+			expected: instanceUpdateImpact{requiresStop: []string{"network_interface"}},
+		},
+		{
+			name: "add primary ipv6 address",
+			changes: &testInstanceChanges{changes: map[string][2]interface{}{
+				"network_interface": {
+					[]interface{}{testNetworkInterface("subnet-a", "192.168.0.10", false)},
+					[]interface{}{map[string]interface{}{"subnet_id": "subnet-a", "ipv4": true, "ip_address": "192.168.0.10", "nat": false, "ipv6": true, "ipv6_address": ""}},
+				},
+			}},
+			expected: instanceUpdateImpact{requiresStop: []string{"network_interface"}},
+		},
+		{
+			name: "move to reserved instance pool",
+			changes: &testInstanceChanges{changes: map[string][2]interface{}{
+				"reserved_instance_pool_id": {"", "pool-a"},
+			}},
+			expected: instanceUpdateImpact{requiresStop: []string{"reserved_instance_pool_id"}},
+		},
+		{
+			name: "attach network interface",
+			changes: &testInstanceChanges{changes: map[string][2]interface{}{
+				"network_interface": {testNetworkInterfaces("subnet-a"), testNetworkInterfaces("subnet-a", "subnet-b")},
+			}},
+			expected: instanceUpdateImpact{requiresStop: []string{"network_interface"}},
+		},
+		{
+			name: "switch to external network interfaces",
+			changes: &testInstanceChanges{changes: map[string][2]interface{}{
+				"network_interface":           {testNetworkInterfaces("subnet-a", "subnet-b"), testNetworkInterfaces("subnet-a")},
+				"external_network_interfaces": {false, true},
+			}},
+			networkInterfaces: []*compute.NetworkInterface{{Index: "0"}, {Index: "1"}},
+			expected:          instanceUpdateImpact{hotUpdate: []string{"network_interface"}},
+		},
+		{
+			name: "requires recreate",
+			changes: &testInstanceChanges{changes: map[string][2]interface{}{
+				"boot_disk":      {nil, nil},
+				"gpu_cluster_id": {"", "gpu-cluster"},
+			}},
+			expected: instanceUpdateImpact{requiresRecreate: []string{"boot_disk", "gpu_cluster_id"}},
+		},
+		{
+			name: "move to another folder",
+			changes: &testInstanceChanges{changes: map[string][2]interface{}{
+				"folder_id": {"folder-a", "folder-b"},
+			}},
+			expected: instanceUpdateImpact{requiresStop: []string{"folder_id"}},
+		},
+		{
+			name: "recreate in another folder",
+			changes: &testInstanceChanges{
+				values: map[string]interface{}{"allow_recreate": true},
+				changes: map[string][2]interface{}{
+					"folder_id": {"folder-a", "folder-b"},
+				},
+			},
+			expected: instanceUpdateImpact{requiresRecreate: []string{"folder_id"}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			actual := classifyInstanceUpdate(tc.changes, tc.networkInterfaces)
+			if !reflect.DeepEqual(actual, tc.expected) {
+				t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", actual, tc.expected)
+			}
+		})
+	}
+}
+
+func TestInstanceUpdateImpactWarning(t *testing.T) {
+	impact := instanceUpdateImpact{
+		hotUpdate:        []string{"secondary_disk"},
+		requiresStop:     []string{"resources", "platform_id"},
+		requiresRecreate: []string{"boot_disk"},
+	}
+
+	expected := "Changes of boot_disk require recreating the instance.\n" +
+		"Changes of resources, platform_id require stopping the instance.\n" +
+		"Changes of secondary_disk are applied without stopping the instance."
+	if actual := impact.warning(false); actual != expected {
+		t.Fatalf("Got:\n\n%s\n\nExpected:\n\n%s\n", actual, expected)
+	}
+
+	expected = "Changes of resources are applied to the stopped instance."
+	if actual := (instanceUpdateImpact{requiresStop: []string{"resources"}}).warning(true); actual != expected {
+		t.Fatalf("Got:\n\n%s\n\nExpected:\n\n%s\n", actual, expected)
+	}
+
+	if actual := (instanceUpdateImpact{}).warning(false); actual != "" {
+		t.Fatalf("Expected no warning, got %q", actual)
+	}
+}
@@ -0,0 +1,75 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"regexp"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+const (
+	yandexComputeInstanceWaitForDefaultTimeout      = "10m"
+	yandexComputeInstanceSerialOutputPollInterval   = 10 * time.Second
+	yandexComputeInstanceSerialOutputErrorTailLines = 20
+)
+
+// waitForInstanceSerialOutput polls the serial port output of the created instance
+// until it matches the `wait_for.serial_output_regex` pattern.
+func waitForInstanceSerialOutput(d *schema.ResourceData, config *Config) error {
+	v, ok := d.GetOk("wait_for.0.serial_output_regex")
+	if !ok {
+		return nil
+	}
+
+	pattern, err := regexp.Compile(v.(string))
+	if err != nil {
+		return fmt.Errorf("Error parsing 'wait_for.0.serial_output_regex': %s", err)
+	}
+
+	timeout, err := time.ParseDuration(d.Get("wait_for.0.timeout").(string))
+	if err != nil {
+		return fmt.Errorf("Error parsing 'wait_for.0.timeout': %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(config.Context(), timeout)
+	defer cancel()
+
+	log.Printf("[DEBUG] Waiting for serial port output of Instance %q to match %q", d.Id(), pattern)
+
+	var output string
+	for {
+		resp, err := config.sdk.Compute().Instance().GetSerialPortOutput(ctx, &compute.GetInstanceSerialPortOutputRequest{
+			InstanceId: d.Id(),
+		})
+		if err == nil {
+			output = resp.GetContents()
+			if pattern.MatchString(output) {
+				log.Printf("[DEBUG] Serial port output of Instance %q matched %q", d.Id(), pattern)
+				return nil
+			}
+		} else if ctx.Err() == nil {
+			log.Printf("[WARN] Error while requesting API to get serial port output of Instance %q: %s", d.Id(), err)
+		}
+
+		select {
+		case <-ctx.Done():
+			return fmt.Errorf("Timeout while waiting for serial port output of Instance %q to match %q, last output lines:\n%s",
+				d.Id(), pattern, serialOutputTail(output, yandexComputeInstanceSerialOutputErrorTailLines))
+		case <-time.After(yandexComputeInstanceSerialOutputPollInterval):
+		}
+	}
+}
+
+// serialOutputTail returns at most n last non-empty lines of the serial port output.
+func serialOutputTail(output string, n int) string {
+	lines := strings.Split(strings.TrimRight(output, "\r\n"), "\n")
+	if len(lines) > n {
+		lines = lines[len(lines)-n:]
+	}
+	return strings.Join(lines, "\n")
+}
@@ -0,0 +1,364 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"time"
+
+	"google.golang.org/protobuf/types/known/fieldmaskpb"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+)
+
+const yandexComputeReservedInstancePoolDefaultTimeout = 15 * time.Minute
+
+func resourceYandexComputeReservedInstancePool() *schema.Resource {
+	return &schema.Resource{
+		Description: "Reserved instance pool guarantees the capacity for VM instances with the same platform and resources in an availability zone. Instances use the reserved capacity when they reference the pool with `reserved_instance_pool_id`. For more information, see [the official documentation](https://yandex.cloud/docs/compute/concepts/reserved-pools).\n",
+
+		CreateContext: resourceYandexComputeReservedInstancePoolCreate,
+		ReadContext:   resourceYandexComputeReservedInstancePoolRead,
+		UpdateContext: resourceYandexComputeReservedInstancePoolUpdate,
+		DeleteContext: resourceYandexComputeReservedInstancePoolDelete,
+
+		SchemaVersion: 0,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: schema.ImportStatePassthroughContext,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexComputeReservedInstancePoolDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexComputeReservedInstancePoolDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexComputeReservedInstancePoolDefaultTimeout),
+		},
+
+		Schema: map[string]*schema.Schema{
+			"folder_id": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["folder_id"],
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["created_at"],
+				Computed:    true,
+			},
+			"name": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["name"],
+				Optional:    true,
+				Default:     "",
+			},
+			"description": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["description"],
+				Optional:    true,
+				Default:     "",
+			},
+			"labels": {
+				Type:        schema.TypeMap,
+				Description: common.ResourceDescriptions["labels"],
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+			"zone": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["zone"],
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+			},
+			"platform_id": {
+				Type:        schema.TypeString,
+				Description: "The type of virtual machine to reserve the capacity for.",
+				Optional:    true,
+				ForceNew:    true,
+				Default:     "standard-v1",
+			},
+			"resources_spec": {
+				Type:        schema.TypeList,
+				Description: "Compute resources of each reserved instance.",
+				Required:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"memory": {
+							Type:         schema.TypeFloat,
+							Description:  "Memory size in GB.",
+							Required:     true,
+							ForceNew:     true,
+							ValidateFunc: FloatAtLeast(0.0),
+						},
+						"cores": {
+							Type:        schema.TypeInt,
+							Description: "CPU cores for the instance.",
+							Required:    true,
+							ForceNew:    true,
+						},
+						"core_fraction": {
+							Type:        schema.TypeInt,
+							Description: "Baseline performance for a core as a percent.",
+							Optional:    true,
+							ForceNew:    true,
+							Default:     100,
+						},
+						"gpus": {
+							Type:        schema.TypeInt,
+							Description: "The number of GPU devices for the instance.",
+							Optional:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
+			"gpu_settings": {
+				Type:        schema.TypeList,
+				Description: "GPU settings of the reserved instances.",
+				Optional:    true,
+				ForceNew:    true,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"gpu_cluster_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the GPU cluster to attach the instances to.",
+							Optional:    true,
+							ForceNew:    true,
+						},
+					},
+				},
+			},
+			"size": {
+				Type:         schema.TypeInt,
+				Description:  "The number of instances to reserve the capacity for.",
+				Required:     true,
+				ValidateFunc: validation.IntAtLeast(0),
+			},
+		},
+	}
+}
+
+func resourceYandexComputeReservedInstancePoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return diag.Errorf("Error getting zone while creating reserved instance pool: %s", err)
+	}
+
+	folderID, err := getFolderID(d, config)
+	if err != nil {
+		return diag.Errorf("Error getting folder ID while creating reserved instance pool: %s", err)
+	}
+
+	labels, err := expandLabels(d.Get("labels"))
+	if err != nil {
+		return diag.Errorf("Error expanding labels while creating reserved instance pool: %s", err)
+	}
+
+	req := compute.CreateReservedInstancePoolRequest{
+		FolderId:      folderID,
+		Name:          d.Get("name").(string),
+		Description:   d.Get("description").(string),
+		Labels:        labels,
+		ZoneId:        zone,
+		PlatformId:    d.Get("platform_id").(string),
+		ResourcesSpec: expandReservedInstancePoolResourcesSpec(d),
+		GpuSettings:   expandReservedInstancePoolGpuSettings(d),
+		Size:          int64(d.Get("size").(int)),
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	op, err := config.sdk.WrapOperation(config.sdk.Compute().ReservedInstancePool().Create(ctx, &req))
+	if err != nil {
+		return diag.Errorf("Error while requesting API for create reserved instance pool: %s", err)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return diag.Errorf("Error while get reserved instance pool create operation metadata: %s", err)
+	}
+
+	md, ok := protoMetadata.(*compute.CreateReservedInstancePoolMetadata)
+	if !ok {
+		return diag.Errorf("could not get reserved instance pool ID from create operation metadata")
+	}
+
+	d.SetId(md.GetReservedInstancePoolId())
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return diag.Errorf("Error while waiting operation to create reserved instance pool: %s", err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return diag.Errorf("Reserved instance pool creation failed: %s", err)
+	}
+
+	return resourceYandexComputeReservedInstancePoolRead(ctx, d, meta)
+}
+
+func resourceYandexComputeReservedInstancePoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	pool, err := config.sdk.Compute().ReservedInstancePool().Get(ctx, &compute.GetReservedInstancePoolRequest{
+		ReservedInstancePoolId: d.Id(),
+	})
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("Reserved instance pool %q", d.Id())))
+	}
+
+	d.Set("folder_id", pool.FolderId)
+	d.Set("created_at", getTimestamp(pool.CreatedAt))
+	d.Set("name", pool.Name)
+	d.Set("description", pool.Description)
+	d.Set("zone", pool.ZoneId)
+	d.Set("platform_id", pool.PlatformId)
+	d.Set("size", int(pool.Size))
+
+	if err := d.Set("labels", pool.Labels); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("resources_spec", flattenReservedInstancePoolResourcesSpec(pool.ResourcesSpec)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	if err := d.Set("gpu_settings", flattenReservedInstancePoolGpuSettings(pool.GpuSettings)); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceYandexComputeReservedInstancePoolUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	var resourceComputeReservedInstancePoolUpdateFieldsMap = map[string]string{
+		"name":        "name",
+		"description": "description",
+		"labels":      "labels",
+		"size":        "size",
+	}
+
+	d.Partial(true)
+
+	labels, err := expandLabels(d.Get("labels"))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	req := compute.UpdateReservedInstancePoolRequest{
+		ReservedInstancePoolId: d.Id(),
+		Name:                   d.Get("name").(string),
+		Description:            d.Get("description").(string),
+		Labels:                 labels,
+		Size:                   int64(d.Get("size").(int)),
+	}
+
+	paths := generateFieldMasks(d, resourceComputeReservedInstancePoolUpdateFieldsMap)
+	if len(paths) > 0 {
+		req.UpdateMask = &fieldmaskpb.FieldMask{Paths: paths}
+		if err := updateReservedInstancePool(ctx, &req, d, meta); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	d.Partial(false)
+
+	return resourceYandexComputeReservedInstancePoolRead(ctx, d, meta)
+}
+
+func resourceYandexComputeReservedInstancePoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	op, err := config.sdk.WrapOperation(config.sdk.Compute().ReservedInstancePool().Delete(
+		ctx, &compute.DeleteReservedInstancePoolRequest{
+			ReservedInstancePoolId: d.Id(),
+		}))
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("Reserved instance pool %q", d.Id())))
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	_, err = op.Response()
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func updateReservedInstancePool(ctx context.Context, req *compute.UpdateReservedInstancePoolRequest, d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	op, err := config.sdk.WrapOperation(config.sdk.Compute().ReservedInstancePool().Update(ctx, req))
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to update reserved instance pool %q: %s", d.Id(), err)
+	}
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error updating reserved instance pool %q: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func expandReservedInstancePoolResourcesSpec(d *schema.ResourceData) *compute.ResourcesSpec {
+	return &compute.ResourcesSpec{
+		Memory:       toBytesFromFloat(d.Get("resources_spec.0.memory").(float64)),
+		Cores:        int64(d.Get("resources_spec.0.cores").(int)),
+		CoreFraction: int64(d.Get("resources_spec.0.core_fraction").(int)),
+		Gpus:         int64(d.Get("resources_spec.0.gpus").(int)),
+	}
+}
+
+func flattenReservedInstancePoolResourcesSpec(spec *compute.ResourcesSpec) []map[string]interface{} {
+	if spec == nil {
+		return nil
+	}
+
+	return []map[string]interface{}{{
+		"memory":        toGigabytesInFloat(spec.Memory),
+		"cores":         int(spec.Cores),
+		"core_fraction": int(spec.CoreFraction),
+		"gpus":          int(spec.Gpus),
+	}}
+}
+
+func expandReservedInstancePoolGpuSettings(d *schema.ResourceData) *compute.GpuSettings {
+	if v, ok := d.GetOk("gpu_settings.0.gpu_cluster_id"); ok {
+		return &compute.GpuSettings{GpuClusterId: v.(string)}
+	}
+	return nil
+}
+
+func flattenReservedInstancePoolGpuSettings(settings *compute.GpuSettings) []map[string]interface{} {
+	if settings.GetGpuClusterId() == "" {
+		return nil
+	}
+
+	return []map[string]interface{}{{
+		"gpu_cluster_id": settings.GetGpuClusterId(),
+	}}
+}
@@ -0,0 +1,249 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/go-multierror"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+func init() {
+	resource.AddTestSweepers("yandex_compute_reserved_instance_pool", &resource.Sweeper{
+		Name: "yandex_compute_reserved_instance_pool",
+		F:    testSweepComputeReservedInstancePool,
+		Dependencies: []string{
+			"yandex_compute_instance",
+		},
+	})
+}
+
+func testSweepComputeReservedInstancePool(_ string) error {
+	conf, err := configForSweepers()
+	if err != nil {
+		return fmt.Errorf("error getting client: %s", err)
+	}
+
+	req := &compute.ListReservedInstancePoolsRequest{FolderId: conf.FolderID}
+	it := conf.sdk.Compute().ReservedInstancePool().ReservedInstancePoolIterator(conf.Context(), req)
+	result := &multierror.Error{}
+	for it.Next() {
+		id := it.Value().GetId()
+		if !sweepComputeReservedInstancePool(conf, id) {
+			result = multierror.Append(result, fmt.Errorf("failed to sweep Compute Reserved Instance Pool %q", id))
+		}
+	}
+
+	return result.ErrorOrNil()
+}
+
+func sweepComputeReservedInstancePool(conf *Config, id string) bool {
+	return sweepWithRetry(sweepComputeReservedInstancePoolOnce, conf, "Compute Reserved Instance Pool", id)
+}
+
+func sweepComputeReservedInstancePoolOnce(conf *Config, id string) error {
+	ctx, cancel := conf.ContextWithTimeout(yandexComputeReservedInstancePoolDefaultTimeout)
+	defer cancel()
+
+	op, err := conf.sdk.Compute().ReservedInstancePool().Delete(ctx, &compute.DeleteReservedInstancePoolRequest{
+		ReservedInstancePoolId: id,
+	})
+	return handleSweepOperation(ctx, conf, op, err)
+}
+
+func TestFlattenReservedInstancePoolResourcesSpec(t *testing.T) {
+	cases := []struct {
+		name     string
+		spec     *compute.ResourcesSpec
+		expected []map[string]interface{}
+	}{
+		{
+			name:     "nil spec",
+			spec:     nil,
+			expected: nil,
+		},
+		{
+			name: "gpu spec",
+			spec: &compute.ResourcesSpec{
+				Memory:       4 * (1 << 30),
+				Cores:        2,
+				CoreFraction: 100,
+				Gpus:         1,
+			},
+			expected: []map[string]interface{}{{
+				"memory":        4.0,
+				"cores":         2,
+				"core_fraction": 100,
+				"gpus":          1,
+			}},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			result := flattenReservedInstancePoolResourcesSpec(tc.spec)
+			if !reflect.DeepEqual(result, tc.expected) {
+				t.Errorf("flattenReservedInstancePoolResourcesSpec() = %v, want %v", result, tc.expected)
+			}
+		})
+	}
+}
+
+func TestFlattenReservedInstancePoolGpuSettings(t *testing.T) {
+	if result := flattenReservedInstancePoolGpuSettings(nil); result != nil {
+		t.Errorf("flattenReservedInstancePoolGpuSettings(nil) = %v, want nil", result)
+	}
+
+	expected := []map[string]interface{}{{"gpu_cluster_id": "gpu-cluster"}}
+	result := flattenReservedInstancePoolGpuSettings(&compute.GpuSettings{GpuClusterId: "gpu-cluster"})
+	if !reflect.DeepEqual(result, expected) {
+		t.Errorf("flattenReservedInstancePoolGpuSettings() = %v, want %v", result, expected)
+	}
+}
+
+func TestAccComputeReservedInstancePool_basic(t *testing.T) {
+	t.Parallel()
+
+	poolName := acctest.RandomWithPrefix("tf-test")
+	var pool compute.ReservedInstancePool
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckComputeReservedInstancePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeReservedInstancePool_basic(poolName, "", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeReservedInstancePoolExists("yandex_compute_reserved_instance_pool.foobar", &pool),
+					resource.TestCheckResourceAttr("yandex_compute_reserved_instance_pool.foobar", "name", poolName),
+					resource.TestCheckResourceAttr("yandex_compute_reserved_instance_pool.foobar", "zone", "ru-central1-a"),
+					resource.TestCheckResourceAttr("yandex_compute_reserved_instance_pool.foobar", "platform_id", "standard-v3"),
+					resource.TestCheckResourceAttr("yandex_compute_reserved_instance_pool.foobar", "resources_spec.0.cores", "2"),
+					resource.TestCheckResourceAttr("yandex_compute_reserved_instance_pool.foobar", "resources_spec.0.memory", "2"),
+					resource.TestCheckResourceAttr("yandex_compute_reserved_instance_pool.foobar", "size", "1"),
+					resource.TestCheckResourceAttr("yandex_compute_reserved_instance_pool.foobar",
+						"labels.my-label", "my-label-value"),
+					testAccCheckCreatedAtAttr("yandex_compute_reserved_instance_pool.foobar"),
+				),
+			},
+			{
+				ResourceName:      "yandex_compute_reserved_instance_pool.foobar",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func TestAccComputeReservedInstancePool_update(t *testing.T) {
+	t.Parallel()
+
+	poolName := acctest.RandomWithPrefix("tf-test")
+	var pool compute.ReservedInstancePool
+
+	newPoolName := acctest.RandomWithPrefix("tf-test")
+	newDesc := "new description"
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:          func() { testAccPreCheck(t) },
+		ProviderFactories: testAccProviderFactories,
+		CheckDestroy:      testAccCheckComputeReservedInstancePoolDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeReservedInstancePool_basic(poolName, "", 1),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeReservedInstancePoolExists("yandex_compute_reserved_instance_pool.foobar", &pool),
+					resource.TestCheckResourceAttr("yandex_compute_reserved_instance_pool.foobar", "size", "1"),
+				),
+			},
+			{
+				Config: testAccComputeReservedInstancePool_basic(newPoolName, newDesc, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeReservedInstancePoolExists("yandex_compute_reserved_instance_pool.foobar", &pool),
+					resource.TestCheckResourceAttr("yandex_compute_reserved_instance_pool.foobar", "name", newPoolName),
+					resource.TestCheckResourceAttr("yandex_compute_reserved_instance_pool.foobar", "description", newDesc),
+					resource.TestCheckResourceAttr("yandex_compute_reserved_instance_pool.foobar", "size", "2"),
+				),
+			},
+		},
+	})
+}
+
+func testAccCheckComputeReservedInstancePoolDestroy(s *terraform.State) error {
+	config := testAccProvider.Meta().(*Config)
+
+	for _, rs := range s.RootModule().Resources {
+		if rs.Type != "yandex_compute_reserved_instance_pool" {
+			continue
+		}
+
+		_, err := config.sdk.Compute().ReservedInstancePool().Get(context.Background(), &compute.GetReservedInstancePoolRequest{
+			ReservedInstancePoolId: rs.Primary.ID,
+		})
+		if err == nil {
+			return fmt.Errorf("Reserved instance pool still exists")
+		}
+	}
+
+	return nil
+}
+
+func testAccCheckComputeReservedInstancePoolExists(n string, pool *compute.ReservedInstancePool) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[n]
+		if !ok {
+			return fmt.Errorf("Not found: %s", n)
+		}
+
+		if rs.Primary.ID == "" {
+			return fmt.Errorf("No ID is set")
+		}
+
+		config := testAccProvider.Meta().(*Config)
+
+		found, err := config.sdk.Compute().ReservedInstancePool().Get(context.Background(), &compute.GetReservedInstancePoolRequest{
+			ReservedInstancePoolId: rs.Primary.ID,
+		})
+		if err != nil {
+			return err
+		}
+
+		if found.Id != rs.Primary.ID {
+			return fmt.Errorf("Reserved instance pool not found")
+		}
+
+		*pool = *found
+
+		return nil
+	}
+}
+
+//revive:disable:var-naming
+func testAccComputeReservedInstancePool_basic(name, desc string, size int) string {
+	return fmt.Sprintf(`
+resource "yandex_compute_reserved_instance_pool" "foobar" {
+  name        = "%s"
+  description = "%s"
+  zone        = "ru-central1-a"
+  platform_id = "standard-v3"
+
+  resources_spec {
+    cores  = 2
+    memory = 2
+  }
+
+  size = %d
+
+  labels = {
+    my-label = "my-label-value"
+  }
+}
+`, name, desc, size)
+}
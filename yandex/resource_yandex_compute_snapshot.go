@@ -230,6 +230,15 @@ func resourceYandexComputeSnapshotRead(d *schema.ResourceData, meta interface{})
 }
 
 func resourceYandexComputeSnapshotUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := updateComputeSnapshotAttributes(d, meta); err != nil {
+		return err
+	}
+
+	return resourceYandexComputeSnapshotRead(d, meta)
+}
+
+// updateComputeSnapshotAttributes updates the name, description and labels of the snapshot.
+func updateComputeSnapshotAttributes(d *schema.ResourceData, meta interface{}) error {
 	d.Partial(true)
 
 	labelPropName := "labels"
@@ -290,7 +299,7 @@ func resourceYandexComputeSnapshotUpdate(d *schema.ResourceData, meta interface{
 
 	d.Partial(false)
 
-	return resourceYandexComputeSnapshotRead(d, meta)
+	return nil
 }
 
 func resourceYandexComputeSnapshotDelete(d *schema.ResourceData, meta interface{}) error {
@@ -0,0 +1,253 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+	"github.com/yandex-cloud/terraform-provider-yandex/common"
+)
+
+func resourceYandexComputeSnapshotCopy() *schema.Resource {
+	return &schema.Resource{
+		Description: "Copies a snapshot, e.g. to another folder. The copy is made through a temporary disk which is created from the source snapshot in the target folder and deleted after the new snapshot is taken. For more information, see [the official documentation](https://yandex.cloud/docs/compute/concepts/snapshot).\n",
+
+		Create: resourceYandexComputeSnapshotCopyCreate,
+		Read:   resourceYandexComputeSnapshotCopyRead,
+		Update: resourceYandexComputeSnapshotCopyUpdate,
+		Delete: resourceYandexComputeSnapshotDelete,
+		Importer: &schema.ResourceImporter{
+			State: schema.ImportStatePassthrough,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(2 * yandexComputeSnapshotDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexComputeSnapshotDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexComputeSnapshotDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"source_snapshot_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the snapshot to copy.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"folder_id": {
+				Type:        schema.TypeString,
+				Description: "The ID of the folder to create the copy in. If it is not provided, the default provider `folder-id` is used.",
+				Computed:    true,
+				Optional:    true,
+				ForceNew:    true,
+			},
+
+			"zone": {
+				Type:        schema.TypeString,
+				Description: "The availability zone of the temporary disk used for copying. If it is not provided, the default provider zone is used.",
+				Optional:    true,
+				Computed:    true,
+				ForceNew:    true,
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["name"],
+				Optional:    true,
+				Default:     "",
+			},
+
+			"description": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["description"],
+				Optional:    true,
+			},
+
+			"labels": {
+				Type:        schema.TypeMap,
+				Description: common.ResourceDescriptions["labels"],
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+
+			"disk_size": {
+				Type:        schema.TypeInt,
+				Description: "Size of the disk when the source snapshot was created, specified in GB.",
+				Computed:    true,
+			},
+
+			"storage_size": {
+				Type:        schema.TypeInt,
+				Description: "Size of the snapshot, specified in GB.",
+				Computed:    true,
+			},
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["created_at"],
+				Computed:    true,
+			},
+		},
+	}
+}
+
+func resourceYandexComputeSnapshotCopyCreate(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	folderID, err := getFolderID(d, config)
+	if err != nil {
+		return fmt.Errorf("Error getting folder ID while copying snapshot: %s", err)
+	}
+
+	zone, err := getZone(d, config)
+	if err != nil {
+		return fmt.Errorf("Error getting zone while copying snapshot: %s", err)
+	}
+
+	labels, err := expandLabels(d.Get("labels"))
+	if err != nil {
+		return fmt.Errorf("Error expanding labels while copying snapshot: %s", err)
+	}
+
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	sourceSnapshotID := d.Get("source_snapshot_id").(string)
+	sourceSnapshot, err := config.sdk.Compute().Snapshot().Get(ctx, &compute.GetSnapshotRequest{
+		SnapshotId: sourceSnapshotID,
+	})
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to get source Snapshot %q: %s", sourceSnapshotID, err)
+	}
+
+	diskID, err := createSnapshotCopyTemporaryDisk(ctx, config, sourceSnapshot, folderID, zone)
+	if diskID != "" {
+		defer deleteSnapshotCopyTemporaryDisk(config, diskID, d.Timeout(schema.TimeoutDelete))
+	}
+	if err != nil {
+		return err
+	}
+
+	op, err := config.sdk.WrapOperation(config.sdk.Compute().Snapshot().Create(ctx, &compute.CreateSnapshotRequest{
+		FolderId:    folderID,
+		DiskId:      diskID,
+		Name:        d.Get("name").(string),
+		Description: d.Get("description").(string),
+		Labels:      labels,
+	}))
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to create snapshot copy: %s", err)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return fmt.Errorf("Error while get snapshot create operation metadata: %s", err)
+	}
+
+	md, ok := protoMetadata.(*compute.CreateSnapshotMetadata)
+	if !ok {
+		return fmt.Errorf("could not get Snapshot ID from create operation metadata")
+	}
+
+	d.SetId(md.SnapshotId)
+	d.Set("zone", zone)
+
+	err = op.Wait(ctx)
+	if err != nil {
+		return fmt.Errorf("Error while waiting operation to create snapshot copy: %s", err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("Snapshot copy creation failed: %s", err)
+	}
+
+	return resourceYandexComputeSnapshotCopyRead(d, meta)
+}
+
+func resourceYandexComputeSnapshotCopyRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+
+	snapshot, err := config.sdk.Compute().Snapshot().Get(config.Context(), &compute.GetSnapshotRequest{
+		SnapshotId: d.Id(),
+	})
+
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("Snapshot %q", d.Get("name").(string)))
+	}
+
+	d.Set("created_at", getTimestamp(snapshot.CreatedAt))
+	d.Set("name", snapshot.Name)
+	d.Set("folder_id", snapshot.FolderId)
+	d.Set("description", snapshot.Description)
+	d.Set("disk_size", toGigabytes(snapshot.DiskSize))
+	d.Set("storage_size", toGigabytes(snapshot.StorageSize))
+
+	return d.Set("labels", snapshot.Labels)
+}
+
+func resourceYandexComputeSnapshotCopyUpdate(d *schema.ResourceData, meta interface{}) error {
+	if err := updateComputeSnapshotAttributes(d, meta); err != nil {
+		return err
+	}
+
+	return resourceYandexComputeSnapshotCopyRead(d, meta)
+}
+
+// createSnapshotCopyTemporaryDisk restores the source snapshot to a disk in the target folder. The disk ID
+// is returned even on a failed wait, so the caller can clean up.
+func createSnapshotCopyTemporaryDisk(ctx context.Context, config *Config, sourceSnapshot *compute.Snapshot, folderID, zone string) (string, error) {
+	log.Printf("[DEBUG] Creating temporary Disk from Snapshot %q in folder %q", sourceSnapshot.GetId(), folderID)
+
+	op, err := config.sdk.WrapOperation(config.sdk.Compute().Disk().Create(ctx, &compute.CreateDiskRequest{
+		FolderId:    folderID,
+		ZoneId:      zone,
+		Description: fmt.Sprintf("Temporary disk for copying snapshot %s", sourceSnapshot.GetId()),
+		Size:        sourceSnapshot.GetDiskSize(),
+		Source: &compute.CreateDiskRequest_SnapshotId{
+			SnapshotId: sourceSnapshot.GetId(),
+		},
+	}))
+	if err != nil {
+		return "", fmt.Errorf("Error while requesting API to create temporary disk from Snapshot %q: %s", sourceSnapshot.GetId(), err)
+	}
+
+	protoMetadata, err := op.Metadata()
+	if err != nil {
+		return "", fmt.Errorf("Error while get disk create operation metadata: %s", err)
+	}
+
+	md, ok := protoMetadata.(*compute.CreateDiskMetadata)
+	if !ok {
+		return "", fmt.Errorf("could not get Disk ID from create operation metadata")
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		return md.DiskId, fmt.Errorf("Error while waiting operation to create temporary disk from Snapshot %q: %s", sourceSnapshot.GetId(), err)
+	}
+
+	return md.DiskId, nil
+}
+
+func deleteSnapshotCopyTemporaryDisk(config *Config, diskID string, timeout time.Duration) {
+	ctx, cancel := context.WithTimeout(config.Context(), timeout)
+	defer cancel()
+
+	log.Printf("[DEBUG] Deleting temporary Disk %q", diskID)
+
+	op, err := config.sdk.WrapOperation(config.sdk.Compute().Disk().Delete(ctx, &compute.DeleteDiskRequest{
+		DiskId: diskID,
+	}))
+	if err == nil {
+		err = op.Wait(ctx)
+	}
+	if err != nil {
+		log.Printf("[WARN] Failed to delete temporary Disk %q, please delete it manually: %s", diskID, err)
+	}
+}
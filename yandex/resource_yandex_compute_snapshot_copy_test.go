@@ -0,0 +1,66 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/compute/v1"
+)
+
+//revive:disable:var-naming
+func TestAccComputeSnapshotCopy_basic(t *testing.T) {
+	t.Parallel()
+
+	var snapshot compute.Snapshot
+	snapshotName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	copyName := fmt.Sprintf("tf-test-copy-%s", acctest.RandString(10))
+	diskName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckComputeSnapshotDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccComputeSnapshotCopy_basic(snapshotName, diskName, copyName, "my-init-value"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeSnapshotExists("yandex_compute_snapshot_copy.foobar", &snapshot),
+					resource.TestCheckResourceAttr("yandex_compute_snapshot_copy.foobar", "name", copyName),
+					resource.TestCheckResourceAttrPair("yandex_compute_snapshot_copy.foobar", "disk_size",
+						"yandex_compute_snapshot.foobar", "disk_size"),
+					testAccCheckCreatedAtAttr("yandex_compute_snapshot_copy.foobar"),
+				),
+			},
+			{
+				Config: testAccComputeSnapshotCopy_basic(snapshotName, diskName, copyName, "my-updated-value"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckComputeSnapshotExists("yandex_compute_snapshot_copy.foobar", &snapshot),
+					resource.TestCheckResourceAttr("yandex_compute_snapshot_copy.foobar", "labels.test_label", "my-updated-value"),
+				),
+			},
+			{
+				ResourceName:            "yandex_compute_snapshot_copy.foobar",
+				ImportState:             true,
+				ImportStateVerify:       true,
+				ImportStateVerifyIgnore: []string{"source_snapshot_id", "zone"},
+			},
+		},
+	})
+}
+
+func testAccComputeSnapshotCopy_basic(snapshotName, diskName, copyName, labelValue string) string {
+	return testAccComputeSnapshot_basic(snapshotName, diskName, "my-value-for-tag") + fmt.Sprintf(`
+resource "yandex_compute_snapshot_copy" "foobar" {
+  name               = "%s"
+  source_snapshot_id = "${yandex_compute_snapshot.foobar.id}"
+  zone               = "ru-central1-a"
+
+  labels = {
+    test_label = "%s"
+  }
+}
+`, copyName, labelValue)
+}
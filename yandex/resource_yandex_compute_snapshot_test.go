@@ -82,7 +82,7 @@ func testAccCheckComputeSnapshotDestroy(s *terraform.State) error {
 	config := testAccProvider.Meta().(*Config)
 
 	for _, rs := range s.RootModule().Resources {
-		if rs.Type != "yandex_compute_snapshot" {
+		if rs.Type != "yandex_compute_snapshot" && rs.Type != "yandex_compute_snapshot_copy" {
 			continue
 		}
 
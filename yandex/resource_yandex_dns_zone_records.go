@@ -0,0 +1,365 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"reflect"
+	"sort"
+	"strings"
+	"time"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+)
+
+func resourceYandexDnsZoneRecords() *schema.Resource {
+	return &schema.Resource{
+		Description: "Manages a set of DNS RecordSets of a zone within Yandex Cloud. All changes are applied with a single batched request, so it's a better fit for zones with many records than `yandex_dns_recordset`.\n\n" +
+			"~> Don't manage the same records with `yandex_dns_recordset` and this resource. With `exclusive = true` all records of the zone which are not declared here are deleted, except the SOA and NS records of the zone apex.\n",
+		Create: resourceYandexDnsZoneRecordsCreate,
+		Read:   resourceYandexDnsZoneRecordsRead,
+		Update: resourceYandexDnsZoneRecordsUpdate,
+		Delete: resourceYandexDnsZoneRecordsDelete,
+		Importer: &schema.ResourceImporter{
+			State: resourceYandexDnsZoneRecordsImportState,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexDnsDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexDnsDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexDnsDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"zone_id": {
+				Type:        schema.TypeString,
+				Description: "The id of the zone in which the record sets will reside.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"exclusive": {
+				Type:        schema.TypeBool,
+				Description: "If `true`, the records of the zone which are not declared in this resource are deleted. The SOA and NS records of the zone apex are never deleted. The default value is `false`.",
+				Optional:    true,
+				Default:     false,
+			},
+
+			"record": {
+				Type:        schema.TypeSet,
+				Description: "A DNS record set of the zone.",
+				Optional:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:         schema.TypeString,
+							Description:  "The DNS name this record set will apply to, either a fully qualified name with a trailing dot or a name relative to the zone. Use `@` for the zone apex.",
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 254),
+						},
+
+						"type": {
+							Type:         schema.TypeString,
+							Description:  "The DNS record set type.",
+							Required:     true,
+							ValidateFunc: validation.StringLenBetween(1, 20),
+						},
+
+						"ttl": {
+							Type:         schema.TypeInt,
+							Description:  "The time-to-live of this record set (seconds).",
+							Required:     true,
+							ValidateFunc: validation.IntBetween(0, 2147483647),
+						},
+
+						"data": {
+							Type:        schema.TypeSet,
+							Description: "The string data for the records in this record set.",
+							Required:    true,
+							MinItems:    1,
+							MaxItems:    100,
+							Elem: &schema.Schema{
+								Type:         schema.TypeString,
+								ValidateFunc: validation.StringLenBetween(1, 1024),
+							},
+							Set: schema.HashString,
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func resourceYandexDnsZoneRecordsCreate(d *schema.ResourceData, meta interface{}) error {
+	d.SetId(d.Get("zone_id").(string))
+
+	if err := applyDnsZoneRecords(d, meta, nil, d.Get("record").(*schema.Set).List(), d.Timeout(schema.TimeoutCreate)); err != nil {
+		d.SetId("")
+		return err
+	}
+
+	return resourceYandexDnsZoneRecordsRead(d, meta)
+}
+
+func resourceYandexDnsZoneRecordsRead(d *schema.ResourceData, meta interface{}) error {
+	config := meta.(*Config)
+	sdk := getSDK(config)
+
+	zone, err := sdk.DNS().DnsZone().Get(config.Context(), &dns.GetDnsZoneRequest{
+		DnsZoneId: d.Id(),
+	})
+	if err != nil {
+		return handleNotFoundError(err, d, fmt.Sprintf("DnsZone %q", d.Id()))
+	}
+
+	current, err := listDnsZoneRecordSets(config.Context(), config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	managed := d.Get("record").(*schema.Set).List()
+	records := flattenDnsZoneRecords(zone.GetZone(), current, managed, d.Get("exclusive").(bool))
+
+	d.Set("zone_id", d.Id())
+	return d.Set("record", records)
+}
+
+func resourceYandexDnsZoneRecordsUpdate(d *schema.ResourceData, meta interface{}) error {
+	if d.HasChanges("record", "exclusive") {
+		oldRecords, newRecords := d.GetChange("record")
+		err := applyDnsZoneRecords(d, meta, oldRecords.(*schema.Set).List(), newRecords.(*schema.Set).List(), d.Timeout(schema.TimeoutUpdate))
+		if err != nil {
+			return err
+		}
+	}
+
+	return resourceYandexDnsZoneRecordsRead(d, meta)
+}
+
+func resourceYandexDnsZoneRecordsDelete(d *schema.ResourceData, meta interface{}) error {
+	// Unmanaged records are kept on delete, even in the exclusive mode
+	d.Set("exclusive", false)
+
+	if err := applyDnsZoneRecords(d, meta, d.Get("record").(*schema.Set).List(), nil, d.Timeout(schema.TimeoutDelete)); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Finished deleting records of DnsZone %q", d.Id())
+	return nil
+}
+
+func resourceYandexDnsZoneRecordsImportState(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	// All records of the zone are imported, as if the resource was exclusive
+	config := meta.(*Config)
+	sdk := getSDK(config)
+
+	zone, err := sdk.DNS().DnsZone().Get(config.Context(), &dns.GetDnsZoneRequest{
+		DnsZoneId: d.Id(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error while requesting API to get DnsZone %q: %s", d.Id(), err)
+	}
+
+	current, err := listDnsZoneRecordSets(config.Context(), config, d.Id())
+	if err != nil {
+		return nil, err
+	}
+
+	if err := d.Set("record", flattenDnsZoneRecords(zone.GetZone(), current, nil, true)); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
+// applyDnsZoneRecords submits the difference between the current records of the zone and the desired ones
+// with a single UpsertRecordSets request.
+func applyDnsZoneRecords(d *schema.ResourceData, meta interface{}, oldRecords, newRecords []interface{}, timeout time.Duration) error {
+	config := meta.(*Config)
+	sdk := getSDK(config)
+
+	ctx, cancel := context.WithTimeout(config.Context(), timeout)
+	defer cancel()
+
+	zone, err := sdk.DNS().DnsZone().Get(ctx, &dns.GetDnsZoneRequest{
+		DnsZoneId: d.Id(),
+	})
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to get DnsZone %q: %s", d.Id(), err)
+	}
+
+	current, err := listDnsZoneRecordSets(ctx, config, d.Id())
+	if err != nil {
+		return err
+	}
+
+	replacements, deletions := dnsZoneRecordsDelta(
+		expandDnsZoneRecords(zone.GetZone(), oldRecords),
+		expandDnsZoneRecords(zone.GetZone(), newRecords),
+		current,
+		zone.GetZone(),
+		d.Get("exclusive").(bool),
+	)
+	if len(replacements) == 0 && len(deletions) == 0 {
+		return nil
+	}
+
+	log.Printf("[DEBUG] Updating records of DnsZone %q: %d replacements, %d deletions", d.Id(), len(replacements), len(deletions))
+
+	op, err := sdk.WrapOperation(sdk.DNS().DnsZone().UpsertRecordSets(ctx, &dns.UpsertRecordSetsRequest{
+		DnsZoneId:    d.Id(),
+		Replacements: replacements,
+		Deletions:    deletions,
+	}))
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to update records of DnsZone %q: %s", d.Id(), err)
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		return fmt.Errorf("Error while waiting operation to update records of DnsZone %q: %s", d.Id(), err)
+	}
+
+	if _, err := op.Response(); err != nil {
+		return fmt.Errorf("Update of records of DnsZone %q failed: %s", d.Id(), err)
+	}
+
+	return nil
+}
+
+func listDnsZoneRecordSets(ctx context.Context, config *Config, zoneID string) ([]*dns.RecordSet, error) {
+	sdk := getSDK(config)
+
+	recordSets, err := sdk.DNS().DnsZone().DnsZoneRecordSetsIterator(ctx, &dns.ListDnsZoneRecordSetsRequest{
+		DnsZoneId: zoneID,
+	}).TakeAll()
+	if err != nil {
+		return nil, fmt.Errorf("Error while requesting API to list records of DnsZone %q: %s", zoneID, err)
+	}
+	return recordSets, nil
+}
+
+// dnsZoneRecordsDelta returns the record sets to replace and to delete. Record sets declared before
+// and not declared now are deleted, in the exclusive mode all undeclared record sets are deleted.
+// The SOA and NS record sets of the zone apex are never deleted.
+func dnsZoneRecordsDelta(oldRecords, newRecords []*dns.RecordSet, current []*dns.RecordSet, zone string, exclusive bool) (replacements, deletions []*dns.RecordSet) {
+	currentByKey := make(map[string]*dns.RecordSet, len(current))
+	for _, rs := range current {
+		currentByKey[dnsRecordSetKey(rs.GetName(), rs.GetType())] = rs
+	}
+
+	desired := make(map[string]bool, len(newRecords))
+	for _, rs := range newRecords {
+		key := dnsRecordSetKey(rs.GetName(), rs.GetType())
+		desired[key] = true
+		if cur, ok := currentByKey[key]; !ok || !sameDnsRecordSet(cur, rs) {
+			replacements = append(replacements, rs)
+		}
+	}
+
+	deleted := make(map[string]bool)
+	deleteRecordSet := func(key string) {
+		if desired[key] || deleted[key] {
+			return
+		}
+		if cur, ok := currentByKey[key]; ok && !isDnsZoneApexRecordSet(cur, zone) {
+			deleted[key] = true
+			deletions = append(deletions, cur)
+		}
+	}
+
+	for _, rs := range oldRecords {
+		deleteRecordSet(dnsRecordSetKey(rs.GetName(), rs.GetType()))
+	}
+	if exclusive {
+		for _, rs := range current {
+			deleteRecordSet(dnsRecordSetKey(rs.GetName(), rs.GetType()))
+		}
+	}
+
+	return replacements, deletions
+}
+
+// flattenDnsZoneRecords returns the managed record sets, keeping the names as they are written in the
+// configuration. In the exclusive mode all record sets except the SOA and NS of the zone apex are returned.
+func flattenDnsZoneRecords(zone string, current []*dns.RecordSet, managed []interface{}, exclusive bool) []interface{} {
+	names := make(map[string]string, len(managed))
+	for _, raw := range managed {
+		record := raw.(map[string]interface{})
+		name := record["name"].(string)
+		names[dnsRecordSetKey(dnsRecordSetFQDN(name, zone), record["type"].(string))] = name
+	}
+
+	var records []interface{}
+	for _, rs := range current {
+		key := dnsRecordSetKey(rs.GetName(), rs.GetType())
+		name, ok := names[key]
+		if !ok {
+			if !exclusive || isDnsZoneApexRecordSet(rs, zone) {
+				continue
+			}
+			name = rs.GetName()
+		}
+
+		records = append(records, map[string]interface{}{
+			"name": name,
+			"type": rs.GetType(),
+			"ttl":  int(rs.GetTtl()),
+			"data": convertStringArrToInterface(rs.GetData()),
+		})
+	}
+	return records
+}
+
+func expandDnsZoneRecords(zone string, records []interface{}) []*dns.RecordSet {
+	result := make([]*dns.RecordSet, 0, len(records))
+	for _, raw := range records {
+		record := raw.(map[string]interface{})
+		result = append(result, &dns.RecordSet{
+			Name: dnsRecordSetFQDN(record["name"].(string), zone),
+			Type: record["type"].(string),
+			Ttl:  int64(record["ttl"].(int)),
+			Data: convertStringSet(record["data"].(*schema.Set)),
+		})
+	}
+	return result
+}
+
+// dnsRecordSetFQDN converts the record set name relative to the zone to the fully qualified one.
+func dnsRecordSetFQDN(name, zone string) string {
+	switch {
+	case name == "@":
+		return zone
+	case strings.HasSuffix(name, "."):
+		return name
+	default:
+		return name + "." + zone
+	}
+}
+
+func dnsRecordSetKey(name, recordType string) string {
+	return strings.ToLower(name) + "/" + strings.ToUpper(recordType)
+}
+
+func isDnsZoneApexRecordSet(rs *dns.RecordSet, zone string) bool {
+	if !strings.EqualFold(rs.GetName(), zone) {
+		return false
+	}
+	return rs.GetType() == "SOA" || rs.GetType() == "NS"
+}
+
+func sameDnsRecordSet(a, b *dns.RecordSet) bool {
+	if a.GetTtl() != b.GetTtl() {
+		return false
+	}
+
+	aData := append([]string(nil), a.GetData()...)
+	bData := append([]string(nil), b.GetData()...)
+	sort.Strings(aData)
+	sort.Strings(bData)
+	return reflect.DeepEqual(aData, bData)
+}
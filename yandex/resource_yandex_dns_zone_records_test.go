@@ -0,0 +1,249 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/dns/v1"
+)
+
+func TestDnsRecordSetFQDN(t *testing.T) {
+	cases := map[string]string{
+		"@":                   "example.com.",
+		"www":                 "www.example.com.",
+		"www.example.com.":    "www.example.com.",
+		"a.b":                 "a.b.example.com.",
+		"other.example.org.":  "other.example.org.",
+		"_acme-challenge.api": "_acme-challenge.api.example.com.",
+	}
+
+	for name, expected := range cases {
+		if fqdn := dnsRecordSetFQDN(name, "example.com."); fqdn != expected {
+			t.Errorf("FQDN of %q: got %q, expected %q", name, fqdn, expected)
+		}
+	}
+}
+
+func TestDnsZoneRecordsDelta(t *testing.T) {
+	const zone = "example.com."
+
+	soa := &dns.RecordSet{Name: zone, Type: "SOA", Ttl: 3600, Data: []string{"ns1.yandexcloud.net. mx.cloud.yandex.net. 1 10800 900 604800 900"}}
+	ns := &dns.RecordSet{Name: zone, Type: "NS", Ttl: 3600, Data: []string{"ns1.yandexcloud.net.", "ns2.yandexcloud.net."}}
+	www := &dns.RecordSet{Name: "www." + zone, Type: "A", Ttl: 300, Data: []string{"10.0.0.1", "10.0.0.2"}}
+	api := &dns.RecordSet{Name: "api." + zone, Type: "A", Ttl: 300, Data: []string{"10.0.0.3"}}
+	unmanaged := &dns.RecordSet{Name: "legacy." + zone, Type: "CNAME", Ttl: 600, Data: []string{"www." + zone}}
+	current := []*dns.RecordSet{soa, ns, www, api, unmanaged}
+
+	wwwReordered := &dns.RecordSet{Name: "WWW." + zone, Type: "a", Ttl: 300, Data: []string{"10.0.0.2", "10.0.0.1"}}
+	apiNewTTL := &dns.RecordSet{Name: "api." + zone, Type: "A", Ttl: 60, Data: []string{"10.0.0.3"}}
+	mail := &dns.RecordSet{Name: zone, Type: "MX", Ttl: 300, Data: []string{"10 mx." + zone}}
+
+	cases := []struct {
+		name                 string
+		oldRecords           []*dns.RecordSet
+		newRecords           []*dns.RecordSet
+		exclusive            bool
+		expectedReplacements []*dns.RecordSet
+		expectedDeletions    []*dns.RecordSet
+	}{
+		{
+			name:       "no changes",
+			oldRecords: []*dns.RecordSet{www, api},
+			newRecords: []*dns.RecordSet{wwwReordered, api},
+		},
+		{
+			name:                 "add and update",
+			oldRecords:           []*dns.RecordSet{www, api},
+			newRecords:           []*dns.RecordSet{www, apiNewTTL, mail},
+			expectedReplacements: []*dns.RecordSet{apiNewTTL, mail},
+		},
+		{
+			name:              "remove managed only",
+			oldRecords:        []*dns.RecordSet{www, api},
+			newRecords:        []*dns.RecordSet{www},
+			expectedDeletions: []*dns.RecordSet{api},
+		},
+		{
+			name:              "exclusive removes unmanaged but keeps apex",
+			oldRecords:        []*dns.RecordSet{www, api},
+			newRecords:        []*dns.RecordSet{www},
+			exclusive:         true,
+			expectedDeletions: []*dns.RecordSet{api, unmanaged},
+		},
+		{
+			name:       "apex is never deleted",
+			oldRecords: []*dns.RecordSet{ns, www, api},
+			newRecords: []*dns.RecordSet{www, api},
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			replacements, deletions := dnsZoneRecordsDelta(tc.oldRecords, tc.newRecords, current, zone, tc.exclusive)
+			if !reflect.DeepEqual(replacements, tc.expectedReplacements) {
+				t.Errorf("Replacements: got %v, expected %v", replacements, tc.expectedReplacements)
+			}
+			if !reflect.DeepEqual(deletions, tc.expectedDeletions) {
+				t.Errorf("Deletions: got %v, expected %v", deletions, tc.expectedDeletions)
+			}
+		})
+	}
+}
+
+func TestFlattenDnsZoneRecords(t *testing.T) {
+	const zone = "example.com."
+
+	current := []*dns.RecordSet{
+		{Name: zone, Type: "NS", Ttl: 3600, Data: []string{"ns1.yandexcloud.net."}},
+		{Name: "www." + zone, Type: "A", Ttl: 300, Data: []string{"10.0.0.1"}},
+		{Name: "legacy." + zone, Type: "CNAME", Ttl: 600, Data: []string{"www." + zone}},
+	}
+	managed := []interface{}{
+		map[string]interface{}{"name": "www", "type": "A"},
+	}
+
+	records := flattenDnsZoneRecords(zone, current, managed, false)
+	expected := []interface{}{
+		map[string]interface{}{"name": "www", "type": "A", "ttl": 300, "data": []interface{}{"10.0.0.1"}},
+	}
+	if !reflect.DeepEqual(records, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", records, expected)
+	}
+
+	records = flattenDnsZoneRecords(zone, current, managed, true)
+	expected = append(expected,
+		map[string]interface{}{"name": "legacy." + zone, "type": "CNAME", "ttl": 600, "data": []interface{}{"www." + zone}})
+	if !reflect.DeepEqual(records, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", records, expected)
+	}
+}
+
+func TestAccDNSZoneRecords_basic(t *testing.T) {
+	t.Parallel()
+
+	var zoneID string
+	zoneName := acctest.RandomWithPrefix("tf-dns-zone")
+	fqdn := acctest.RandomWithPrefix("tf-test") + ".dnstest.test."
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckDnsZoneDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccDNSZoneRecordsBasic(zoneName, fqdn, false, 200),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.records", "record.#", "2"),
+					testAccCheckDNSZoneRecordSetTTL("yandex_dns_zone.zone1", "srv."+fqdn, "A", 200),
+					testAccCheckDNSZoneRecordSetTTL("yandex_dns_zone.zone1", fqdn, "MX", 200),
+					func(s *terraform.State) error {
+						zoneID = s.RootModule().Resources["yandex_dns_zone.zone1"].Primary.ID
+						return nil
+					},
+				),
+			},
+			{
+				Config: testAccDNSZoneRecordsBasic(zoneName, fqdn, false, 300),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.records", "record.#", "2"),
+					testAccCheckDNSZoneRecordSetTTL("yandex_dns_zone.zone1", "srv."+fqdn, "A", 300),
+				),
+			},
+			{
+				// the recordset created outside of Terraform is deleted in the exclusive mode
+				PreConfig: func() {
+					if err := testAccDNSZoneRecordsAddUnmanaged(zoneID, "legacy."+fqdn, "srv."+fqdn); err != nil {
+						t.Fatal(err)
+					}
+				},
+				Config: testAccDNSZoneRecordsBasic(zoneName, fqdn, true, 300),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_dns_zone_records.records", "record.#", "2"),
+					testAccCheckDNSZoneRecordSetTTL("yandex_dns_zone.zone1", "legacy."+fqdn, "CNAME", -1),
+				),
+			},
+		},
+	})
+}
+
+func testAccDNSZoneRecordsAddUnmanaged(zoneID, name, target string) error {
+	config := testAccProvider.Meta().(*Config)
+
+	op, err := config.sdk.WrapOperation(config.sdk.DNS().DnsZone().UpdateRecordSets(context.Background(), &dns.UpdateRecordSetsRequest{
+		DnsZoneId: zoneID,
+		Additions: []*dns.RecordSet{
+			{Name: name, Type: "CNAME", Ttl: 600, Data: []string{target}},
+		},
+	}))
+	if err != nil {
+		return err
+	}
+	return op.Wait(context.Background())
+}
+
+// testAccCheckDNSZoneRecordSetTTL checks the TTL of the recordset, a negative TTL means that the recordset must not exist.
+func testAccCheckDNSZoneRecordSetTTL(zoneResource, name, recordType string, ttl int64) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		rs, ok := s.RootModule().Resources[zoneResource]
+		if !ok {
+			return fmt.Errorf("Not found: %s", zoneResource)
+		}
+
+		config := testAccProvider.Meta().(*Config)
+		recordSets, err := listDnsZoneRecordSets(context.Background(), config, rs.Primary.ID)
+		if err != nil {
+			return err
+		}
+
+		for _, recordSet := range recordSets {
+			if recordSet.GetName() == name && recordSet.GetType() == recordType {
+				if ttl < 0 {
+					return fmt.Errorf("DnsRecordSet %s %s should not exist", recordType, name)
+				}
+				if recordSet.GetTtl() != ttl {
+					return fmt.Errorf("DnsRecordSet %s %s has TTL %d, expected %d", recordType, name, recordSet.GetTtl(), ttl)
+				}
+				return nil
+			}
+		}
+
+		if ttl < 0 {
+			return nil
+		}
+		return fmt.Errorf("DnsRecordSet %s %s not found", recordType, name)
+	}
+}
+
+func testAccDNSZoneRecordsBasic(name, fqdn string, exclusive bool, ttl int) string {
+	return fmt.Sprintf(`
+resource "yandex_dns_zone" "zone1" {
+  name        = "%[1]s"
+  description = "desc"
+  zone        = "%[2]s"
+}
+
+resource "yandex_dns_zone_records" "records" {
+  zone_id   = yandex_dns_zone.zone1.id
+  exclusive = %[3]t
+
+  record {
+    name = "srv"
+    type = "A"
+    ttl  = %[4]d
+    data = ["192.168.0.1", "192.168.0.2"]
+  }
+
+  record {
+    name = "@"
+    type = "MX"
+    ttl  = %[4]d
+    data = ["10 mx.%[2]s"]
+  }
+}
+`, name, fqdn, exclusive, ttl)
+}
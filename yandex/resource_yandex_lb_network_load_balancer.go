@@ -9,6 +9,7 @@ import (
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"google.golang.org/genproto/protobuf/field_mask"
 
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
@@ -16,6 +17,11 @@ import (
 
 const yandexLBNetworkLoadBalancerDefaultTimeout = 5 * time.Minute
 
+const (
+	lbAttachmentsManagementInline   = "inline"
+	lbAttachmentsManagementExternal = "external"
+)
+
 func resourceYandexLBNetworkLoadBalancer() *schema.Resource {
 	return &schema.Resource{
 		Description: "Creates a network load balancer in the specified folder using the data specified in the config. For more information, see [the official documentation](https://yandex.cloud/docs/load-balancer/concepts).",
@@ -24,6 +30,9 @@ func resourceYandexLBNetworkLoadBalancer() *schema.Resource {
 		Read:   resourceYandexLBNetworkLoadBalancerRead,
 		Update: resourceYandexLBNetworkLoadBalancerUpdate,
 		Delete: resourceYandexLBNetworkLoadBalancerDelete,
+
+		CustomizeDiff: resourceYandexLBNetworkLoadBalancerCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			State: schema.ImportStatePassthrough,
 		},
@@ -85,169 +94,203 @@ func resourceYandexLBNetworkLoadBalancer() *schema.Resource {
 				Description: "Listener specification that will be used by a network load balancer.\n\n~> One of `external_address_spec` or `internal_address_spec` should be specified.\n",
 				Optional:    true,
 				Set:         resourceLBNetworkLoadBalancerListenerHash,
+				Elem:        lbNetworkLoadBalancerListenerResource(),
+			},
+
+			"attached_target_group": {
+				Type:        schema.TypeSet,
+				Description: "An AttachedTargetGroup resource.",
+				Optional:    true,
+				Set:         resourceLBNetworkLoadBalancerAttachedTargetGroupHash,
+				Elem:        lbNetworkLoadBalancerAttachedTargetGroupResource(),
+			},
+
+			"attachments_management": {
+				Type:         schema.TypeString,
+				Description:  "How listeners and attached target groups are managed. With `inline` (the default) they are declared with the `listener` and `attached_target_group` blocks and any other listeners and target groups are removed. With `external` the blocks can't be used and the listeners and target groups managed by `yandex_lb_network_load_balancer_listener` and `yandex_lb_network_load_balancer_target_group_attachment` resources are left intact.",
+				Optional:     true,
+				ValidateFunc: validation.StringInSlice([]string{lbAttachmentsManagementInline, lbAttachmentsManagementExternal}, false),
+			},
+
+			"created_at": {
+				Type:        schema.TypeString,
+				Description: common.ResourceDescriptions["created_at"],
+				Computed:    true,
+			},
+			"deletion_protection": {
+				Type:        schema.TypeBool,
+				Description: common.ResourceDescriptions["deletion_protection"],
+				Optional:    true,
+				Computed:    true,
+			},
+			"allow_zonal_shift": {
+				Type:        schema.TypeBool,
+				Description: "Flag that marks the network load balancer as available to zonal shift.",
+				Optional:    true,
+				Computed:    true,
+			},
+		},
+	}
+
+}
+
+func lbNetworkLoadBalancerListenerResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the listener. The name must be unique for each listener on a single load balancer.",
+				Required:    true,
+			},
+			"port": {
+				Type:        schema.TypeInt,
+				Description: "Port for incoming traffic.",
+				Required:    true,
+			},
+			"target_port": {
+				Type:        schema.TypeInt,
+				Description: "Port of a target. The default is the same as listener's port.",
+				Optional:    true,
+				Computed:    true,
+			},
+			"protocol": {
+				Type:         schema.TypeString,
+				Description:  "Protocol for incoming traffic. TCP or UDP and the default is TCP.",
+				Optional:     true,
+				Computed:     true,
+				ValidateFunc: validation.StringInSlice([]string{"tcp", "udp"}, false),
+			},
+			"external_address_spec": {
+				Type:        schema.TypeSet,
+				Description: "External IP address specification. ",
+				Optional:    true,
+				Set:         resourceLBNetworkLoadBalancerExternalAddressHash,
+				MaxItems:    1,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
-						"name": {
+						"address": {
 							Type:        schema.TypeString,
-							Description: "Name of the listener. The name must be unique for each listener on a single load balancer.",
-							Required:    true,
+							Description: "External IP address for a listener. IP address will be allocated if it wasn't been set.",
+							Optional:    true,
+							Computed:    true,
 						},
-						"port": {
-							Type:        schema.TypeInt,
-							Description: "Port for incoming traffic.",
+						"ip_version": {
+							Type:         schema.TypeString,
+							Description:  "IP version of the external addresses that the load balancer works with. Must be one of `ipv4` or `ipv6`. The default is `ipv4`.",
+							Optional:     true,
+							Default:      "ipv4",
+							ValidateFunc: validation.StringInSlice([]string{"ipv4", "ipv6"}, false),
+						},
+					},
+				},
+			},
+			"internal_address_spec": {
+				Type:        schema.TypeSet,
+				Description: "Internal IP address specification. ",
+				Optional:    true,
+				Set:         resourceLBNetworkLoadBalancerInternalAddressHash,
+				MaxItems:    1,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"subnet_id": {
+							Type:        schema.TypeString,
+							Description: "ID of the subnet to which the internal IP address belongs.",
 							Required:    true,
 						},
-						"target_port": {
-							Type:        schema.TypeInt,
-							Description: "Port of a target. The default is the same as listener's port.",
+						"address": {
+							Type:        schema.TypeString,
+							Description: "Internal IP address for a listener. Must belong to the subnet that is referenced in subnet_id. IP address will be allocated if it wasn't been set.",
 							Optional:    true,
 							Computed:    true,
 						},
-						"protocol": {
+						"ip_version": {
 							Type:         schema.TypeString,
-							Description:  "Protocol for incoming traffic. TCP or UDP and the default is TCP.",
+							Description:  "IP version of the external addresses that the load balancer works with. Must be one of `ipv4` or `ipv6`. The default is `ipv4`.",
 							Optional:     true,
-							Computed:     true,
-							ValidateFunc: validation.StringInSlice([]string{"tcp", "udp"}, false),
+							Default:      "ipv4",
+							ValidateFunc: validation.StringInSlice([]string{"ipv4", "ipv6"}, false),
+						},
+					},
+				},
+			},
+		},
+	}
+}
+
+func lbNetworkLoadBalancerAttachedTargetGroupResource() *schema.Resource {
+	return &schema.Resource{
+		Schema: map[string]*schema.Schema{
+			"target_group_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the target group.",
+				Required:    true,
+			},
+			"healthcheck": {
+				Type:        schema.TypeList,
+				Description: "A HealthCheck resource.\n\n~> One of `http_options` or `tcp_options` should be specified.\n",
+				Required:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the health check. The name must be unique for each target group that attached to a single load balancer.",
+							Required:    true,
 						},
-						"external_address_spec": {
-							Type:        schema.TypeSet,
-							Description: "External IP address specification. ",
+						"interval": {
+							Type:        schema.TypeInt,
+							Description: "The interval between health checks. The default is 2 seconds.",
+							Default:     2,
+							Optional:    true,
+						},
+						"timeout": {
+							Type:        schema.TypeInt,
+							Description: "Timeout for a target to return a response for the health check. The default is 1 second.",
+							Default:     1,
+							Optional:    true,
+						},
+						"unhealthy_threshold": {
+							Type:        schema.TypeInt,
+							Description: "Number of failed health checks before changing the status to `UNHEALTHY`. The default is 2.",
+							Default:     2,
 							Optional:    true,
-							Set:         resourceLBNetworkLoadBalancerExternalAddressHash,
-							MaxItems:    1,
-							Elem: &schema.Resource{
-								Schema: map[string]*schema.Schema{
-									"address": {
-										Type:        schema.TypeString,
-										Description: "External IP address for a listener. IP address will be allocated if it wasn't been set.",
-										Optional:    true,
-										Computed:    true,
-									},
-									"ip_version": {
-										Type:         schema.TypeString,
-										Description:  "IP version of the external addresses that the load balancer works with. Must be one of `ipv4` or `ipv6`. The default is `ipv4`.",
-										Optional:     true,
-										Default:      "ipv4",
-										ValidateFunc: validation.StringInSlice([]string{"ipv4", "ipv6"}, false),
-									},
-								},
-							},
 						},
-						"internal_address_spec": {
-							Type:        schema.TypeSet,
-							Description: "Internal IP address specification. ",
+						"healthy_threshold": {
+							Type:        schema.TypeInt,
+							Description: "Number of successful health checks required in order to set the `HEALTHY` status for the target.",
+							Default:     2,
+							Optional:    true,
+						},
+						"http_options": {
+							Type:        schema.TypeList,
+							Description: "Options for HTTP health check.",
 							Optional:    true,
-							Set:         resourceLBNetworkLoadBalancerInternalAddressHash,
 							MaxItems:    1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
-									"subnet_id": {
-										Type:        schema.TypeString,
-										Description: "ID of the subnet to which the internal IP address belongs.",
+									"port": {
+										Type:        schema.TypeInt,
+										Description: "Port to use for HTTP health checks.",
 										Required:    true,
 									},
-									"address": {
+									"path": {
 										Type:        schema.TypeString,
-										Description: "Internal IP address for a listener. Must belong to the subnet that is referenced in subnet_id. IP address will be allocated if it wasn't been set.",
+										Description: "URL path to set for health checking requests for every target in the target group. For example `/ping`. The default path is `/`.",
 										Optional:    true,
-										Computed:    true,
-									},
-									"ip_version": {
-										Type:         schema.TypeString,
-										Description:  "IP version of the external addresses that the load balancer works with. Must be one of `ipv4` or `ipv6`. The default is `ipv4`.",
-										Optional:     true,
-										Default:      "ipv4",
-										ValidateFunc: validation.StringInSlice([]string{"ipv4", "ipv6"}, false),
 									},
 								},
 							},
 						},
-					},
-				},
-			},
-
-			"attached_target_group": {
-				Type:        schema.TypeSet,
-				Description: "An AttachedTargetGroup resource.",
-				Optional:    true,
-				Set:         resourceLBNetworkLoadBalancerAttachedTargetGroupHash,
-				Elem: &schema.Resource{
-					Schema: map[string]*schema.Schema{
-						"target_group_id": {
-							Type:        schema.TypeString,
-							Description: "ID of the target group.",
-							Required:    true,
-						},
-						"healthcheck": {
+						"tcp_options": {
 							Type:        schema.TypeList,
-							Description: "A HealthCheck resource.\n\n~> One of `http_options` or `tcp_options` should be specified.\n",
-							Required:    true,
+							Description: "Options for TCP health check.",
+							Optional:    true,
+							MaxItems:    1,
 							Elem: &schema.Resource{
 								Schema: map[string]*schema.Schema{
-									"name": {
-										Type:        schema.TypeString,
-										Description: "Name of the health check. The name must be unique for each target group that attached to a single load balancer.",
-										Required:    true,
-									},
-									"interval": {
-										Type:        schema.TypeInt,
-										Description: "The interval between health checks. The default is 2 seconds.",
-										Default:     2,
-										Optional:    true,
-									},
-									"timeout": {
-										Type:        schema.TypeInt,
-										Description: "Timeout for a target to return a response for the health check. The default is 1 second.",
-										Default:     1,
-										Optional:    true,
-									},
-									"unhealthy_threshold": {
+									"port": {
 										Type:        schema.TypeInt,
-										Description: "Number of failed health checks before changing the status to `UNHEALTHY`. The default is 2.",
-										Default:     2,
-										Optional:    true,
-									},
-									"healthy_threshold": {
-										Type:        schema.TypeInt,
-										Description: "Number of successful health checks required in order to set the `HEALTHY` status for the target.",
-										Default:     2,
-										Optional:    true,
-									},
-									"http_options": {
-										Type:        schema.TypeList,
-										Description: "Options for HTTP health check.",
-										Optional:    true,
-										MaxItems:    1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"port": {
-													Type:        schema.TypeInt,
-													Description: "Port to use for HTTP health checks.",
-													Required:    true,
-												},
-												"path": {
-													Type:        schema.TypeString,
-													Description: "URL path to set for health checking requests for every target in the target group. For example `/ping`. The default path is `/`.",
-													Optional:    true,
-												},
-											},
-										},
-									},
-									"tcp_options": {
-										Type:        schema.TypeList,
-										Description: "Options for TCP health check.",
-										Optional:    true,
-										MaxItems:    1,
-										Elem: &schema.Resource{
-											Schema: map[string]*schema.Schema{
-												"port": {
-													Type:        schema.TypeInt,
-													Description: "Port to use for TCP health checks.",
-													Required:    true,
-												},
-											},
-										},
+										Description: "Port to use for TCP health checks.",
+										Required:    true,
 									},
 								},
 							},
@@ -255,27 +298,8 @@ func resourceYandexLBNetworkLoadBalancer() *schema.Resource {
 					},
 				},
 			},
-
-			"created_at": {
-				Type:        schema.TypeString,
-				Description: common.ResourceDescriptions["created_at"],
-				Computed:    true,
-			},
-			"deletion_protection": {
-				Type:        schema.TypeBool,
-				Description: common.ResourceDescriptions["deletion_protection"],
-				Optional:    true,
-				Computed:    true,
-			},
-			"allow_zonal_shift": {
-				Type:        schema.TypeBool,
-				Description: "Flag that marks the network load balancer as available to zonal shift.",
-				Optional:    true,
-				Computed:    true,
-			},
 		},
 	}
-
 }
 
 func resourceYandexLBNetworkLoadBalancerCreate(d *schema.ResourceData, meta interface{}) error {
@@ -384,6 +408,10 @@ func resourceYandexLBNetworkLoadBalancerRead(d *schema.ResourceData, meta interf
 	d.Set("deletion_protection", nlb.DeletionProtection)
 	d.Set("allow_zonal_shift", nlb.AllowZonalShift)
 
+	if isLBAttachmentsManagementExternal(d) {
+		return d.Set("labels", nlb.Labels)
+	}
+
 	if err := d.Set("listener", ls); err != nil {
 		return err
 	}
@@ -424,6 +452,15 @@ func resourceYandexLBNetworkLoadBalancerUpdate(d *schema.ResourceData, meta inte
 		AllowZonalShift:       d.Get("allow_zonal_shift").(bool),
 	}
 
+	if isLBAttachmentsManagementExternal(d) {
+		// listeners and target groups belong to the attachment resources
+		req.ListenerSpecs = nil
+		req.AttachedTargetGroups = nil
+		req.UpdateMask = &field_mask.FieldMask{
+			Paths: []string{"name", "description", "labels", "deletion_protection", "allow_zonal_shift"},
+		}
+	}
+
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
@@ -440,6 +477,23 @@ func resourceYandexLBNetworkLoadBalancerUpdate(d *schema.ResourceData, meta inte
 	return resourceYandexLBNetworkLoadBalancerRead(d, meta)
 }
 
+func resourceYandexLBNetworkLoadBalancerCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Get("attachments_management").(string) != lbAttachmentsManagementExternal {
+		return nil
+	}
+
+	for _, key := range []string{"listener", "attached_target_group"} {
+		if d.Get(key).(*schema.Set).Len() > 0 {
+			return fmt.Errorf("%q can't be used together with attachments_management = %q", key, lbAttachmentsManagementExternal)
+		}
+	}
+	return nil
+}
+
+func isLBAttachmentsManagementExternal(d *schema.ResourceData) bool {
+	return d.Get("attachments_management").(string) == lbAttachmentsManagementExternal
+}
+
 func resourceYandexLBNetworkLoadBalancerDelete(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -0,0 +1,176 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
+)
+
+func resourceYandexLBNetworkLoadBalancerListener() *schema.Resource {
+	listenerSchema := recursivelyUpdateResource(lbNetworkLoadBalancerListenerResource(), func(s *schema.Schema) {
+		// listeners can't be changed in place, they are removed and added again
+		if s.Required || s.Optional {
+			s.ForceNew = true
+		}
+	}).Schema
+
+	listenerSchema["network_load_balancer_id"] = &schema.Schema{
+		Type:        schema.TypeString,
+		Description: "ID of the network load balancer to add the listener to.",
+		Required:    true,
+		ForceNew:    true,
+	}
+
+	return &schema.Resource{
+		Description: "Adds a listener to a network load balancer. Use it with `attachments_management = \"external\"` of the `yandex_lb_network_load_balancer`, so several configurations can share one load balancer. For more information, see [the official documentation](https://yandex.cloud/docs/network-load-balancer/concepts/listener).\n\n" +
+			"~> Any change of the listener recreates it.\n",
+
+		CreateContext: resourceYandexLBNetworkLoadBalancerListenerCreate,
+		ReadContext:   resourceYandexLBNetworkLoadBalancerListenerRead,
+		DeleteContext: resourceYandexLBNetworkLoadBalancerListenerDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexLBNetworkLoadBalancerListenerImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexLBNetworkLoadBalancerDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexLBNetworkLoadBalancerDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: listenerSchema,
+	}
+}
+
+func resourceYandexLBNetworkLoadBalancerListenerCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	nlbID := d.Get("network_load_balancer_id").(string)
+	ls, err := expandLBListenerSpec(map[string]interface{}{
+		"name":                  d.Get("name"),
+		"port":                  d.Get("port"),
+		"target_port":           d.Get("target_port"),
+		"protocol":              d.Get("protocol"),
+		"external_address_spec": d.Get("external_address_spec"),
+		"internal_address_spec": d.Get("internal_address_spec"),
+	})
+	if err != nil {
+		return diag.Errorf("Error expanding listener while adding it to network load balancer %q: %s", nlbID, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(nlbID)
+	defer mutexKV.Unlock(nlbID)
+
+	log.Printf("[DEBUG] Adding listener %q to NetworkLoadBalancer %q", ls.Name, nlbID)
+
+	op, err := config.sdk.WrapOperation(config.sdk.LoadBalancer().NetworkLoadBalancer().AddListener(ctx, &loadbalancer.AddNetworkLoadBalancerListenerRequest{
+		NetworkLoadBalancerId: nlbID,
+		ListenerSpec:          ls,
+	}))
+	if err != nil {
+		return diag.Errorf("Error while requesting API to add listener %q to NetworkLoadBalancer %q: %s", ls.Name, nlbID, err)
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		return diag.Errorf("Error while waiting operation to add listener %q to NetworkLoadBalancer %q: %s", ls.Name, nlbID, err)
+	}
+
+	d.SetId(makeLBNetworkLoadBalancerChildID(nlbID, ls.Name))
+
+	return resourceYandexLBNetworkLoadBalancerListenerRead(ctx, d, meta)
+}
+
+func resourceYandexLBNetworkLoadBalancerListenerRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	nlbID, name, err := parseLBNetworkLoadBalancerChildID(d.Id(), "listener_name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	nlb, err := config.sdk.LoadBalancer().NetworkLoadBalancer().Get(ctx, &loadbalancer.GetNetworkLoadBalancerRequest{
+		NetworkLoadBalancerId: nlbID,
+	})
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("NetworkLoadBalancer %q", nlbID)))
+	}
+
+	var listener *loadbalancer.Listener
+	for _, ls := range nlb.GetListeners() {
+		if ls.GetName() == name {
+			listener = ls
+			break
+		}
+	}
+	if listener == nil {
+		log.Printf("[WARN] Listener %q is not found in NetworkLoadBalancer %q, removing listener from state", name, nlbID)
+		d.SetId("")
+		return nil
+	}
+
+	flListener, err := flattenLBListener(nlb.GetType(), listener)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("network_load_balancer_id", nlbID)
+	for key, value := range flListener {
+		if err := d.Set(key, value); err != nil {
+			return diag.FromErr(err)
+		}
+	}
+
+	return nil
+}
+
+func resourceYandexLBNetworkLoadBalancerListenerDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	nlbID, name, err := parseLBNetworkLoadBalancerChildID(d.Id(), "listener_name")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(nlbID)
+	defer mutexKV.Unlock(nlbID)
+
+	log.Printf("[DEBUG] Removing listener %q from NetworkLoadBalancer %q", name, nlbID)
+
+	op, err := config.sdk.WrapOperation(config.sdk.LoadBalancer().NetworkLoadBalancer().RemoveListener(ctx, &loadbalancer.RemoveNetworkLoadBalancerListenerRequest{
+		NetworkLoadBalancerId: nlbID,
+		ListenerName:          name,
+	}))
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("Listener %q of NetworkLoadBalancer %q", name, nlbID)))
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		return diag.Errorf("Error while waiting operation to remove listener %q from NetworkLoadBalancer %q: %s", name, nlbID, err)
+	}
+
+	log.Printf("[DEBUG] Finished removing listener %q from NetworkLoadBalancer %q", name, nlbID)
+	return nil
+}
+
+func resourceYandexLBNetworkLoadBalancerListenerImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := parseLBNetworkLoadBalancerChildID(d.Id(), "listener_name"); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
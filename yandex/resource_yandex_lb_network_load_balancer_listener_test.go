@@ -0,0 +1,111 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
+)
+
+func TestParseLBNetworkLoadBalancerChildID(t *testing.T) {
+	nlbID, name, err := parseLBNetworkLoadBalancerChildID(makeLBNetworkLoadBalancerChildID("enp1", "http"), "listener_name")
+	if err != nil || nlbID != "enp1" || name != "http" {
+		t.Fatalf("Unexpected result of parsing: %q, %q, %v", nlbID, name, err)
+	}
+
+	for _, id := range []string{"", "enp1", "enp1/", "/http", "enp1/http/extra"} {
+		if _, _, err := parseLBNetworkLoadBalancerChildID(id, "listener_name"); err == nil {
+			t.Errorf("Parsing of %q should fail", id)
+		}
+	}
+}
+
+func TestResourceYandexLBNetworkLoadBalancerListenerForceNew(t *testing.T) {
+	var check func(prefix string, s map[string]*schema.Schema)
+	check = func(prefix string, s map[string]*schema.Schema) {
+		for key, attr := range s {
+			if (attr.Required || attr.Optional) && !attr.ForceNew {
+				t.Errorf("Attribute %q of the listener should force new resource", prefix+key)
+			}
+			if elem, ok := attr.Elem.(*schema.Resource); ok {
+				check(prefix+key+".", elem.Schema)
+			}
+		}
+	}
+	check("", resourceYandexLBNetworkLoadBalancerListener().Schema)
+}
+
+func TestAccLBNetworkLoadBalancerListener_basic(t *testing.T) {
+	t.Parallel()
+
+	var nlb loadbalancer.NetworkLoadBalancer
+	nlbName := acctest.RandomWithPrefix("tf-network-load-balancer")
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBNetworkLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBNetworkLoadBalancerListener_basic(nlbName, 8080),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBNetworkLoadBalancerExists(nlbResource, &nlb),
+					testAccCheckLBNetworkLoadBalancerValues(&nlb, 2, 0, nil, nil),
+					// listeners of the listener resources are not tracked by the load balancer
+					resource.TestCheckResourceAttr(nlbResource, "listener.#", "0"),
+					resource.TestCheckResourceAttr("yandex_lb_network_load_balancer_listener.http", "port", "8080"),
+					resource.TestCheckResourceAttr("yandex_lb_network_load_balancer_listener.http", "target_port", "8080"),
+					resource.TestCheckResourceAttr("yandex_lb_network_load_balancer_listener.http", "protocol", "tcp"),
+					resource.TestCheckResourceAttrSet("yandex_lb_network_load_balancer_listener.http", "external_address_spec.0.address"),
+				),
+			},
+			{
+				Config: testAccLBNetworkLoadBalancerListener_basic(nlbName, 8081),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBNetworkLoadBalancerExists(nlbResource, &nlb),
+					testAccCheckLBNetworkLoadBalancerValues(&nlb, 2, 0, nil, nil),
+					resource.TestCheckResourceAttr("yandex_lb_network_load_balancer_listener.http", "port", "8081"),
+				),
+			},
+			{
+				ResourceName:      "yandex_lb_network_load_balancer_listener.http",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+//revive:disable:var-naming
+func testAccLBNetworkLoadBalancerListener_basic(name string, port int) string {
+	return fmt.Sprintf(`
+resource "yandex_lb_network_load_balancer" "test-nlb" {
+  name                   = "%s"
+  attachments_management = "external"
+}
+
+resource "yandex_lb_network_load_balancer_listener" "http" {
+  network_load_balancer_id = "${yandex_lb_network_load_balancer.test-nlb.id}"
+  name                     = "http"
+  port                     = %d
+
+  external_address_spec {
+    ip_version = "ipv4"
+  }
+}
+
+resource "yandex_lb_network_load_balancer_listener" "dns" {
+  network_load_balancer_id = "${yandex_lb_network_load_balancer.test-nlb.id}"
+  name                     = "dns"
+  port                     = 53
+  protocol                 = "udp"
+
+  external_address_spec {
+    ip_version = "ipv4"
+  }
+}
+`, name, port)
+}
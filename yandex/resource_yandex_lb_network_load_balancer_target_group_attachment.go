@@ -0,0 +1,230 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
+	"google.golang.org/genproto/protobuf/field_mask"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
+)
+
+func resourceYandexLBNetworkLoadBalancerTargetGroupAttachment() *schema.Resource {
+	atgSchema := lbNetworkLoadBalancerAttachedTargetGroupResource().Schema
+
+	return &schema.Resource{
+		Description: "Attaches a target group to a network load balancer. Use it with `attachments_management = \"external\"` of the `yandex_lb_network_load_balancer`, so several configurations can share one load balancer. For more information, see [the official documentation](https://yandex.cloud/docs/network-load-balancer/concepts/target-resources).\n\n" +
+			"Health checks are updated in place, other changes reattach the target group.\n",
+
+		CreateContext: resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentCreate,
+		ReadContext:   resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentRead,
+		UpdateContext: resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentUpdate,
+		DeleteContext: resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexLBNetworkLoadBalancerDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexLBNetworkLoadBalancerDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexLBNetworkLoadBalancerDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"network_load_balancer_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the network load balancer to attach the target group to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"target_group_id": {
+				Type:        schema.TypeString,
+				Description: atgSchema["target_group_id"].Description,
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"healthcheck": atgSchema["healthcheck"],
+		},
+	}
+}
+
+func resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	nlbID := d.Get("network_load_balancer_id").(string)
+	atg, err := expandLBNetworkLoadBalancerTargetGroupAttachment(d)
+	if err != nil {
+		return diag.Errorf("Error expanding attached target group while attaching it to network load balancer %q: %s", nlbID, err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(nlbID)
+	defer mutexKV.Unlock(nlbID)
+
+	log.Printf("[DEBUG] Attaching target group %q to NetworkLoadBalancer %q", atg.TargetGroupId, nlbID)
+
+	op, err := config.sdk.WrapOperation(config.sdk.LoadBalancer().NetworkLoadBalancer().AttachTargetGroup(ctx, &loadbalancer.AttachNetworkLoadBalancerTargetGroupRequest{
+		NetworkLoadBalancerId: nlbID,
+		AttachedTargetGroup:   atg,
+	}))
+	if err != nil {
+		return diag.Errorf("Error while requesting API to attach target group %q to NetworkLoadBalancer %q: %s", atg.TargetGroupId, nlbID, err)
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		return diag.Errorf("Error while waiting operation to attach target group %q to NetworkLoadBalancer %q: %s", atg.TargetGroupId, nlbID, err)
+	}
+
+	d.SetId(makeLBNetworkLoadBalancerChildID(nlbID, atg.TargetGroupId))
+
+	return resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentRead(ctx, d, meta)
+}
+
+func resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	nlbID, targetGroupID, err := parseLBNetworkLoadBalancerChildID(d.Id(), "target_group_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	nlb, err := config.sdk.LoadBalancer().NetworkLoadBalancer().Get(ctx, &loadbalancer.GetNetworkLoadBalancerRequest{
+		NetworkLoadBalancerId: nlbID,
+	})
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("NetworkLoadBalancer %q", nlbID)))
+	}
+
+	atg := findLBAttachedTargetGroup(nlb.GetAttachedTargetGroups(), targetGroupID)
+	if atg == nil {
+		log.Printf("[WARN] Target group %q is not attached to NetworkLoadBalancer %q, removing attachment from state", targetGroupID, nlbID)
+		d.SetId("")
+		return nil
+	}
+
+	hcs, err := flattenLBHealthchecks(atg)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.Set("network_load_balancer_id", nlbID)
+	d.Set("target_group_id", targetGroupID)
+	if err := d.Set("healthcheck", hcs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	nlbID, targetGroupID, err := parseLBNetworkLoadBalancerChildID(d.Id(), "target_group_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	atg, err := expandLBNetworkLoadBalancerTargetGroupAttachment(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	// There is no API call to change health checks of an attached target group,
+	// so the whole list of the attached target groups is updated under the load balancer lock.
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(nlbID)
+	defer mutexKV.Unlock(nlbID)
+
+	nlb, err := config.sdk.LoadBalancer().NetworkLoadBalancer().Get(ctx, &loadbalancer.GetNetworkLoadBalancerRequest{
+		NetworkLoadBalancerId: nlbID,
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	atgs := nlb.GetAttachedTargetGroups()
+	current := findLBAttachedTargetGroup(atgs, targetGroupID)
+	if current == nil {
+		return diag.Errorf("target group %q is not attached to NetworkLoadBalancer %q", targetGroupID, nlbID)
+	}
+	current.HealthChecks = atg.HealthChecks
+
+	log.Printf("[DEBUG] Updating health checks of target group %q attached to NetworkLoadBalancer %q", targetGroupID, nlbID)
+
+	op, err := config.sdk.WrapOperation(config.sdk.LoadBalancer().NetworkLoadBalancer().Update(ctx, &loadbalancer.UpdateNetworkLoadBalancerRequest{
+		NetworkLoadBalancerId: nlbID,
+		AttachedTargetGroups:  atgs,
+		UpdateMask:            &field_mask.FieldMask{Paths: []string{"attached_target_groups"}},
+	}))
+	if err != nil {
+		return diag.Errorf("Error while requesting API to update health checks of target group %q attached to NetworkLoadBalancer %q: %s", targetGroupID, nlbID, err)
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		return diag.Errorf("Error while waiting operation to update health checks of target group %q attached to NetworkLoadBalancer %q: %s", targetGroupID, nlbID, err)
+	}
+
+	return resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentRead(ctx, d, meta)
+}
+
+func resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	nlbID, targetGroupID, err := parseLBNetworkLoadBalancerChildID(d.Id(), "target_group_id")
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(nlbID)
+	defer mutexKV.Unlock(nlbID)
+
+	log.Printf("[DEBUG] Detaching target group %q from NetworkLoadBalancer %q", targetGroupID, nlbID)
+
+	op, err := config.sdk.WrapOperation(config.sdk.LoadBalancer().NetworkLoadBalancer().DetachTargetGroup(ctx, &loadbalancer.DetachNetworkLoadBalancerTargetGroupRequest{
+		NetworkLoadBalancerId: nlbID,
+		TargetGroupId:         targetGroupID,
+	}))
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("Target group %q attached to NetworkLoadBalancer %q", targetGroupID, nlbID)))
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		return diag.Errorf("Error while waiting operation to detach target group %q from NetworkLoadBalancer %q: %s", targetGroupID, nlbID, err)
+	}
+
+	log.Printf("[DEBUG] Finished detaching target group %q from NetworkLoadBalancer %q", targetGroupID, nlbID)
+	return nil
+}
+
+func resourceYandexLBNetworkLoadBalancerTargetGroupAttachmentImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := parseLBNetworkLoadBalancerChildID(d.Id(), "target_group_id"); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandLBNetworkLoadBalancerTargetGroupAttachment(d *schema.ResourceData) (*loadbalancer.AttachedTargetGroup, error) {
+	return expandLBAttachedTargetGroup(map[string]interface{}{
+		"target_group_id": d.Get("target_group_id"),
+		"healthcheck":     d.Get("healthcheck"),
+	})
+}
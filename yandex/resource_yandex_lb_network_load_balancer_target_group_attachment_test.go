@@ -0,0 +1,87 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/loadbalancer/v1"
+)
+
+func TestAccLBNetworkLoadBalancerTargetGroupAttachment_basic(t *testing.T) {
+	t.Parallel()
+
+	var nlb loadbalancer.NetworkLoadBalancer
+	nlbName := acctest.RandomWithPrefix("tf-network-load-balancer")
+	tgName := acctest.RandomWithPrefix("tf-tg")
+	baseTemplate := testAccLBBaseTemplate(acctest.RandomWithPrefix("tf-instance"))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckLBNetworkLoadBalancerDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccLBNetworkLoadBalancerTargetGroupAttachment_basic(nlbName, tgName, baseTemplate, 2),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBNetworkLoadBalancerExists(nlbResource, &nlb),
+					testAccCheckLBNetworkLoadBalancerValues(&nlb, 1, 1, nil, func(atg *loadbalancer.AttachedTargetGroup) error {
+						return checkLBAttachedTargetGroup(atg, "http", 2, 1, 2, 2, 8080, "/ping")
+					}),
+					resource.TestCheckResourceAttr(nlbResource, "attached_target_group.#", "0"),
+					resource.TestCheckResourceAttr("yandex_lb_network_load_balancer_target_group_attachment.tg", "healthcheck.0.interval", "2"),
+				),
+			},
+			{
+				Config: testAccLBNetworkLoadBalancerTargetGroupAttachment_basic(nlbName, tgName, baseTemplate, 5),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckLBNetworkLoadBalancerExists(nlbResource, &nlb),
+					testAccCheckLBNetworkLoadBalancerValues(&nlb, 1, 1, nil, func(atg *loadbalancer.AttachedTargetGroup) error {
+						return checkLBAttachedTargetGroup(atg, "http", 5, 1, 2, 2, 8080, "/ping")
+					}),
+					resource.TestCheckResourceAttr("yandex_lb_network_load_balancer_target_group_attachment.tg", "healthcheck.0.interval", "5"),
+				),
+			},
+			{
+				ResourceName:      "yandex_lb_network_load_balancer_target_group_attachment.tg",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+//revive:disable:var-naming
+func testAccLBNetworkLoadBalancerTargetGroupAttachment_basic(nlbName, tgName, baseTemplate string, interval int) string {
+	return fmt.Sprintf(`
+resource "yandex_lb_network_load_balancer" "test-nlb" {
+  name                   = "%s"
+  attachments_management = "external"
+}
+
+resource "yandex_lb_network_load_balancer_listener" "http" {
+  network_load_balancer_id = "${yandex_lb_network_load_balancer.test-nlb.id}"
+  name                     = "http"
+  port                     = 8080
+
+  external_address_spec {
+    ip_version = "ipv4"
+  }
+}
+
+resource "yandex_lb_network_load_balancer_target_group_attachment" "tg" {
+  network_load_balancer_id = "${yandex_lb_network_load_balancer.test-nlb.id}"
+  target_group_id          = "${yandex_lb_target_group.test-tg.id}"
+
+  healthcheck {
+    name     = "http"
+    interval = %d
+    http_options {
+      port = 8080
+      path = "/ping"
+    }
+  }
+}
+`, nlbName, interval) + testAccLBGeneralTGTemplate(tgName, "tg-description", baseTemplate, 2, false)
+}
@@ -17,6 +17,7 @@ import (
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/mdb/clickhouse/v1"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/operation"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/mdbcommon"
 )
 
 const (
@@ -331,6 +332,8 @@ func resourceYandexMDBClickHouseCluster() *schema.Resource {
 			Delete: schema.DefaultTimeout(yandexMDBClickHouseClusterDeleteTimeout),
 		},
 
+		CustomizeDiff: clickHouseVersionUpgradeDiff,
+
 		SchemaVersion: 0,
 
 		Schema: map[string]*schema.Schema{
@@ -1672,6 +1675,41 @@ var mdbClickhouseMergeTreeUpdateFields = []string{
 	"max_cleanup_delay_period",
 }
 
+// clickHouseUpgradePlanner loads the allowed upgrades of ClickHouse versions from the API.
+// If the versions can't be listed, upgrades are passed to the API as is.
+func clickHouseUpgradePlanner(ctx context.Context, config *Config) *mdbcommon.VersionUpgradePlanner {
+	planner := &mdbcommon.VersionUpgradePlanner{
+		Engine: "ClickHouse",
+		Rules:  mdbcommon.VersionUpgradeRules{},
+	}
+
+	versions, err := config.sdk.MDB().Clickhouse().Versions().VersionsIterator(ctx, &clickhouse.ListVersionsRequest{}).TakeAll()
+	if err != nil {
+		log.Printf("[WARN] Failed to list ClickHouse versions, upgrade path is not validated: %s", err)
+		return planner
+	}
+
+	for _, v := range versions {
+		planner.Rules[v.Id] = v.UpdatableTo
+	}
+	return planner
+}
+
+// clickHouseVersionUpgradeDiff rejects upgrades which are not allowed by the API at plan time.
+func clickHouseVersionUpgradeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChange("version") || !d.NewValueKnown("version") {
+		return nil
+	}
+
+	oldVersion, newVersion := d.GetChange("version")
+	if oldVersion.(string) == "" || newVersion.(string) == "" {
+		return nil
+	}
+
+	_, err := clickHouseUpgradePlanner(ctx, meta.(*Config)).UpgradePath(oldVersion.(string), newVersion.(string))
+	return err
+}
+
 func updateClickHouseClusterParams(d *schema.ResourceData, meta interface{}) error {
 	config := meta.(*Config)
 
@@ -1679,27 +1717,36 @@ func updateClickHouseClusterParams(d *schema.ResourceData, meta interface{}) err
 		oldVersion, newVersion := d.GetChange("version")
 		log.Printf("[DEBUG] Pre-updating ClickHouse Cluster %q version %q -> %q", d.Id(), oldVersion, newVersion)
 
-		req := &clickhouse.UpdateClusterRequest{
-			ClusterId: d.Id(),
-			ConfigSpec: &clickhouse.ConfigSpec{
-				Version: d.Get("version").(string),
-			},
-			UpdateMask: &field_mask.FieldMask{
-				Paths: []string{"config_spec.version"},
-			},
-		}
-
 		ctx, cancel := config.ContextWithTimeout(d.Timeout(schema.TimeoutUpdate))
 		defer cancel()
 
-		op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().Update(ctx, req))
+		upgradePath, err := clickHouseUpgradePlanner(ctx, config).UpgradePath(oldVersion.(string), newVersion.(string))
 		if err != nil {
-			return fmt.Errorf("error while requesting API to update ClickHouse Cluster version %q: %s", d.Id(), err)
+			return err
 		}
 
-		err = op.WaitInterval(ctx, yandexMDBClickHouseClusterPollInterval)
-		if err != nil {
-			return fmt.Errorf("error while updating ClickHouse Cluster version %q: %s", d.Id(), err)
+		for i, version := range upgradePath {
+			log.Printf("[INFO] Upgrading ClickHouse Cluster %q version to %q, step %d of %d", d.Id(), version, i+1, len(upgradePath))
+
+			req := &clickhouse.UpdateClusterRequest{
+				ClusterId: d.Id(),
+				ConfigSpec: &clickhouse.ConfigSpec{
+					Version: version,
+				},
+				UpdateMask: &field_mask.FieldMask{
+					Paths: []string{"config_spec.version"},
+				},
+			}
+
+			op, err := config.sdk.WrapOperation(config.sdk.MDB().Clickhouse().Cluster().Update(ctx, req))
+			if err != nil {
+				return fmt.Errorf("error while requesting API to update ClickHouse Cluster version %q: %s", d.Id(), err)
+			}
+
+			err = op.WaitInterval(ctx, yandexMDBClickHouseClusterPollInterval)
+			if err != nil {
+				return fmt.Errorf("error while updating ClickHouse Cluster version %q: %s", d.Id(), err)
+			}
 		}
 	}
 
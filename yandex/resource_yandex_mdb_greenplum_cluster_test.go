@@ -124,9 +124,9 @@ func TestAccMDBGreenplumCluster_full(t *testing.T) {
 	folderID := getExampleFolderID()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMDBGreenplumClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBGreenplumClusterDestroy,
 		Steps: []resource.TestStep{
 			// Create Greenplum Cluster
 			{
@@ -686,9 +686,9 @@ func TestAccMDBMongoDBCluster_6_0(t *testing.T) {
 	folderID := getExampleFolderID()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
-		CheckDestroy:      testAccCheckVPCNetworkDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckVPCNetworkDestroy,
 		Steps: []resource.TestStep{
 			// Create MongoDB Cluster
 			{
@@ -1053,8 +1053,8 @@ func TestAccMDBMongoDBCluster_6_0NotShardedV0(t *testing.T) {
 		DiskTypeId:       s2Small26hdd.DiskTypeId,
 	}
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckMDBMongoDBClusterDestroy,
 			testAccCheckVPCNetworkDestroy,
@@ -1144,8 +1144,8 @@ func TestAccMDBMongoDBCluster_6_0ShardedCfgV0(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckMDBMongoDBClusterDestroy,
 			testAccCheckVPCNetworkDestroy,
@@ -1309,8 +1309,8 @@ func TestAccMDBMongoDBCluster_6_0ShardedInfraV0(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckMDBMongoDBClusterDestroy,
 			testAccCheckVPCNetworkDestroy,
@@ -1394,8 +1394,8 @@ func TestAccMDBMongoDBCluster_6_0NotShardedV1(t *testing.T) {
 		DiskTypeId:       s2Small26hdd.DiskTypeId,
 	}
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckMDBMongoDBClusterDestroy,
 			testAccCheckVPCNetworkDestroy,
@@ -1552,8 +1552,8 @@ func TestAccMDBMongoDBCluster_6_0ShardedCfgV1(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckMDBMongoDBClusterDestroy,
 			testAccCheckVPCNetworkDestroy,
@@ -1754,8 +1754,8 @@ func TestAccMDBMongoDBCluster_6_0ShardedInfraV1(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckMDBMongoDBClusterDestroy,
 			testAccCheckVPCNetworkDestroy,
@@ -1939,8 +1939,8 @@ func TestAccMDBMongoDBCluster_enableSharding(t *testing.T) {
 	}
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckMDBMongoDBClusterDestroy,
 			testAccCheckVPCNetworkDestroy,
@@ -2033,8 +2033,8 @@ func TestAccMDBMongoDBCluster_restore(t *testing.T) {
 	folderID := getExampleFolderID()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:          func() { testAccPreCheck(t) },
-		ProviderFactories: testAccProviderFactories,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
 		CheckDestroy: resource.ComposeTestCheckFunc(
 			testAccCheckMDBMongoDBClusterDestroy,
 			testAccCheckVPCNetworkDestroy,
@@ -114,9 +114,9 @@ func TestAccMDBMySQLCluster_full(t *testing.T) {
 	var hostNames *[]string = new([]string)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMDBMysqlClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBMysqlClusterDestroy,
 		Steps: []resource.TestStep{
 			// Create MySQL Cluster
 			{
@@ -247,9 +247,9 @@ func TestAccMDBMySQLClusterHA_update(t *testing.T) {
 	var hostNames *[]string = new([]string)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMDBMysqlClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBMysqlClusterDestroy,
 		Steps: []resource.TestStep{
 			//Add new host
 			{
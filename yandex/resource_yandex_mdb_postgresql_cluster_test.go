@@ -124,9 +124,9 @@ func TestAccMDBPostgreSQLCluster_full(t *testing.T) {
 	var hostNames *[]string = new([]string)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMDBPGClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBPGClusterDestroy,
 		Steps: []resource.TestStep{
 			// 1. Create PostgreSQL Cluster
 			{
@@ -350,9 +350,9 @@ func TestAccMDBPostgreSQLCluster_HAWithoutNames_update(t *testing.T) {
 	var hostNames *[]string = new([]string)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMDBPGClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBPGClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccMDBPGClusterConfigHA(clusterName, version),
@@ -401,9 +401,9 @@ func TestAccMDBPostgreSQLCluster_HAWithNames_update(t *testing.T) {
 	var hostNames *[]string = new([]string)
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMDBPGClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBPGClusterDestroy,
 		Steps: []resource.TestStep{
 			// 1. Create PostgreSQL Cluster
 			{
@@ -500,9 +500,9 @@ func TestAccMDBPostgreSQLCluster_restore(t *testing.T) {
 	folderId := getExampleFolderID()
 
 	resource.Test(t, resource.TestCase{
-		PreCheck:     func() { testAccPreCheck(t) },
-		Providers:    testAccProviders,
-		CheckDestroy: testAccCheckMDBPGClusterDestroy,
+		PreCheck:                 func() { testAccPreCheck(t) },
+		ProtoV6ProviderFactories: testAccMuxProviderFactories,
+		CheckDestroy:             testAccCheckMDBPGClusterDestroy,
 		Steps: []resource.TestStep{
 			{
 				Config: testAccMDBPGClusterConfigRestore(clusterName, true),
@@ -0,0 +1,330 @@
+package yandex
+
+import (
+	"context"
+	"encoding/binary"
+	"fmt"
+	"log"
+	"net"
+	"sort"
+	"sync"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
+	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/hashcode"
+)
+
+// vpcCIDRAllocations keeps the prefixes of the allocations planned or created by the current provider process.
+// Allocations of one apply don't see each other in the used ranges of the pool, so the names that lead
+// to the same prefix are reported instead of allocating the prefix twice.
+var vpcCIDRAllocations = struct {
+	sync.Mutex
+	byPool map[string]map[string]*net.IPNet
+}{byPool: map[string]map[string]*net.IPNet{}}
+
+func resourceYandexVPCCIDRAllocation() *schema.Resource {
+	return &schema.Resource{
+		Description: "Allocates a free IPv4 prefix of the given length from a `yandex_vpc_cidr_pool`. The prefix is chosen at the plan time and stays in the Terraform state, so it can be passed to `v4_cidr_blocks` of a `yandex_vpc_subnet`.\n\n" +
+			"The prefix depends only on the pool supernet, the ranges taken in the pool and the allocation name: the search for the first free prefix starts at the position derived from the name, so allocations created by the same apply get the same prefixes in any order.\n",
+
+		CreateContext: resourceYandexVPCCIDRAllocationCreate,
+		ReadContext:   resourceYandexVPCCIDRAllocationRead,
+		UpdateContext: resourceYandexVPCCIDRAllocationUpdate,
+		DeleteContext: resourceYandexVPCCIDRAllocationDelete,
+
+		CustomizeDiff: resourceYandexVPCCIDRAllocationDiff,
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"pool_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the `yandex_vpc_cidr_pool` to allocate the prefix from.",
+				Required:    true,
+			},
+
+			"supernet": {
+				Type:         schema.TypeString,
+				Description:  "Supernet of the pool, `supernet` of the `yandex_vpc_cidr_pool`. The allocated prefix is kept while it fits the supernet.",
+				Required:     true,
+				ValidateFunc: validation.IsCIDRNetwork(0, 32),
+			},
+
+			"used_cidrs": {
+				Type:        schema.TypeList,
+				Description: "Ranges taken in the pool, `used_cidrs` of the `yandex_vpc_cidr_pool`. Changes of them don't affect the allocated prefix.",
+				Optional:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+
+			"name": {
+				Type:        schema.TypeString,
+				Description: "Name of the allocation, unique within the pool.",
+				Required:    true,
+			},
+
+			"prefix_length": {
+				Type:         schema.TypeInt,
+				Description:  "Length of the prefix to allocate, for example `24`. VPC subnets support lengths from 16 to 28.",
+				Required:     true,
+				ValidateFunc: validation.IntBetween(16, 28),
+			},
+
+			"cidr": {
+				Type:        schema.TypeString,
+				Description: "Allocated prefix in CIDR notation.",
+				Computed:    true,
+			},
+		},
+	}
+}
+
+// resourceYandexVPCCIDRAllocationDiff allocates the prefix at the plan time, so it's shown in the plan.
+// The allocated prefix is kept while it has the requested length and fits the supernet.
+func resourceYandexVPCCIDRAllocationDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if !d.NewValueKnown("supernet") || !d.NewValueKnown("prefix_length") {
+		return d.SetNewComputed("cidr")
+	}
+
+	_, supernet, err := net.ParseCIDR(d.Get("supernet").(string))
+	if err != nil {
+		return err
+	}
+
+	allocated := keptVPCCIDRAllocation(supernet, d.Get("cidr").(string), d.Get("prefix_length").(int))
+	if allocated == nil {
+		// the used ranges are unknown while the pool is being created or replaced
+		if !d.NewValueKnown("used_cidrs") || !d.NewValueKnown("name") {
+			return d.SetNewComputed("cidr")
+		}
+
+		used, err := expandVPCCIDRs(d.Get("used_cidrs").([]interface{}))
+		if err != nil {
+			return err
+		}
+		if allocated, err = allocateVPCCIDR(supernet, used, d.Get("name").(string), d.Get("prefix_length").(int)); err != nil {
+			return err
+		}
+	}
+
+	if d.NewValueKnown("pool_id") {
+		if err := registerVPCCIDRAllocation(d.Get("pool_id").(string), d.Get("name").(string), allocated); err != nil {
+			return err
+		}
+	}
+
+	if allocated.String() == d.Get("cidr").(string) {
+		return nil
+	}
+	return d.SetNew("cidr", allocated.String())
+}
+
+func resourceYandexVPCCIDRAllocationCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setVPCCIDRAllocation(d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return resourceYandexVPCCIDRAllocationRead(ctx, d, meta)
+}
+
+func resourceYandexVPCCIDRAllocationRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The allocation has no cloud counterpart, the prefix stays as it was allocated.
+	return nil
+}
+
+func resourceYandexVPCCIDRAllocationUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	if err := setVPCCIDRAllocation(d); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceYandexVPCCIDRAllocationRead(ctx, d, meta)
+}
+
+func resourceYandexVPCCIDRAllocationDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	vpcCIDRAllocations.Lock()
+	delete(vpcCIDRAllocations.byPool[d.Get("pool_id").(string)], d.Get("name").(string))
+	vpcCIDRAllocations.Unlock()
+
+	d.SetId("")
+	return nil
+}
+
+// setVPCCIDRAllocation keeps the prefix allocated at the plan time and allocates it only if it was unknown,
+// e.g. when the pool is created by the same apply.
+func setVPCCIDRAllocation(d *schema.ResourceData) error {
+	poolID := d.Get("pool_id").(string)
+	name := d.Get("name").(string)
+
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(poolID)
+	defer mutexKV.Unlock(poolID)
+
+	_, supernet, err := net.ParseCIDR(d.Get("supernet").(string))
+	if err != nil {
+		return err
+	}
+
+	allocated := keptVPCCIDRAllocation(supernet, d.Get("cidr").(string), d.Get("prefix_length").(int))
+	if allocated == nil {
+		used, err := expandVPCCIDRs(d.Get("used_cidrs").([]interface{}))
+		if err != nil {
+			return err
+		}
+		if allocated, err = allocateVPCCIDR(supernet, used, name, d.Get("prefix_length").(int)); err != nil {
+			return err
+		}
+	}
+
+	if err := registerVPCCIDRAllocation(poolID, name, allocated); err != nil {
+		return err
+	}
+
+	log.Printf("[DEBUG] Allocated prefix %q from CIDR pool %q", allocated.String(), poolID)
+	return d.Set("cidr", allocated.String())
+}
+
+// registerVPCCIDRAllocation fails if the prefix overlaps the prefix of another allocation of the pool.
+func registerVPCCIDRAllocation(poolID, name string, allocated *net.IPNet) error {
+	vpcCIDRAllocations.Lock()
+	defer vpcCIDRAllocations.Unlock()
+
+	allocations := vpcCIDRAllocations.byPool[poolID]
+	if allocations == nil {
+		allocations = map[string]*net.IPNet{}
+		vpcCIDRAllocations.byPool[poolID] = allocations
+	}
+
+	for other, r := range allocations {
+		if other != name && cidrsOverlap(r, allocated) {
+			return fmt.Errorf("prefix %q of allocation %q overlaps prefix %q of allocation %q of the same CIDR pool, rename one of them", allocated.String(), name, r.String(), other)
+		}
+	}
+	allocations[name] = allocated
+	return nil
+}
+
+// allocateVPCCIDR returns the first free prefix of the given length, the search starts at the position derived
+// from the allocation name and wraps around the supernet.
+func allocateVPCCIDR(supernet *net.IPNet, used []*net.IPNet, name string, prefixLength int) (*net.IPNet, error) {
+	return nextFreeVPCCIDRFrom(supernet, prefixLength, used, uint64(hashcode.String(name)))
+}
+
+func keptVPCCIDRAllocation(supernet *net.IPNet, cidr string, prefixLength int) *net.IPNet {
+	if cidr == "" {
+		return nil
+	}
+	_, r, err := net.ParseCIDR(cidr)
+	if err != nil {
+		return nil
+	}
+	if ones, _ := r.Mask.Size(); ones != prefixLength || !cidrContains(supernet, r) {
+		return nil
+	}
+	return r
+}
+
+func expandVPCCIDRs(v []interface{}) ([]*net.IPNet, error) {
+	var cidrs []*net.IPNet
+	for _, cidr := range expandStringSlice(v) {
+		_, r, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		cidrs = append(cidrs, r)
+	}
+	return cidrs, nil
+}
+
+// nextFreeVPCCIDR returns the lowest prefix of the given length inside the supernet which doesn't overlap any of the used ranges.
+func nextFreeVPCCIDR(supernet *net.IPNet, prefixLength int, used []*net.IPNet) (*net.IPNet, error) {
+	return nextFreeVPCCIDRFrom(supernet, prefixLength, used, 0)
+}
+
+// nextFreeVPCCIDRFrom returns the first prefix of the given length which doesn't overlap any of the used ranges,
+// the search starts at the prefix with the given number, modulo the number of prefixes in the supernet,
+// and wraps around the supernet.
+func nextFreeVPCCIDRFrom(supernet *net.IPNet, prefixLength int, used []*net.IPNet, start uint64) (*net.IPNet, error) {
+	supernetLength, bits := supernet.Mask.Size()
+	if bits != 32 {
+		return nil, fmt.Errorf("only IPv4 supernets are supported, got %q", supernet.String())
+	}
+	if prefixLength < supernetLength || prefixLength > 32 {
+		return nil, fmt.Errorf("prefix length %d doesn't fit the supernet %q", prefixLength, supernet.String())
+	}
+
+	used = append([]*net.IPNet{}, used...)
+	sortCIDRs(used)
+
+	first, last := ipv4RangeBounds(supernet)
+	size := uint64(1) << uint(32-prefixLength)
+	from := first + start%(uint64(1)<<uint(prefixLength-supernetLength))*size
+
+	candidate, ok := firstFreeVPCCIDR(from, last, size, used)
+	if !ok && from > first {
+		candidate, ok = firstFreeVPCCIDR(first, from-1, size, used)
+	}
+	if !ok {
+		return nil, fmt.Errorf("no free /%d prefix is left in the supernet %q", prefixLength, supernet.String())
+	}
+
+	ip := make(net.IP, 4)
+	binary.BigEndian.PutUint32(ip, uint32(candidate))
+	return &net.IPNet{IP: ip, Mask: net.CIDRMask(prefixLength, 32)}, nil
+}
+
+// firstFreeVPCCIDR returns the first address of the lowest aligned range of the given size between from and to,
+// which doesn't overlap any of the used ranges sorted by address.
+func firstFreeVPCCIDR(from, to, size uint64, used []*net.IPNet) (uint64, bool) {
+	for candidate := from; candidate+size-1 <= to; {
+		next := candidate
+		for _, r := range used {
+			rFirst, rLast := ipv4RangeBounds(r)
+			if rFirst <= candidate+size-1 && candidate <= rLast {
+				// skip to the first aligned prefix after the used range
+				next = (rLast/size + 1) * size
+				break
+			}
+		}
+
+		if next == candidate {
+			return candidate, true
+		}
+		candidate = next
+	}
+	return 0, false
+}
+
+// ipv4RangeBounds returns the first and the last addresses of the range, widened to uint64 to avoid overflows.
+func ipv4RangeBounds(r *net.IPNet) (uint64, uint64) {
+	ip := r.IP.To4()
+	if ip == nil {
+		return 0, 0
+	}
+	ones, _ := r.Mask.Size()
+	first := uint64(binary.BigEndian.Uint32(ip))
+	return first, first + uint64(1)<<uint(32-ones) - 1
+}
+
+func cidrsOverlap(a, b *net.IPNet) bool {
+	return a.Contains(b.IP) || b.Contains(a.IP)
+}
+
+func cidrContains(outer, inner *net.IPNet) bool {
+	outerLength, _ := outer.Mask.Size()
+	innerLength, _ := inner.Mask.Size()
+	return outerLength <= innerLength && outer.Contains(inner.IP)
+}
+
+func sortCIDRs(cidrs []*net.IPNet) {
+	sort.Slice(cidrs, func(i, j int) bool {
+		iFirst, _ := ipv4RangeBounds(cidrs[i])
+		jFirst, _ := ipv4RangeBounds(cidrs[j])
+		return iFirst < jFirst
+	})
+}
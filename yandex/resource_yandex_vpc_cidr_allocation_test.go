@@ -0,0 +1,315 @@
+package yandex
+
+import (
+	"fmt"
+	"net"
+	"reflect"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/plancheck"
+)
+
+func TestNextFreeVPCCIDR(t *testing.T) {
+	cidrs := func(values ...string) []*net.IPNet {
+		var result []*net.IPNet
+		for _, v := range values {
+			_, r, err := net.ParseCIDR(v)
+			if err != nil {
+				t.Fatal(err)
+			}
+			result = append(result, r)
+		}
+		return result
+	}
+
+	cases := []struct {
+		name         string
+		supernet     string
+		prefixLength int
+		used         []string
+		expected     string
+	}{
+		{
+			name:         "empty supernet",
+			supernet:     "10.100.0.0/16",
+			prefixLength: 24,
+			expected:     "10.100.0.0/24",
+		},
+		{
+			name:         "skips used and reserved ranges",
+			supernet:     "10.100.0.0/16",
+			prefixLength: 24,
+			used:         []string{"10.100.1.0/24", "10.100.0.0/24", "10.100.2.0/28"},
+			expected:     "10.100.3.0/24",
+		},
+		{
+			name:         "fills a gap",
+			supernet:     "10.100.0.0/16",
+			prefixLength: 24,
+			used:         []string{"10.100.0.0/24", "10.100.2.0/23"},
+			expected:     "10.100.1.0/24",
+		},
+		{
+			name:         "skips a wide range",
+			supernet:     "10.100.0.0/16",
+			prefixLength: 22,
+			used:         []string{"10.100.0.0/20", "10.100.16.0/24"},
+			expected:     "10.100.20.0/22",
+		},
+		{
+			name:         "used range wider than supernet",
+			supernet:     "10.100.0.0/16",
+			prefixLength: 24,
+			used:         []string{"10.0.0.0/8"},
+		},
+		{
+			name:         "exhausted supernet",
+			supernet:     "10.100.0.0/23",
+			prefixLength: 24,
+			used:         []string{"10.100.0.0/24", "10.100.1.0/24"},
+		},
+		{
+			name:         "top of the address space",
+			supernet:     "255.255.255.0/24",
+			prefixLength: 25,
+			used:         []string{"255.255.255.0/25"},
+			expected:     "255.255.255.128/25",
+		},
+		{
+			name:         "prefix wider than supernet",
+			supernet:     "10.100.0.0/24",
+			prefixLength: 16,
+		},
+	}
+
+	for _, tc := range cases {
+		t.Run(tc.name, func(t *testing.T) {
+			supernet := cidrs(tc.supernet)[0]
+			result, err := nextFreeVPCCIDR(supernet, tc.prefixLength, cidrs(tc.used...))
+			if tc.expected == "" {
+				if err == nil {
+					t.Fatalf("Allocation should fail, got %q", result.String())
+				}
+				return
+			}
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			if result.String() != tc.expected {
+				t.Fatalf("Expected %q, got %q", tc.expected, result.String())
+			}
+		})
+	}
+}
+
+func TestNextFreeVPCCIDRFrom(t *testing.T) {
+	_, supernet, _ := net.ParseCIDR("10.100.0.0/22")
+	_, used, _ := net.ParseCIDR("10.100.2.0/24")
+
+	for start, expected := range map[uint64]string{
+		0: "10.100.0.0/24",
+		// skips the used prefix
+		2: "10.100.3.0/24",
+		// wraps around the supernet
+		7: "10.100.3.0/24",
+		5: "10.100.1.0/24",
+	} {
+		result, err := nextFreeVPCCIDRFrom(supernet, 24, []*net.IPNet{used}, start)
+		if err != nil {
+			t.Fatalf("Unexpected error: %v", err)
+		}
+		if result.String() != expected {
+			t.Errorf("Expected %q from %d, got %q", expected, start, result.String())
+		}
+	}
+
+	_, last, _ := net.ParseCIDR("10.100.3.0/24")
+	_, first, _ := net.ParseCIDR("10.100.0.0/23")
+	if result, err := nextFreeVPCCIDRFrom(supernet, 24, []*net.IPNet{first, used, last}, 3); err == nil {
+		t.Errorf("Allocation should fail, got %q", result.String())
+	}
+}
+
+func TestAllocateVPCCIDR(t *testing.T) {
+	_, supernet, _ := net.ParseCIDR("10.100.0.0/16")
+	_, reserved, _ := net.ParseCIDR("10.100.0.0/20")
+
+	allocated, err := allocateVPCCIDR(supernet, []*net.IPNet{reserved}, "team-a", 24)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if allocated.String() != "10.100.125.0/24" {
+		t.Errorf("Unexpected prefix of team-a: %q", allocated.String())
+	}
+
+	// the prefix derived from the name is taken by a subnet
+	allocated, err = allocateVPCCIDR(supernet, []*net.IPNet{reserved, allocated}, "team-a", 24)
+	if err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if allocated.String() != "10.100.126.0/24" {
+		t.Errorf("Unexpected prefix of team-a: %q", allocated.String())
+	}
+}
+
+func TestAllocateVPCCIDRInSwappedOrder(t *testing.T) {
+	_, supernet, _ := net.ParseCIDR("10.100.0.0/16")
+
+	// every allocation sees the subnets of the allocations created before it
+	allocate := func(names ...string) map[string]string {
+		var used []*net.IPNet
+		allocated := map[string]string{}
+		for _, name := range names {
+			r, err := allocateVPCCIDR(supernet, used, name, 24)
+			if err != nil {
+				t.Fatalf("Unexpected error: %v", err)
+			}
+			allocated[name] = r.String()
+			used = append(used, r)
+		}
+		return allocated
+	}
+
+	ab, ba := allocate("team-a", "team-b"), allocate("team-b", "team-a")
+	if !reflect.DeepEqual(ab, ba) || ab["team-a"] == ab["team-b"] {
+		t.Fatalf("Allocations depend on the order: %v, %v", ab, ba)
+	}
+}
+
+func TestKeptVPCCIDRAllocation(t *testing.T) {
+	_, supernet, _ := net.ParseCIDR("10.100.0.0/16")
+
+	cases := []struct {
+		cidr         string
+		prefixLength int
+		kept         bool
+	}{
+		{cidr: "10.100.17.0/24", prefixLength: 24, kept: true},
+		{cidr: "", prefixLength: 24},
+		{cidr: "10.100.17.0/24", prefixLength: 23},
+		{cidr: "10.101.17.0/24", prefixLength: 24},
+	}
+
+	for _, tc := range cases {
+		if kept := keptVPCCIDRAllocation(supernet, tc.cidr, tc.prefixLength); (kept != nil) != tc.kept {
+			t.Errorf("Unexpected result for %q of length %d: %v", tc.cidr, tc.prefixLength, kept)
+		}
+	}
+}
+
+func TestRegisterVPCCIDRAllocation(t *testing.T) {
+	_, a, _ := net.ParseCIDR("10.100.17.0/24")
+	_, b, _ := net.ParseCIDR("10.100.16.0/23")
+	_, c, _ := net.ParseCIDR("10.100.18.0/24")
+
+	if err := registerVPCCIDRAllocation("test-pool", "a", a); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	// the same allocation is planned again at the apply time
+	if err := registerVPCCIDRAllocation("test-pool", "a", a); err != nil {
+		t.Fatalf("Unexpected error: %v", err)
+	}
+	if err := registerVPCCIDRAllocation("test-pool", "b", b); err == nil {
+		t.Errorf("Overlapping allocation should fail")
+	}
+	if err := registerVPCCIDRAllocation("test-pool", "c", c); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+	if err := registerVPCCIDRAllocation("other-pool", "b", b); err != nil {
+		t.Errorf("Unexpected error: %v", err)
+	}
+}
+
+func TestAccVPCCIDRAllocation_basic(t *testing.T) {
+	networkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPCSubnetDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCCIDRAllocation_basic(networkName, false),
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_vpc_cidr_allocation.a", "cidr", "10.100.125.0/24"),
+					resource.TestCheckResourceAttr("yandex_vpc_cidr_allocation.b", "cidr", "10.100.199.0/24"),
+					resource.TestCheckResourceAttr("yandex_vpc_subnet.a", "v4_cidr_blocks.0", "10.100.125.0/24"),
+					resource.TestCheckResourceAttr("yandex_vpc_subnet.b", "v4_cidr_blocks.0", "10.100.199.0/24"),
+				),
+			},
+			{
+				// the allocations keep their prefixes, though the subnets now take them
+				Config: testAccVPCCIDRAllocation_basic(networkName, true),
+				ConfigPlanChecks: resource.ConfigPlanChecks{
+					PreApply: []plancheck.PlanCheck{
+						plancheck.ExpectResourceAction("yandex_vpc_subnet.a", plancheck.ResourceActionNoop),
+						plancheck.ExpectResourceAction("yandex_vpc_subnet.b", plancheck.ResourceActionNoop),
+					},
+				},
+				Check: resource.ComposeTestCheckFunc(
+					resource.TestCheckResourceAttr("yandex_vpc_cidr_allocation.a", "cidr", "10.100.125.0/24"),
+					resource.TestCheckResourceAttr("yandex_vpc_cidr_allocation.c", "cidr", "10.100.81.0/24"),
+					resource.TestCheckResourceAttr("yandex_vpc_cidr_pool.pool", "used_cidrs.#", "3"),
+				),
+			},
+		},
+	})
+}
+
+//revive:disable:var-naming
+func testAccVPCCIDRAllocation_basic(networkName string, withC bool) string {
+	allocationC := ""
+	if withC {
+		allocationC = `
+resource "yandex_vpc_cidr_allocation" "c" {
+  pool_id       = "${yandex_vpc_cidr_pool.pool.id}"
+  supernet      = "${yandex_vpc_cidr_pool.pool.supernet}"
+  used_cidrs    = yandex_vpc_cidr_pool.pool.used_cidrs
+  name          = "team-c"
+  prefix_length = 24
+}
+`
+	}
+
+	return fmt.Sprintf(`
+resource "yandex_vpc_network" "foo" {
+  name = "%s"
+}
+
+resource "yandex_vpc_cidr_pool" "pool" {
+  supernet        = "10.100.0.0/16"
+  reserved_ranges = ["10.100.0.0/20"]
+  network_ids     = ["${yandex_vpc_network.foo.id}"]
+}
+
+resource "yandex_vpc_cidr_allocation" "a" {
+  pool_id       = "${yandex_vpc_cidr_pool.pool.id}"
+  supernet      = "${yandex_vpc_cidr_pool.pool.supernet}"
+  used_cidrs    = yandex_vpc_cidr_pool.pool.used_cidrs
+  name          = "team-a"
+  prefix_length = 24
+}
+
+resource "yandex_vpc_cidr_allocation" "b" {
+  pool_id       = "${yandex_vpc_cidr_pool.pool.id}"
+  supernet      = "${yandex_vpc_cidr_pool.pool.supernet}"
+  used_cidrs    = yandex_vpc_cidr_pool.pool.used_cidrs
+  name          = "team-b"
+  prefix_length = 24
+}
+
+resource "yandex_vpc_subnet" "a" {
+  network_id     = "${yandex_vpc_network.foo.id}"
+  zone           = "ru-central1-a"
+  v4_cidr_blocks = ["${yandex_vpc_cidr_allocation.a.cidr}"]
+}
+
+resource "yandex_vpc_subnet" "b" {
+  network_id     = "${yandex_vpc_network.foo.id}"
+  zone           = "ru-central1-a"
+  v4_cidr_blocks = ["${yandex_vpc_cidr_allocation.b.cidr}"]
+}
+%s`, networkName, allocationC)
+}
@@ -0,0 +1,158 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"net"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	"google.golang.org/grpc/codes"
+)
+
+func resourceYandexVPCCIDRPool() *schema.Resource {
+	return &schema.Resource{
+		Description: "Declares an IPv4 supernet to allocate subnet ranges from with `yandex_vpc_cidr_allocation`. The pool exists only in the Terraform state, so every change of the pool replaces it.\n\n" +
+			"Ranges that are already taken are collected from the reserved ranges and from the subnets of the listed networks.\n",
+
+		CreateContext: resourceYandexVPCCIDRPoolCreate,
+		ReadContext:   resourceYandexVPCCIDRPoolRead,
+		DeleteContext: resourceYandexVPCCIDRPoolDelete,
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"supernet": {
+				Type:         schema.TypeString,
+				Description:  "IPv4 range in CIDR notation to allocate prefixes from.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDRNetwork(0, 32),
+			},
+
+			"reserved_ranges": {
+				Type:        schema.TypeList,
+				Description: "IPv4 ranges in CIDR notation that must never be allocated.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem: &schema.Schema{
+					Type:         schema.TypeString,
+					ValidateFunc: validation.IsCIDRNetwork(0, 32),
+				},
+			},
+
+			"network_ids": {
+				Type:        schema.TypeSet,
+				Description: "IDs of the networks whose subnets are treated as taken ranges.",
+				Optional:    true,
+				ForceNew:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+				Set:         schema.HashString,
+			},
+
+			"used_cidrs": {
+				Type:        schema.TypeList,
+				Description: "Taken ranges inside the supernet: the reserved ranges and the CIDR blocks of the subnets of the listed networks.",
+				Computed:    true,
+				Elem:        &schema.Schema{Type: schema.TypeString},
+			},
+		},
+	}
+}
+
+type vpcCIDRPoolSpec struct {
+	Supernet       string
+	ReservedRanges []string
+	NetworkIDs     []string
+}
+
+func expandVPCCIDRPoolSpec(d *schema.ResourceData) *vpcCIDRPoolSpec {
+	return &vpcCIDRPoolSpec{
+		Supernet:       d.Get("supernet").(string),
+		ReservedRanges: expandStringSlice(d.Get("reserved_ranges").([]interface{})),
+		NetworkIDs:     expandStringSet(d.Get("network_ids")),
+	}
+}
+
+func resourceYandexVPCCIDRPoolCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	spec := expandVPCCIDRPoolSpec(d)
+	for _, cidr := range append([]string{spec.Supernet}, spec.ReservedRanges...) {
+		if ip, _, _ := net.ParseCIDR(cidr); ip.To4() == nil {
+			return diag.Errorf("CIDR pool supports IPv4 ranges only, got %q", cidr)
+		}
+	}
+
+	d.SetId(resource.UniqueId())
+
+	return resourceYandexVPCCIDRPoolRead(ctx, d, meta)
+}
+
+func resourceYandexVPCCIDRPoolRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	used, err := listVPCCIDRPoolUsedRanges(ctx, config, expandVPCCIDRPoolSpec(d))
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	usedCIDRs := make([]string, 0, len(used))
+	for _, r := range used {
+		usedCIDRs = append(usedCIDRs, r.String())
+	}
+
+	if err := d.Set("used_cidrs", usedCIDRs); err != nil {
+		return diag.FromErr(err)
+	}
+
+	return nil
+}
+
+func resourceYandexVPCCIDRPoolDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	// The pool has no cloud counterpart, removing it from the state is enough.
+	d.SetId("")
+	return nil
+}
+
+// listVPCCIDRPoolUsedRanges returns the reserved ranges and the subnet ranges of the pool networks
+// which overlap the pool supernet, sorted by address.
+func listVPCCIDRPoolUsedRanges(ctx context.Context, config *Config, spec *vpcCIDRPoolSpec) ([]*net.IPNet, error) {
+	_, supernet, err := net.ParseCIDR(spec.Supernet)
+	if err != nil {
+		return nil, err
+	}
+
+	cidrs := append([]string{}, spec.ReservedRanges...)
+	for _, networkID := range spec.NetworkIDs {
+		it := config.sdk.VPC().Network().NetworkSubnetsIterator(ctx, &vpc.ListNetworkSubnetsRequest{
+			NetworkId: networkID,
+		})
+		for it.Next() {
+			cidrs = append(cidrs, it.Value().GetV4CidrBlocks()...)
+		}
+		if err := it.Error(); err != nil {
+			if isStatusWithCode(err, codes.NotFound) {
+				log.Printf("[WARN] Network %q of CIDR pool %q is not found, skipping it", networkID, spec.Supernet)
+				continue
+			}
+			return nil, fmt.Errorf("Error while requesting API to list subnets of Network %q: %s", networkID, err)
+		}
+	}
+
+	var used []*net.IPNet
+	for _, cidr := range cidrs {
+		_, r, err := net.ParseCIDR(cidr)
+		if err != nil {
+			return nil, err
+		}
+		if cidrsOverlap(supernet, r) {
+			used = append(used, r)
+		}
+	}
+	sortCIDRs(used)
+
+	return used, nil
+}
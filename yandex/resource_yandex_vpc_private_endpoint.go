@@ -3,9 +3,11 @@ package yandex
 import (
 	"context"
 	"fmt"
+	"strings"
 	"time"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
 	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1/privatelink"
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
 	"google.golang.org/genproto/protobuf/field_mask"
@@ -13,6 +15,62 @@ import (
 
 const yandexVPCPrivateEndpointDefaultTimeout = 1 * time.Minute
 
+const vpcPrivateEndpointServiceObjectStorage = "object_storage"
+
+// vpcPrivateEndpointService describes a service which private endpoints can be created for.
+// Adding a service supported by the Private Link API is a matter of adding an entry to vpcPrivateEndpointServices.
+type vpcPrivateEndpointService struct {
+	Type        string
+	Description string
+	// DNSNames holds the names of the private DNS records created for the endpoint, by region.
+	DNSNames map[string][]string
+
+	setCreateRequest func(req *privatelink.CreatePrivateEndpointRequest)
+	matches          func(pe *privatelink.PrivateEndpoint) bool
+}
+
+var vpcPrivateEndpointServices = []vpcPrivateEndpointService{
+	{
+		Type:        vpcPrivateEndpointServiceObjectStorage,
+		Description: "Object Storage",
+		DNSNames: map[string][]string{
+			"ru-central1": {"storage.yandexcloud.net", "*.storage.yandexcloud.net"},
+		},
+		setCreateRequest: func(req *privatelink.CreatePrivateEndpointRequest) {
+			req.Service = &privatelink.CreatePrivateEndpointRequest_ObjectStorage{}
+		},
+		matches: func(pe *privatelink.PrivateEndpoint) bool {
+			return pe.GetObjectStorage() != nil
+		},
+	},
+}
+
+func vpcPrivateEndpointServiceTypes() []string {
+	var types []string
+	for _, service := range vpcPrivateEndpointServices {
+		types = append(types, service.Type)
+	}
+	return types
+}
+
+func findVPCPrivateEndpointServiceByType(serviceType string) *vpcPrivateEndpointService {
+	for i := range vpcPrivateEndpointServices {
+		if vpcPrivateEndpointServices[i].Type == serviceType {
+			return &vpcPrivateEndpointServices[i]
+		}
+	}
+	return nil
+}
+
+func findVPCPrivateEndpointService(pe *privatelink.PrivateEndpoint) *vpcPrivateEndpointService {
+	for i := range vpcPrivateEndpointServices {
+		if vpcPrivateEndpointServices[i].matches(pe) {
+			return &vpcPrivateEndpointServices[i]
+		}
+	}
+	return nil
+}
+
 func resourceYandexVPCPrivateEndpoint() *schema.Resource {
 	return &schema.Resource{
 		Description: "Manages a VPC Private Endpoint within the Yandex Cloud. For more information, see [the official documentation](https://yandex.cloud/docs/vpc/concepts/private-endpoint).\n\n* How-to Guides\n  * [Cloud Networking](https://yandex.cloud/docs/vpc/)\n",
@@ -23,6 +81,8 @@ func resourceYandexVPCPrivateEndpoint() *schema.Resource {
 		Update: resourceYandexVPCPrivateEndpointUpdate,
 		Delete: resourceYandexVPCPrivateEndpointDelete,
 
+		CustomizeDiff: resourceYandexVPCPrivateEndpointCustomizeDiff,
+
 		Importer: &schema.ResourceImporter{
 			StateContext: schema.ImportStatePassthroughContext,
 		},
@@ -86,16 +146,60 @@ func resourceYandexVPCPrivateEndpoint() *schema.Resource {
 			},
 
 			"object_storage": {
-				Type:        schema.TypeList,
-				Description: "Private endpoint for Object Storage.",
-				MaxItems:    1,
-				// NOTE: For now we require object storage, but later there will be more choices / services.
-				Required: true,
+				Type:         schema.TypeList,
+				Description:  "Private endpoint for Object Storage.",
+				Deprecated:   "Use `service { type = \"object_storage\" }` instead.",
+				MaxItems:     1,
+				Optional:     true,
+				ExactlyOneOf: []string{"object_storage", "service"},
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{},
 				},
 			},
 
+			"service": {
+				Type:         schema.TypeList,
+				Description:  "Service the private endpoint is created for. Use the `yandex_vpc_private_endpoint_services` data source to list the supported services.",
+				MaxItems:     1,
+				Optional:     true,
+				ExactlyOneOf: []string{"object_storage", "service"},
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"type": {
+							Type:         schema.TypeString,
+							Description:  fmt.Sprintf("Type of the service. Possible values: %s.", strings.Join(vpcPrivateEndpointServiceTypes(), ", ")),
+							Required:     true,
+							ValidateFunc: validation.StringInSlice(vpcPrivateEndpointServiceTypes(), false),
+						},
+					},
+				},
+			},
+
+			"dns_records": {
+				Type:        schema.TypeList,
+				Description: "Private DNS records created for the endpoint when `private_dns_records_enabled` is set.",
+				Computed:    true,
+				Elem: &schema.Resource{
+					Schema: map[string]*schema.Schema{
+						"name": {
+							Type:        schema.TypeString,
+							Description: "Name of the DNS record.",
+							Computed:    true,
+						},
+						"type": {
+							Type:        schema.TypeString,
+							Description: "Type of the DNS record.",
+							Computed:    true,
+						},
+						"value": {
+							Type:        schema.TypeString,
+							Description: "Value of the DNS record, that is the private endpoint address.",
+							Computed:    true,
+						},
+					},
+				},
+			},
+
 			"endpoint_address": {
 				Type:        schema.TypeList,
 				Description: "Private endpoint address specification block.\n\n~> Only one of `address_id` or `subnet_id` + `address` arguments can be specified.\n",
@@ -180,9 +284,11 @@ func resourceYandexVPCPrivateEndpointCreate(d *schema.ResourceData, meta interfa
 		AddressSpec: addressSpec,
 	}
 
-	if d.Get("object_storage") != nil {
-		req.Service = &privatelink.CreatePrivateEndpointRequest_ObjectStorage{}
+	service := findVPCPrivateEndpointServiceByType(expandPrivateEndpointServiceType(d))
+	if service == nil {
+		return fmt.Errorf("error getting service while creating private endpoint: unknown service type %q", expandPrivateEndpointServiceType(d))
 	}
+	service.setCreateRequest(&req)
 
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutCreate))
 	defer cancel()
@@ -267,14 +373,25 @@ func yandexVPCPrivateEndpointRead(d *schema.ResourceData, meta interface{}, id s
 		return err
 	}
 
-	switch v := privateEndpoint.Service.(type) {
-	case *privatelink.PrivateEndpoint_ObjectStorage_:
-		objStorage := flattenPrivateEndpointObjectStorage(v.ObjectStorage)
-		if err := d.Set("object_storage", objStorage); err != nil {
-			return err
+	service := findVPCPrivateEndpointService(privateEndpoint)
+	if service != nil {
+		// keep the deprecated block for endpoints declared with it
+		if d.Get("object_storage.#").(int) > 0 && service.Type == vpcPrivateEndpointServiceObjectStorage {
+			if err := d.Set("object_storage", flattenPrivateEndpointObjectStorage(privateEndpoint.GetObjectStorage())); err != nil {
+				return err
+			}
+		} else {
+			if err := d.Set("service", []map[string]interface{}{{"type": service.Type}}); err != nil {
+				return err
+			}
 		}
 	}
 
+	dnsRecords := flattenPrivateEndpointDnsRecords(service, config.Region, privateEndpoint)
+	if err := d.Set("dns_records", dnsRecords); err != nil {
+		return err
+	}
+
 	return d.Set("labels", privateEndpoint.GetLabels())
 }
 
@@ -332,6 +449,12 @@ func resourceYandexVPCPrivateEndpointUpdate(d *schema.ResourceData, meta interfa
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, dnsOptPropName)
 	}
 
+	if len(req.UpdateMask.Paths) == 0 {
+		// switching between `object_storage` and `service` blocks of the same service doesn't touch the endpoint
+		d.Partial(false)
+		return resourceYandexVPCPrivateEndpointRead(d, meta)
+	}
+
 	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
 	defer cancel()
 
@@ -378,6 +501,41 @@ func resourceYandexVPCPrivateEndpointDelete(d *schema.ResourceData, meta interfa
 	return nil
 }
 
+// resourceYandexVPCPrivateEndpointCustomizeDiff recreates the endpoint only when the service changes,
+// so moving from the deprecated `object_storage` block to `service` is applied in place.
+func resourceYandexVPCPrivateEndpointCustomizeDiff(ctx context.Context, d *schema.ResourceDiff, meta interface{}) error {
+	if d.Id() == "" || !d.HasChanges("object_storage", "service") {
+		return nil
+	}
+
+	oldObjectStorage, newObjectStorage := d.GetChange("object_storage")
+	oldService, newService := d.GetChange("service")
+	oldType := privateEndpointServiceType(oldObjectStorage.([]interface{}), oldService.([]interface{}))
+	newType := privateEndpointServiceType(newObjectStorage.([]interface{}), newService.([]interface{}))
+	if oldType == newType {
+		return nil
+	}
+
+	if d.HasChange("service") {
+		return d.ForceNew("service")
+	}
+	return d.ForceNew("object_storage")
+}
+
+func expandPrivateEndpointServiceType(d *schema.ResourceData) string {
+	return privateEndpointServiceType(d.Get("object_storage").([]interface{}), d.Get("service").([]interface{}))
+}
+
+func privateEndpointServiceType(objectStorage, service []interface{}) string {
+	if len(objectStorage) > 0 {
+		return vpcPrivateEndpointServiceObjectStorage
+	}
+	if len(service) > 0 && service[0] != nil {
+		return service[0].(map[string]interface{})["type"].(string)
+	}
+	return ""
+}
+
 func expandPrivateEndpointDnsOptions(d *schema.ResourceData) (*privatelink.PrivateEndpoint_DnsOptions, error) {
 	if d.Get("dns_options.#").(int) == 0 {
 		return nil, nil
@@ -428,3 +586,19 @@ func flattenPrivateEndpointObjectStorage(_ *privatelink.PrivateEndpoint_ObjectSt
 	res := make(map[string]interface{})
 	return []map[string]interface{}{res}
 }
+
+func flattenPrivateEndpointDnsRecords(service *vpcPrivateEndpointService, region string, pe *privatelink.PrivateEndpoint) []map[string]interface{} {
+	if service == nil || !pe.GetDnsOptions().GetPrivateDnsRecordsEnabled() || pe.GetAddress().GetAddress() == "" {
+		return []map[string]interface{}{}
+	}
+
+	records := make([]map[string]interface{}, 0, len(service.DNSNames[region]))
+	for _, name := range service.DNSNames[region] {
+		records = append(records, map[string]interface{}{
+			"name":  name,
+			"type":  "A",
+			"value": pe.GetAddress().GetAddress(),
+		})
+	}
+	return records
+}
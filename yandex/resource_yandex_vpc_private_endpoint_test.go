@@ -58,6 +58,54 @@ func sweepVPCPrivateEndpointOnce(conf *Config, id string) error {
 	return handleSweepOperation(ctx, conf, op, err)
 }
 
+func TestPrivateEndpointServiceType(t *testing.T) {
+	objectStorage := []interface{}{map[string]interface{}{}}
+	service := []interface{}{map[string]interface{}{"type": "object_storage"}}
+
+	if v := privateEndpointServiceType(objectStorage, nil); v != vpcPrivateEndpointServiceObjectStorage {
+		t.Errorf("Unexpected service type of the object_storage block: %q", v)
+	}
+	if v := privateEndpointServiceType(nil, service); v != vpcPrivateEndpointServiceObjectStorage {
+		t.Errorf("Unexpected service type of the service block: %q", v)
+	}
+	if v := privateEndpointServiceType(nil, nil); v != "" {
+		t.Errorf("Unexpected service type without blocks: %q", v)
+	}
+
+	for _, serviceType := range vpcPrivateEndpointServiceTypes() {
+		if findVPCPrivateEndpointServiceByType(serviceType) == nil {
+			t.Errorf("Service %q is not found by type", serviceType)
+		}
+	}
+}
+
+func TestFlattenPrivateEndpointDnsRecords(t *testing.T) {
+	pe := &privatelink.PrivateEndpoint{
+		Address:    &privatelink.PrivateEndpoint_EndpointAddress{Address: "10.0.0.5"},
+		DnsOptions: &privatelink.PrivateEndpoint_DnsOptions{PrivateDnsRecordsEnabled: true},
+		Service:    &privatelink.PrivateEndpoint_ObjectStorage_{ObjectStorage: &privatelink.PrivateEndpoint_ObjectStorage{}},
+	}
+
+	service := findVPCPrivateEndpointService(pe)
+	if service == nil || service.Type != vpcPrivateEndpointServiceObjectStorage {
+		t.Fatalf("Unexpected service of the endpoint: %+v", service)
+	}
+
+	records := flattenPrivateEndpointDnsRecords(service, "ru-central1", pe)
+	if len(records) != 2 || records[0]["name"] != "storage.yandexcloud.net" || records[1]["value"] != "10.0.0.5" || records[1]["type"] != "A" {
+		t.Fatalf("Unexpected DNS records: %v", records)
+	}
+
+	if records := flattenPrivateEndpointDnsRecords(service, "unknown-region", pe); len(records) != 0 {
+		t.Errorf("Records of an unknown region should be empty, got %v", records)
+	}
+
+	pe.DnsOptions.PrivateDnsRecordsEnabled = false
+	if records := flattenPrivateEndpointDnsRecords(service, "ru-central1", pe); len(records) != 0 {
+		t.Errorf("Records should be empty when private DNS records are disabled, got %v", records)
+	}
+}
+
 func TestAccVPCPrivateEndpoint_Basic(t *testing.T) {
 	t.Parallel()
 
@@ -82,6 +130,8 @@ func TestAccVPCPrivateEndpoint_Basic(t *testing.T) {
 					testAccCheckCreatedAtAttr("yandex_vpc_private_endpoint.pe"),
 					resource.TestCheckResourceAttr("yandex_vpc_private_endpoint.pe", "dns_options.#", "1"),
 					resource.TestCheckResourceAttr("yandex_vpc_private_endpoint.pe", "dns_options.0.private_dns_records_enabled", "false"),
+					resource.TestCheckResourceAttr("yandex_vpc_private_endpoint.pe", "object_storage.#", "1"),
+					resource.TestCheckResourceAttr("yandex_vpc_private_endpoint.pe", "dns_records.#", "0"),
 					resource.TestCheckResourceAttr("yandex_vpc_private_endpoint.pe", "endpoint_address.#", "1"),
 					resource.TestCheckResourceAttrSet("yandex_vpc_private_endpoint.pe", "endpoint_address.0.subnet_id"),
 					resource.TestCheckResourceAttrSet("yandex_vpc_private_endpoint.pe", "endpoint_address.0.address"),
@@ -99,6 +149,11 @@ func TestAccVPCPrivateEndpoint_Basic(t *testing.T) {
 					testAccCheckCreatedAtAttr("yandex_vpc_private_endpoint.pe"),
 					resource.TestCheckResourceAttr("yandex_vpc_private_endpoint.pe", "dns_options.#", "1"),
 					resource.TestCheckResourceAttr("yandex_vpc_private_endpoint.pe", "dns_options.0.private_dns_records_enabled", "true"),
+					resource.TestCheckResourceAttr("yandex_vpc_private_endpoint.pe", "object_storage.#", "0"),
+					resource.TestCheckResourceAttr("yandex_vpc_private_endpoint.pe", "service.0.type", "object_storage"),
+					resource.TestCheckResourceAttr("yandex_vpc_private_endpoint.pe", "dns_records.#", "2"),
+					resource.TestCheckResourceAttr("yandex_vpc_private_endpoint.pe", "dns_records.0.name", "storage.yandexcloud.net"),
+					resource.TestCheckResourceAttrPair("yandex_vpc_private_endpoint.pe", "dns_records.0.value", "yandex_vpc_private_endpoint.pe", "endpoint_address.0.address"),
 					resource.TestCheckResourceAttr("yandex_vpc_private_endpoint.pe", "endpoint_address.#", "1"),
 					resource.TestCheckResourceAttrSet("yandex_vpc_private_endpoint.pe", "endpoint_address.0.subnet_id"),
 					resource.TestCheckResourceAttrSet("yandex_vpc_private_endpoint.pe", "endpoint_address.0.address"),
@@ -264,7 +319,9 @@ resource "yandex_vpc_private_endpoint" "pe" {
   name       = "%s"
   network_id = yandex_vpc_network.foo.id
 
-  object_storage {}
+  service {
+    type = "object_storage"
+  }
 
   dns_options {
     private_dns_records_enabled = true
@@ -8,6 +8,7 @@ import (
 	"time"
 
 	"github.com/yandex-cloud/terraform-provider-yandex/common"
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
 	"github.com/yandex-cloud/terraform-provider-yandex/yandex/internal/hashcode"
 
 	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
@@ -27,7 +28,7 @@ func resourceYandexVPCRouteTable() *schema.Resource {
 		Update: resourceYandexVPCRouteTableUpdate,
 		Delete: resourceYandexVPCRouteTableDelete,
 		Importer: &schema.ResourceImporter{
-			State: schema.ImportStatePassthrough,
+			State: resourceYandexVPCRouteTableImport,
 		},
 
 		Timeouts: &schema.ResourceTimeout{
@@ -78,7 +79,7 @@ func resourceYandexVPCRouteTable() *schema.Resource {
 
 			"static_route": {
 				Type:        schema.TypeSet,
-				Description: "A list of static route records for the route table.\n\n~> Only one of `next_hop_address` or `gateway_id` should be specified.\n",
+				Description: "A list of static route records for the route table. Routes added with the `yandex_vpc_route_table_route` resource are removed by this resource unless [`external_static_routes`](#external_static_routes) is `true`.\n\n~> Only one of `next_hop_address` or `gateway_id` should be specified.\n",
 				Optional:    true,
 				Elem: &schema.Resource{
 					Schema: map[string]*schema.Schema{
@@ -102,6 +103,12 @@ func resourceYandexVPCRouteTable() *schema.Resource {
 				Set: resourceYandexVPCRouteTableHash,
 			},
 
+			"external_static_routes": {
+				Type:        schema.TypeBool,
+				Description: "If `true`, the static routes which are not declared in `static_route` are managed by `yandex_vpc_route_table_route` resources. They are neither shown in `static_route` nor removed by this resource. Switching it on doesn't remove any routes.",
+				Optional:    true,
+			},
+
 			"created_at": {
 				Type:        schema.TypeString,
 				Description: common.ResourceDescriptions["created_at"],
@@ -192,7 +199,13 @@ func resourceYandexVPCRouteTableRead(d *schema.ResourceData, meta interface{}) e
 		return err
 	}
 
-	return d.Set("static_route", flattenStaticRoutes(routeTable))
+	staticRoutes := flattenStaticRoutes(routeTable)
+	if d.Get("external_static_routes").(bool) {
+		// Routes added by yandex_vpc_route_table_route resources are not tracked by the route table
+		staticRoutes = filterManagedStaticRoutes(staticRoutes, d.Get("static_route").(*schema.Set))
+	}
+
+	return d.Set("static_route", staticRoutes)
 }
 
 func resourceYandexVPCRouteTableUpdate(d *schema.ResourceData, meta interface{}) error {
@@ -225,26 +238,45 @@ func resourceYandexVPCRouteTableUpdate(d *schema.ResourceData, meta interface{})
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "description")
 	}
 
+	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
 	if d.HasChange("static_route") {
-		_, new := d.GetChange("static_route")
-		nrs := new.(*schema.Set).List()
+		old, new := d.GetChange("static_route")
+
+		newRoutes, err := expandStaticRoutes(new)
+		if err != nil {
+			return err
+		}
 
-		var newRoutes []*vpc.StaticRoute
-		for _, route := range nrs {
-			sr, err := routeDescriptionToStaticRoute(route)
+		req.StaticRoutes = newRoutes
+		if d.Get("external_static_routes").(bool) {
+			oldRoutes, err := expandStaticRoutes(old)
 			if err != nil {
 				return err
 			}
-			newRoutes = append(newRoutes, sr)
-		}
+			if d.HasChange("external_static_routes") {
+				// None of the current routes are removed when the external routes are switched on
+				oldRoutes = nil
+			}
 
-		req.StaticRoutes = newRoutes
+			// Routes of the yandex_vpc_route_table_route resources are modified with read-modify-write too
+			mutexKV := globallock.GetMutexKV()
+			mutexKV.Lock(d.Id())
+			defer mutexKV.Unlock(d.Id())
+
+			routeTable, err := config.sdk.VPC().RouteTable().Get(ctx, &vpc.GetRouteTableRequest{
+				RouteTableId: d.Id(),
+			})
+			if err != nil {
+				return fmt.Errorf("Error while requesting API to get Route table %q: %s", d.Id(), err)
+			}
+
+			req.StaticRoutes = mergeStaticRoutes(routeTable.GetStaticRoutes(), oldRoutes, newRoutes)
+		}
 		req.UpdateMask.Paths = append(req.UpdateMask.Paths, "static_routes")
 	}
 
-	ctx, cancel := context.WithTimeout(config.Context(), d.Timeout(schema.TimeoutUpdate))
-	defer cancel()
-
 	op, err := config.sdk.WrapOperation(config.sdk.VPC().RouteTable().Update(ctx, req))
 	if err != nil {
 		return fmt.Errorf("Error while requesting API to update Route table %q: %s", d.Id(), err)
@@ -291,6 +323,24 @@ func resourceYandexVPCRouteTableDelete(d *schema.ResourceData, meta interface{})
 	return nil
 }
 
+func resourceYandexVPCRouteTableImport(d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	config := meta.(*Config)
+
+	// All routes of the imported route table are considered declared in it
+	routeTable, err := config.sdk.VPC().RouteTable().Get(config.Context(), &vpc.GetRouteTableRequest{
+		RouteTableId: d.Id(),
+	})
+	if err != nil {
+		return nil, fmt.Errorf("Error while requesting API to get Route table %q: %s", d.Id(), err)
+	}
+
+	if err := d.Set("static_route", flattenStaticRoutes(routeTable)); err != nil {
+		return nil, err
+	}
+
+	return []*schema.ResourceData{d}, nil
+}
+
 func resourceYandexVPCRouteTableHash(v interface{}) int {
 	var buf bytes.Buffer
 	m, ok := v.(map[string]interface{})
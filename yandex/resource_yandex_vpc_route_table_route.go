@@ -0,0 +1,266 @@
+package yandex
+
+import (
+	"context"
+	"fmt"
+	"log"
+	"strings"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/diag"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/validation"
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+	"google.golang.org/genproto/protobuf/field_mask"
+
+	"github.com/yandex-cloud/terraform-provider-yandex/pkg/globallock"
+)
+
+func resourceYandexVPCRouteTableRoute() *schema.Resource {
+	return &schema.Resource{
+		Description: "Adds a static route to a route table. Routes of the same route table are modified one by one, so several route resources can be managed concurrently. For more information, see [the official documentation](https://yandex.cloud/docs/vpc/concepts/static-routes).\n\n" +
+			"~> Set `external_static_routes = true` on the `yandex_vpc_route_table`, otherwise the route table removes the routes added with this resource. Don't declare the same route in both places.\n",
+
+		CreateContext: resourceYandexVPCRouteTableRouteCreate,
+		ReadContext:   resourceYandexVPCRouteTableRouteRead,
+		UpdateContext: resourceYandexVPCRouteTableRouteUpdate,
+		DeleteContext: resourceYandexVPCRouteTableRouteDelete,
+
+		Importer: &schema.ResourceImporter{
+			StateContext: resourceYandexVPCRouteTableRouteImport,
+		},
+
+		Timeouts: &schema.ResourceTimeout{
+			Create: schema.DefaultTimeout(yandexVPCRouteTableDefaultTimeout),
+			Update: schema.DefaultTimeout(yandexVPCRouteTableDefaultTimeout),
+			Delete: schema.DefaultTimeout(yandexVPCRouteTableDefaultTimeout),
+		},
+
+		SchemaVersion: 0,
+
+		Schema: map[string]*schema.Schema{
+			"route_table_id": {
+				Type:        schema.TypeString,
+				Description: "ID of the route table to add the route to.",
+				Required:    true,
+				ForceNew:    true,
+			},
+
+			"destination_prefix": {
+				Type:         schema.TypeString,
+				Description:  "Route prefix in CIDR notation.",
+				Required:     true,
+				ForceNew:     true,
+				ValidateFunc: validation.IsCIDR,
+			},
+
+			"next_hop_address": {
+				Type:         schema.TypeString,
+				Description:  "Address of the next hop.",
+				Optional:     true,
+				ExactlyOneOf: []string{"next_hop_address", "gateway_id"},
+			},
+
+			"gateway_id": {
+				Type:         schema.TypeString,
+				Description:  "ID of the gateway used as next hop.",
+				Optional:     true,
+				ExactlyOneOf: []string{"next_hop_address", "gateway_id"},
+			},
+		},
+	}
+}
+
+func makeVPCRouteTableRouteID(routeTableID, destinationPrefix string) string {
+	return routeTableID + "/" + destinationPrefix
+}
+
+// parseVPCRouteTableRouteID splits the ID on the first slash only, as the destination prefix contains one.
+func parseVPCRouteTableRouteID(id string) (string, string, error) {
+	parts := strings.SplitN(id, "/", 2)
+	if len(parts) != 2 || parts[0] == "" || !strings.Contains(parts[1], "/") {
+		return "", "", fmt.Errorf("invalid route table route ID %q, expected format is route_table_id/destination_prefix", id)
+	}
+	return parts[0], parts[1], nil
+}
+
+func resourceYandexVPCRouteTableRouteCreate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	routeTableID := d.Get("route_table_id").(string)
+	route, err := expandVPCRouteTableRoute(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutCreate))
+	defer cancel()
+
+	log.Printf("[DEBUG] Adding route %q to Route table %q", route.GetDestinationPrefix(), routeTableID)
+
+	err = updateVPCRouteTableStaticRoutes(ctx, config, routeTableID, func(routes []*vpc.StaticRoute) ([]*vpc.StaticRoute, error) {
+		if findVPCStaticRoute(routes, route.GetDestinationPrefix()) != nil {
+			return nil, fmt.Errorf("route to %q already exists in Route table %q", route.GetDestinationPrefix(), routeTableID)
+		}
+		return append(routes, route), nil
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	d.SetId(makeVPCRouteTableRouteID(routeTableID, route.GetDestinationPrefix()))
+
+	return resourceYandexVPCRouteTableRouteRead(ctx, d, meta)
+}
+
+func resourceYandexVPCRouteTableRouteRead(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	routeTableID, destinationPrefix, err := parseVPCRouteTableRouteID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	routeTable, err := config.sdk.VPC().RouteTable().Get(ctx, &vpc.GetRouteTableRequest{
+		RouteTableId: routeTableID,
+	})
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("Route table %q", routeTableID)))
+	}
+
+	route := findVPCStaticRoute(routeTable.GetStaticRoutes(), destinationPrefix)
+	if route == nil {
+		log.Printf("[WARN] Route %q is not found in Route table %q, removing route from state", destinationPrefix, routeTableID)
+		d.SetId("")
+		return nil
+	}
+
+	d.Set("route_table_id", routeTableID)
+	d.Set("destination_prefix", route.GetDestinationPrefix())
+	d.Set("next_hop_address", route.GetNextHopAddress())
+	d.Set("gateway_id", route.GetGatewayId())
+
+	return nil
+}
+
+func resourceYandexVPCRouteTableRouteUpdate(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	routeTableID, destinationPrefix, err := parseVPCRouteTableRouteID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	route, err := expandVPCRouteTableRoute(d)
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutUpdate))
+	defer cancel()
+
+	log.Printf("[DEBUG] Updating route %q of Route table %q", destinationPrefix, routeTableID)
+
+	err = updateVPCRouteTableStaticRoutes(ctx, config, routeTableID, func(routes []*vpc.StaticRoute) ([]*vpc.StaticRoute, error) {
+		for i, r := range routes {
+			if r.GetDestinationPrefix() == destinationPrefix {
+				routes[i] = route
+				return routes, nil
+			}
+		}
+		return nil, fmt.Errorf("route to %q is not found in Route table %q", destinationPrefix, routeTableID)
+	})
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	return resourceYandexVPCRouteTableRouteRead(ctx, d, meta)
+}
+
+func resourceYandexVPCRouteTableRouteDelete(ctx context.Context, d *schema.ResourceData, meta interface{}) diag.Diagnostics {
+	config := meta.(*Config)
+
+	routeTableID, destinationPrefix, err := parseVPCRouteTableRouteID(d.Id())
+	if err != nil {
+		return diag.FromErr(err)
+	}
+
+	ctx, cancel := context.WithTimeout(ctx, d.Timeout(schema.TimeoutDelete))
+	defer cancel()
+
+	log.Printf("[DEBUG] Removing route %q from Route table %q", destinationPrefix, routeTableID)
+
+	err = updateVPCRouteTableStaticRoutes(ctx, config, routeTableID, func(routes []*vpc.StaticRoute) ([]*vpc.StaticRoute, error) {
+		var result []*vpc.StaticRoute
+		for _, r := range routes {
+			if r.GetDestinationPrefix() != destinationPrefix {
+				result = append(result, r)
+			}
+		}
+		return result, nil
+	})
+	if err != nil {
+		return diag.FromErr(handleNotFoundError(err, d, fmt.Sprintf("Route table %q", routeTableID)))
+	}
+
+	log.Printf("[DEBUG] Finished removing route %q from Route table %q", destinationPrefix, routeTableID)
+	return nil
+}
+
+func resourceYandexVPCRouteTableRouteImport(ctx context.Context, d *schema.ResourceData, meta interface{}) ([]*schema.ResourceData, error) {
+	if _, _, err := parseVPCRouteTableRouteID(d.Id()); err != nil {
+		return nil, err
+	}
+	return []*schema.ResourceData{d}, nil
+}
+
+func expandVPCRouteTableRoute(d *schema.ResourceData) (*vpc.StaticRoute, error) {
+	return routeDescriptionToStaticRoute(map[string]interface{}{
+		"destination_prefix": d.Get("destination_prefix"),
+		"next_hop_address":   d.Get("next_hop_address"),
+		"gateway_id":         d.Get("gateway_id"),
+	})
+}
+
+// updateVPCRouteTableStaticRoutes applies the modification to the current static routes of the route table.
+// The routes are replaced as a whole, so the read-modify-write runs under the route table lock.
+func updateVPCRouteTableStaticRoutes(ctx context.Context, config *Config, routeTableID string, modify func([]*vpc.StaticRoute) ([]*vpc.StaticRoute, error)) error {
+	mutexKV := globallock.GetMutexKV()
+	mutexKV.Lock(routeTableID)
+	defer mutexKV.Unlock(routeTableID)
+
+	routeTable, err := config.sdk.VPC().RouteTable().Get(ctx, &vpc.GetRouteTableRequest{
+		RouteTableId: routeTableID,
+	})
+	if err != nil {
+		return err
+	}
+
+	routes, err := modify(routeTable.GetStaticRoutes())
+	if err != nil {
+		return err
+	}
+
+	op, err := config.sdk.WrapOperation(config.sdk.VPC().RouteTable().Update(ctx, &vpc.UpdateRouteTableRequest{
+		RouteTableId: routeTableID,
+		StaticRoutes: routes,
+		UpdateMask:   &field_mask.FieldMask{Paths: []string{"static_routes"}},
+	}))
+	if err != nil {
+		return fmt.Errorf("Error while requesting API to update static routes of Route table %q: %s", routeTableID, err)
+	}
+
+	if err = op.Wait(ctx); err != nil {
+		return fmt.Errorf("Error while waiting operation to update static routes of Route table %q: %s", routeTableID, err)
+	}
+
+	return nil
+}
+
+func findVPCStaticRoute(routes []*vpc.StaticRoute, destinationPrefix string) *vpc.StaticRoute {
+	for _, route := range routes {
+		if route.GetDestinationPrefix() == destinationPrefix {
+			return route
+		}
+	}
+	return nil
+}
@@ -0,0 +1,160 @@
+package yandex
+
+import (
+	"fmt"
+	"testing"
+
+	"github.com/hashicorp/terraform-plugin-sdk/v2/helper/schema"
+	"github.com/hashicorp/terraform-plugin-testing/helper/acctest"
+	"github.com/hashicorp/terraform-plugin-testing/helper/resource"
+	"github.com/hashicorp/terraform-plugin-testing/terraform"
+
+	"github.com/yandex-cloud/go-genproto/yandex/cloud/vpc/v1"
+)
+
+func TestParseVPCRouteTableRouteID(t *testing.T) {
+	routeTableID, prefix, err := parseVPCRouteTableRouteID(makeVPCRouteTableRouteID("enp1", "10.0.0.0/16"))
+	if err != nil || routeTableID != "enp1" || prefix != "10.0.0.0/16" {
+		t.Fatalf("Unexpected result of parsing: %q, %q, %v", routeTableID, prefix, err)
+	}
+
+	for _, id := range []string{"", "enp1", "enp1/", "/10.0.0.0/16", "enp1/10.0.0.0"} {
+		if _, _, err := parseVPCRouteTableRouteID(id); err == nil {
+			t.Errorf("Parsing of %q should fail", id)
+		}
+	}
+}
+
+func TestMergeStaticRoutes(t *testing.T) {
+	route := func(prefix, nextHop string) *vpc.StaticRoute {
+		return &vpc.StaticRoute{
+			Destination: &vpc.StaticRoute_DestinationPrefix{DestinationPrefix: prefix},
+			NextHop:     &vpc.StaticRoute_NextHopAddress{NextHopAddress: nextHop},
+		}
+	}
+
+	current := []*vpc.StaticRoute{
+		route("10.0.0.0/16", "10.0.0.10"),
+		route("10.1.0.0/16", "10.1.0.10"),
+		route("10.2.0.0/16", "10.2.0.10"),
+	}
+	oldManaged := []*vpc.StaticRoute{route("10.0.0.0/16", "10.0.0.10"), route("10.2.0.0/16", "10.2.0.10")}
+	newManaged := []*vpc.StaticRoute{route("10.0.0.0/16", "10.0.0.20")}
+
+	var prefixes []string
+	for _, r := range mergeStaticRoutes(current, oldManaged, newManaged) {
+		prefixes = append(prefixes, r.GetDestinationPrefix()+" "+r.GetNextHopAddress())
+	}
+
+	expected := []string{"10.1.0.0/16 10.1.0.10", "10.0.0.0/16 10.0.0.20"}
+	if fmt.Sprint(prefixes) != fmt.Sprint(expected) {
+		t.Errorf("Unexpected merged routes: %v, expected %v", prefixes, expected)
+	}
+
+	// When the external routes are switched on no routes are managed yet, the declared ones are replaced and the rest are kept
+	prefixes = nil
+	for _, r := range mergeStaticRoutes(current, nil, []*vpc.StaticRoute{route("10.0.0.0/16", "10.0.0.10")}) {
+		prefixes = append(prefixes, r.GetDestinationPrefix()+" "+r.GetNextHopAddress())
+	}
+
+	expected = []string{"10.1.0.0/16 10.1.0.10", "10.2.0.0/16 10.2.0.10", "10.0.0.0/16 10.0.0.10"}
+	if fmt.Sprint(prefixes) != fmt.Sprint(expected) {
+		t.Errorf("Unexpected merged routes after switching external routes on: %v, expected %v", prefixes, expected)
+	}
+}
+
+func TestFilterManagedStaticRoutes(t *testing.T) {
+	routes := schema.NewSet(resourceYandexVPCRouteTableHash, []interface{}{
+		map[string]interface{}{"destination_prefix": "10.0.0.0/16", "next_hop_address": "10.0.0.10", "gateway_id": ""},
+		map[string]interface{}{"destination_prefix": "10.1.0.0/16", "next_hop_address": "10.1.0.10", "gateway_id": ""},
+	})
+	managed := schema.NewSet(resourceYandexVPCRouteTableHash, []interface{}{
+		map[string]interface{}{"destination_prefix": "10.0.0.0/16", "next_hop_address": "10.0.0.99", "gateway_id": ""},
+	})
+
+	filtered := filterManagedStaticRoutes(routes, managed).List()
+	if len(filtered) != 1 || filtered[0].(map[string]interface{})["next_hop_address"] != "10.0.0.10" {
+		t.Errorf("Unexpected filtered routes: %v", filtered)
+	}
+}
+
+func TestAccVPCRouteTableRoute_basic(t *testing.T) {
+	var routeTable vpc.RouteTable
+
+	networkName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+	routeTableName := fmt.Sprintf("tf-test-%s", acctest.RandString(10))
+
+	resource.Test(t, resource.TestCase{
+		PreCheck:     func() { testAccPreCheck(t) },
+		Providers:    testAccProviders,
+		CheckDestroy: testAccCheckVPCRouteTableDestroy,
+		Steps: []resource.TestStep{
+			{
+				Config: testAccVPCRouteTableRoute_basic(networkName, routeTableName, "10.1.0.10"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCRouteTableExists("yandex_vpc_route_table.rt", &routeTable),
+					testAccCheckVPCRouteTableStaticRoutesCount(&routeTable, 3),
+					// routes of the route resources are not tracked by the route table
+					resource.TestCheckResourceAttr("yandex_vpc_route_table.rt", "static_route.#", "1"),
+					resource.TestCheckResourceAttr("yandex_vpc_route_table_route.r1", "next_hop_address", "10.1.0.10"),
+					resource.TestCheckResourceAttr("yandex_vpc_route_table_route.r2", "destination_prefix", "10.2.0.0/16"),
+				),
+			},
+			{
+				Config: testAccVPCRouteTableRoute_basic(networkName, routeTableName, "10.1.0.20"),
+				Check: resource.ComposeTestCheckFunc(
+					testAccCheckVPCRouteTableExists("yandex_vpc_route_table.rt", &routeTable),
+					testAccCheckVPCRouteTableStaticRoutesCount(&routeTable, 3),
+					resource.TestCheckResourceAttr("yandex_vpc_route_table_route.r1", "next_hop_address", "10.1.0.20"),
+				),
+			},
+			{
+				ResourceName:      "yandex_vpc_route_table_route.r1",
+				ImportState:       true,
+				ImportStateVerify: true,
+			},
+		},
+	})
+}
+
+func testAccCheckVPCRouteTableStaticRoutesCount(routeTable *vpc.RouteTable, count int) resource.TestCheckFunc {
+	return func(s *terraform.State) error {
+		if len(routeTable.StaticRoutes) != count {
+			return fmt.Errorf("Route table should have %d static routes, got %d", count, len(routeTable.StaticRoutes))
+		}
+		return nil
+	}
+}
+
+//revive:disable:var-naming
+func testAccVPCRouteTableRoute_basic(networkName, routeTableName, nextHop string) string {
+	return fmt.Sprintf(`
+resource "yandex_vpc_network" "foo" {
+  name = "%s"
+}
+
+resource "yandex_vpc_route_table" "rt" {
+  name       = "%s"
+  network_id = "${yandex_vpc_network.foo.id}"
+
+  external_static_routes = true
+
+  static_route {
+    destination_prefix = "10.0.0.0/16"
+    next_hop_address   = "10.0.0.10"
+  }
+}
+
+resource "yandex_vpc_route_table_route" "r1" {
+  route_table_id     = "${yandex_vpc_route_table.rt.id}"
+  destination_prefix = "10.1.0.0/16"
+  next_hop_address   = "%s"
+}
+
+resource "yandex_vpc_route_table_route" "r2" {
+  route_table_id     = "${yandex_vpc_route_table.rt.id}"
+  destination_prefix = "10.2.0.0/16"
+  next_hop_address   = "10.2.0.10"
+}
+`, networkName, routeTableName, nextHop)
+}
@@ -272,6 +272,52 @@ func flattenInstanceSecondaryDisks(instance *compute.Instance) ([]map[string]int
 	return secondaryDisks, nil
 }
 
+// filterManagedInstanceSecondaryDisks keeps only the disks declared in the secondary_disk of the instance,
+// other disks are attached by the yandex_compute_disk_attachment resources.
+func filterManagedInstanceSecondaryDisks(disks []map[string]interface{}, managed *schema.Set) []map[string]interface{} {
+	managedIDs := map[string]bool{}
+	for _, disk := range managed.List() {
+		managedIDs[disk.(map[string]interface{})["disk_id"].(string)] = true
+	}
+
+	var filtered []map[string]interface{}
+	for _, disk := range disks {
+		if managedIDs[disk["disk_id"].(string)] {
+			filtered = append(filtered, disk)
+		}
+	}
+	return filtered
+}
+
+// managedInstanceNetworkInterfaceIndexes returns the indexes of the network interfaces declared in the
+// instance resource. Interfaces without a known index get it by their position, as the API assigns them on creation.
+func managedInstanceNetworkInterfaceIndexes(managed []interface{}) map[int]bool {
+	indexes := map[int]bool{0: true}
+	for i, raw := range managed {
+		iface, _ := raw.(map[string]interface{})
+		index, _ := iface["index"].(int)
+		if index == 0 {
+			index = i
+		}
+		indexes[index] = true
+	}
+	return indexes
+}
+
+// filterManagedInstanceNetworkInterfaces skips the network interfaces attached with the
+// yandex_compute_instance_network_interface resource, the first interface always belongs to the instance.
+func filterManagedInstanceNetworkInterfaces(nics []map[string]interface{}, managed []interface{}) []map[string]interface{} {
+	indexes := managedInstanceNetworkInterfaceIndexes(managed)
+
+	var filtered []map[string]interface{}
+	for _, nic := range nics {
+		if indexes[nic["index"].(int)] {
+			filtered = append(filtered, nic)
+		}
+	}
+	return filtered
+}
+
 func hashInstanceSecondaryDisks(v interface{}) int {
 	var buf bytes.Buffer
 
@@ -1105,6 +1151,40 @@ func flattenStaticRoutes(routeTable *vpc.RouteTable) *schema.Set {
 	return staticRoutes
 }
 
+// filterManagedStaticRoutes keeps only the routes declared in the route table resource, the routes of
+// the yandex_vpc_route_table_route resources are skipped. Routes are matched by the destination prefix.
+func filterManagedStaticRoutes(routes *schema.Set, managed *schema.Set) *schema.Set {
+	prefixes := make(map[string]bool)
+	for _, raw := range managed.List() {
+		prefixes[raw.(map[string]interface{})["destination_prefix"].(string)] = true
+	}
+
+	filtered := schema.NewSet(resourceYandexVPCRouteTableHash, nil)
+	for _, raw := range routes.List() {
+		if prefix, _ := raw.(map[string]interface{})["destination_prefix"].(string); prefixes[prefix] {
+			filtered.Add(raw)
+		}
+	}
+	return filtered
+}
+
+// mergeStaticRoutes replaces the routes declared in the route table resource, keeping the routes of
+// the yandex_vpc_route_table_route resources.
+func mergeStaticRoutes(current, oldManaged, newManaged []*vpc.StaticRoute) []*vpc.StaticRoute {
+	managedPrefixes := make(map[string]bool)
+	for _, route := range append(append([]*vpc.StaticRoute(nil), oldManaged...), newManaged...) {
+		managedPrefixes[route.GetDestinationPrefix()] = true
+	}
+
+	var routes []*vpc.StaticRoute
+	for _, route := range current {
+		if !managedPrefixes[route.GetDestinationPrefix()] {
+			routes = append(routes, route)
+		}
+	}
+	return append(routes, newManaged...)
+}
+
 func expandStaticRoutes(v interface{}) ([]*vpc.StaticRoute, error) {
 	staticRoutes := []*vpc.StaticRoute{}
 
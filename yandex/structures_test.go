@@ -357,6 +357,54 @@ func TestFlattenInstanceBootDisk(t *testing.T) {
 	}
 }
 
+func TestFilterManagedInstanceSecondaryDisks(t *testing.T) {
+	disks := []map[string]interface{}{
+		{"disk_id": "inline", "device_name": "a", "mode": "READ_WRITE", "auto_delete": false},
+		{"disk_id": "attached", "device_name": "b", "mode": "READ_WRITE", "auto_delete": false},
+	}
+	managed := schema.NewSet(hashInstanceSecondaryDisks, []interface{}{
+		map[string]interface{}{"disk_id": "inline", "device_name": "a", "mode": "READ_WRITE", "auto_delete": false},
+	})
+
+	result := filterManagedInstanceSecondaryDisks(disks, managed)
+	expected := []map[string]interface{}{disks[0]}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", result, expected)
+	}
+
+	if result := filterManagedInstanceSecondaryDisks(disks, schema.NewSet(hashInstanceSecondaryDisks, nil)); len(result) != 0 {
+		t.Fatalf("Disks not declared in the instance should be filtered out, got %#v", result)
+	}
+}
+
+func TestFilterManagedInstanceNetworkInterfaces(t *testing.T) {
+	nics := []map[string]interface{}{
+		{"index": 0, "subnet_id": "inline"},
+		{"index": 1, "subnet_id": "attached"},
+		{"index": 2, "subnet_id": "inline-indexed"},
+	}
+	managed := []interface{}{
+		map[string]interface{}{"index": 0, "subnet_id": "inline"},
+		map[string]interface{}{"index": 2, "subnet_id": "inline-indexed"},
+	}
+
+	result := filterManagedInstanceNetworkInterfaces(nics, managed)
+	expected := []map[string]interface{}{nics[0], nics[2]}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", result, expected)
+	}
+
+	// the first interface always belongs to the instance, others without an index are matched by position
+	result = filterManagedInstanceNetworkInterfaces(nics, []interface{}{
+		map[string]interface{}{"subnet_id": "inline"},
+		map[string]interface{}{"subnet_id": "attached"},
+	})
+	expected = []map[string]interface{}{nics[0], nics[1]}
+	if !reflect.DeepEqual(result, expected) {
+		t.Fatalf("Got:\n\n%#v\n\nExpected:\n\n%#v\n", result, expected)
+	}
+}
+
 func TestFlattenInstanceNetworkInterfaces(t *testing.T) {
 	tests := []struct {
 		name       string